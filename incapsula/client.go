@@ -28,6 +28,9 @@ type Client struct {
 	httpClient      *http.Client
 	providerVersion string
 	accountStatus   *AccountStatusResponse
+	resolver        DNSResolver
+	// accountID is the account sites are added to when no account is given, see WithAccount
+	accountID int
 }
 
 // NewClient creates a new client with the provided configuration
@@ -70,7 +73,7 @@ func (c *Client) CreateFormDataBody(bodyMap map[string]interface{}) ([]byte, str
 func (c *Client) Verify() (*AccountStatusResponse, error) {
 	log.Println("[INFO] Checking API credentials against Incapsula API")
 
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointAccountStatus)
+	reqURL := c.endpointURL(endpointAccountStatus)
 	data := url.Values{}
 
 	resp, err := c.PostFormWithHeaders(reqURL, data, VerifyAccount)
@@ -102,7 +105,7 @@ func (c *Client) Verify() (*AccountStatusResponse, error) {
 
 	// Look at the response status code from Incapsula
 	if resString != "0" {
-		return &accountStatusResponse, fmt.Errorf("Error from Incapsula service when checking account: %s", string(responseBody))
+		return &accountStatusResponse, newIncapsulaError(resString, accountStatusResponse.DebugInfo, "Error from Incapsula service when checking account: %s", string(responseBody))
 	}
 
 	return &accountStatusResponse, nil
@@ -118,6 +121,49 @@ func (c *Client) PostFormWithHeaders(url string, data url.Values, operation stri
 	return c.executeRequest(req)
 }
 
+// jsonDecodeError is returned when a response body isn't valid JSON for the expected type
+type jsonDecodeError struct {
+	err error
+}
+
+func (e *jsonDecodeError) Error() string {
+	return e.err.Error()
+}
+
+// postFormAndDecode posts the form and decodes the JSON response body into v, returning the response body.
+// A read whose response body is non-empty but isn't valid JSON is sent once more, since the API occasionally truncates
+// responses under load and a clean retry usually succeeds. Mutations are never sent twice.
+func (c *Client) postFormAndDecode(url string, data url.Values, operation string, v interface{}) ([]byte, error) {
+	responseBody, err := c.postFormAndReadBody(url, data, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(responseBody, v)
+	if _, invalidJSON := err.(*json.SyntaxError); invalidJSON && len(responseBody) > 0 && isReadOperation(http.MethodPost, operation) {
+		log.Printf("[WARN] Invalid JSON response from Incapsula service for operation %s, performing retry: %s\n", operation, string(responseBody))
+		responseBody, err = c.postFormAndReadBody(url, data, operation)
+		if err != nil {
+			return nil, err
+		}
+		err = json.Unmarshal(responseBody, v)
+	}
+	if err != nil {
+		return responseBody, &jsonDecodeError{err: err}
+	}
+
+	return responseBody, nil
+}
+
+func (c *Client) postFormAndReadBody(url string, data url.Values, operation string) ([]byte, error) {
+	resp, err := c.PostFormWithHeaders(url, data, operation)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
 func (c *Client) DoJsonRequestWithCustomHeaders(method string, url string, data []byte, headers map[string]string, operation string) (*http.Response, error) {
 	req, err := PrepareJsonRequest(method, url, data)
 	if err != nil {
@@ -194,8 +240,7 @@ func SetHeaders(c *Client, req *http.Request, contentType string, operation stri
 
 func (c *Client) executeRequest(req *http.Request) (*http.Response, error) {
 	//if "read" action then we want to allow retries in case of timeout from incapsula service
-	operation := req.Header.Get("x-tf-operation")
-	if req.Method == http.MethodGet || (req.Method == http.MethodPost && strings.HasPrefix(strings.ToLower(operation), "read")) {
+	if isReadOperation(req.Method, req.Header.Get("x-tf-operation")) {
 		var responseOnRequest *http.Response
 		var errorOnRequest error
 		resource.Retry(durationOfRetriesInSeconds*time.Second, func() *resource.RetryError {
@@ -215,3 +260,8 @@ func (c *Client) executeRequest(req *http.Request) (*http.Response, error) {
 	//if not a "read" request  - don't do retries (retires for updates are risky and result could be non-deterministic)
 	return c.httpClient.Do(req)
 }
+
+// isReadOperation returns true for requests which only read, and can therefore safely be sent again
+func isReadOperation(method, operation string) bool {
+	return method == http.MethodGet || (method == http.MethodPost && strings.HasPrefix(strings.ToLower(operation), "read"))
+}
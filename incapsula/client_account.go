@@ -30,7 +30,9 @@ type AccountAddResponse struct {
 			EmailVerified bool    `json:"email_verified"`
 		} `json:"logins"`
 	} `json:"account"`
-	Res int `json:"res"`
+	Res        int       `json:"res"`
+	ResMessage string    `json:"res_message"`
+	DebugInfo  DebugInfo `json:"debug_info"`
 }
 
 // AccountUpdateResponse contains the relevant account information when updating an Incapsula Account
@@ -56,12 +58,18 @@ type AccountStatusResponse struct {
 			Email         string  `json:"email"`
 			EmailVerified bool    `json:"email_verified"`
 		} `json:"logins"`
-		SupportLevel                   string `json:"support_level"`
-		SupportAllTLSVersions          bool   `json:"supprt_all_tls_versions"`
-		WildcardSANForNewSites         string `json:"wildcard_san_for_new_sites"`
-		NakedDomainSANForNewWWWSites   bool   `json:"naked_domain_san_for_new_www_sites"`
-		EnableHttp2ForNewSites         bool   `json:"enable_http2_for_new_sites"`
-		EnableHttp2ToOriginForNewSites bool   `json:"enable_http2_to_origin_for_new_sites"`
+		SupportLevel                    string          `json:"support_level"`
+		SupportAllTLSVersions           bool            `json:"supprt_all_tls_versions"`
+		WildcardSANForNewSites          string          `json:"wildcard_san_for_new_sites"`
+		NakedDomainSANForNewWWWSites    bool            `json:"naked_domain_san_for_new_www_sites"`
+		EnableHttp2ForNewSites          bool            `json:"enable_http2_for_new_sites"`
+		EnableHttp2ToOriginForNewSites  bool            `json:"enable_http2_to_origin_for_new_sites"`
+		DefaultGeoBlocking              SecurityRuleGeo `json:"default_geo_blocking"`
+		DefaultAccelerationLevel        string          `json:"default_acceleration_level"`
+		DefaultLogLevel                 string          `json:"default_log_level"`
+		RestrictedCnameReuseForNewSites bool            `json:"restricted_cname_reuse_for_new_sites"`
+		DefaultBlockNonEssentialBots    bool            `json:"default_block_non_essential_bots"`
+		APIKeyAllowedIPs                []string        `json:"api_key_allowed_ips"`
 	} `json:"account"`
 	ParentID    int    `json:"parent_id"`
 	Email       string `json:"email"`
@@ -84,9 +92,7 @@ type AccountStatusResponse struct {
 	ConsentRequired              bool        `json:"consent_required"`
 	Res                          interface{} `json:"res"`
 	ResMessage                   string      `json:"res_message"`
-	DebugInfo                    struct {
-		IDInfo string `json:"id-info"`
-	} `json:"debug_info"`
+	DebugInfo                    DebugInfo   `json:"debug_info"`
 }
 
 // AddAccount adds an account to be managed by Incapsula
@@ -111,7 +117,7 @@ func (c *Client) AddAccount(email, refID, userName, planID, accountName, logLeve
 		values["logs_account_id"][0] = fmt.Sprint(logsAccountID)
 	}
 
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointAccountAdd)
+	reqURL := c.endpointURL(endpointAccountAdd)
 	resp, err := c.PostFormWithHeaders(reqURL, values, CreateAccount)
 	if err != nil {
 		return nil, fmt.Errorf("Error adding account for email %s: %s", email, err)
@@ -145,7 +151,7 @@ func (c *Client) AccountStatus(accountID int, operation string) (*AccountStatusR
 
 	// Post form to Incapsula
 	values := url.Values{"account_id": {strconv.Itoa(accountID)}}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointAccountStatus)
+	reqURL := c.endpointURL(endpointAccountStatus)
 	resp, err := c.PostFormWithHeaders(reqURL, values, operation)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting account status for account id %d: %s", accountID, err)
@@ -190,7 +196,7 @@ func (c *Client) UpdateAccount(accountID, param, value string) (*AccountUpdateRe
 		"param":      {param},
 		"value":      {value},
 	}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointAccountUpdate)
+	reqURL := c.endpointURL(endpointAccountUpdate)
 	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateAccount)
 	if err != nil {
 		return nil, fmt.Errorf("Error updating param (%s) with value (%s) on account_id: %s: %s", param, value, accountID, err)
@@ -231,7 +237,7 @@ func (c *Client) DeleteAccount(accountID int) error {
 
 	// Post form to Incapsula
 	values := url.Values{"account_id": {strconv.Itoa(accountID)}}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointAccountDelete)
+	reqURL := c.endpointURL(endpointAccountDelete)
 	resp, err := c.PostFormWithHeaders(reqURL, values, DeleteAccount)
 	if err != nil {
 		return fmt.Errorf("Error deleting account id: %d: %s", accountID, err)
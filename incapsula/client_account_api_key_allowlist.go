@@ -0,0 +1,89 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Account param of the source IPs allowed to use the API keys of the account
+const accountAPIKeyIPAllowlistParam = "api_key_allowed_ips"
+
+// SetAPIKeyIPAllowlist restricts the source IPs allowed to use the API keys of an account to the given IPs and CIDR
+// ranges. The list is a set: it's sent sorted and without duplicates. An empty list lifts the restriction.
+func (c *Client) SetAPIKeyIPAllowlist(accountID int, ips []string) error {
+	log.Printf("[INFO] Setting Incapsula API key IP allowlist (%v) for account id: %d\n", ips, accountID)
+
+	allowlist, err := normalizeAPIKeyIPAllowlist(ips)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.UpdateAccount(strconv.Itoa(accountID), accountAPIKeyIPAllowlistParam, strings.Join(allowlist, ","))
+	if err != nil {
+		return fmt.Errorf("Error setting API key IP allowlist for account id %d: %s", accountID, err)
+	}
+
+	return nil
+}
+
+// GetAPIKeyIPAllowlist gets the source IPs and CIDR ranges allowed to use the API keys of an account, sorted. The
+// list is empty when the API keys can be used from any IP.
+func (c *Client) GetAPIKeyIPAllowlist(accountID int) ([]string, error) {
+	log.Printf("[INFO] Getting Incapsula API key IP allowlist for account id: %d\n", accountID)
+
+	accountStatusResponse, err := c.AccountStatus(accountID, ReadAccount)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting API key IP allowlist for account id %d: %s", accountID, err)
+	}
+
+	allowlist := make([]string, 0, len(accountStatusResponse.Account.APIKeyAllowedIPs))
+	for _, ip := range accountStatusResponse.Account.APIKeyAllowedIPs {
+		if !contains(allowlist, ip) {
+			allowlist = append(allowlist, ip)
+		}
+	}
+	sort.Strings(allowlist)
+	return allowlist, nil
+}
+
+// normalizeAPIKeyIPAllowlist validates the IPs and CIDR ranges and returns them in their canonical form, sorted and
+// without duplicates, e.g. 2001:db8::1 for 2001:DB8:0::1
+func normalizeAPIKeyIPAllowlist(ips []string) ([]string, error) {
+	allowlist := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		normalized, err := normalizeIPOrCIDR(ip)
+		if err != nil {
+			return nil, err
+		}
+		if !contains(allowlist, normalized) {
+			allowlist = append(allowlist, normalized)
+		}
+	}
+	sort.Strings(allowlist)
+	return allowlist, nil
+}
+
+// normalizeIPOrCIDR returns the canonical form of an IP or a CIDR range. A range must be given by its network
+// address, 10.0.0.1/8 is rejected rather than silently allowing 10.0.0.0/8.
+func normalizeIPOrCIDR(value string) (string, error) {
+	if !strings.Contains(value, "/") {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return "", fmt.Errorf("Error - invalid IP (%s), must be an IP or a CIDR range, e.g. 192.0.2.1 or 192.0.2.0/24", value)
+		}
+		return ip.String(), nil
+	}
+
+	ip, ipNet, err := net.ParseCIDR(value)
+	if err != nil {
+		return "", fmt.Errorf("Error - invalid CIDR range (%s), must be an IP or a CIDR range, e.g. 192.0.2.1 or 192.0.2.0/24", value)
+	}
+	if !ip.Equal(ipNet.IP) {
+		return "", fmt.Errorf("Error - CIDR range %s isn't given by its network address, did you mean %s?", value, ipNet.String())
+	}
+	return ipNet.String(), nil
+}
@@ -0,0 +1,98 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetAPIKeyIPAllowlist / GetAPIKeyIPAllowlist Tests
+////////////////////////////////////////////////////////////////
+
+// apiKeyIPAllowlistServer stores the allowlist set with the account update and serves it back in the account status
+func apiKeyIPAllowlistServer(t *testing.T, updates *[]string) *httptest.Server {
+	allowlist := make([]string, 0)
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		switch req.URL.String() {
+		case fmt.Sprintf("/%s", endpointAccountUpdate):
+			if req.PostForm.Get("account_id") != "123" || req.PostForm.Get("param") != accountAPIKeyIPAllowlistParam {
+				t.Errorf("Unexpected account update: %v", req.PostForm)
+			}
+			value := req.PostForm.Get("value")
+			*updates = append(*updates, value)
+			allowlist = make([]string, 0)
+			if value != "" {
+				allowlist = strings.Split(value, ",")
+			}
+			rw.Write([]byte(`{"account_id":123,"res":0}`))
+		case fmt.Sprintf("/%s", endpointAccountStatus):
+			ips, _ := json.Marshal(allowlist)
+			rw.Write([]byte(fmt.Sprintf(`{"res":0,"account":{"account_id":123,"api_key_allowed_ips":%s}}`, ips)))
+		default:
+			t.Errorf("Unexpected request to %s", req.URL.String())
+		}
+	}))
+}
+
+func TestClientSetAPIKeyIPAllowlistAddRemove(t *testing.T) {
+	updates := make([]string, 0)
+	server := apiKeyIPAllowlistServer(t, &updates)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	steps := []struct {
+		ips      []string
+		expected []string
+	}{
+		{[]string{"198.51.100.7", "192.0.2.0/24"}, []string{"192.0.2.0/24", "198.51.100.7"}},
+		{[]string{"192.0.2.0/24", "198.51.100.7", "2001:DB8:0::1", "192.0.2.0/24"}, []string{"192.0.2.0/24", "198.51.100.7", "2001:db8::1"}},
+		{[]string{"2001:db8::1"}, []string{"2001:db8::1"}},
+		{[]string{}, []string{}},
+	}
+	for _, step := range steps {
+		err := client.SetAPIKeyIPAllowlist(123, step.ips)
+		if err != nil {
+			t.Fatalf("Should not have received an error setting %v, got: %s", step.ips, err)
+		}
+		allowlist, err := client.GetAPIKeyIPAllowlist(123)
+		if err != nil {
+			t.Fatalf("Should not have received an error, got: %s", err)
+		}
+		if !reflect.DeepEqual(allowlist, step.expected) {
+			t.Errorf("Expected allowlist %v after setting %v, got: %v", step.expected, step.ips, allowlist)
+		}
+	}
+
+	expectedUpdates := []string{"192.0.2.0/24,198.51.100.7", "192.0.2.0/24,198.51.100.7,2001:db8::1", "2001:db8::1", ""}
+	if !reflect.DeepEqual(updates, expectedUpdates) {
+		t.Errorf("Expected updates %q, got: %q", expectedUpdates, updates)
+	}
+}
+
+func TestClientSetAPIKeyIPAllowlistInvalid(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	cases := map[string]string{
+		"192.0.2":        "Error - invalid IP (192.0.2)",
+		"example.com":    "Error - invalid IP (example.com)",
+		"192.0.2.0/33":   "Error - invalid CIDR range (192.0.2.0/33)",
+		"192.0.2.0/":     "Error - invalid CIDR range (192.0.2.0/)",
+		"192.0.2.1/24":   "Error - CIDR range 192.0.2.1/24 isn't given by its network address, did you mean 192.0.2.0/24?",
+		"2001:db8::1/32": "Error - CIDR range 2001:db8::1/32 isn't given by its network address, did you mean 2001:db8::/32?",
+	}
+	for ip, expectedError := range cases {
+		err := client.SetAPIKeyIPAllowlist(123, []string{"198.51.100.7", ip})
+		if err == nil || !strings.HasPrefix(err.Error(), expectedError) {
+			t.Errorf("Should have received an error starting with %q for %s, got: %v", expectedError, ip, err)
+		}
+	}
+}
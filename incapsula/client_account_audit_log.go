@@ -0,0 +1,147 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Endpoints (unexported consts)
+const endpointAuditTrailEvents = "/audit-trail/v2/events"
+
+// Paging of the audit log. At most maxAuditEvents events are returned for a single query.
+const auditEventsPageSize = 100
+const maxAuditEvents = 1000
+
+// Longest time range of a single audit log query
+const maxAuditLogRange = 90 * 24 * time.Hour
+
+// AuditEventDTO is an audit event as returned by the audit trail
+type AuditEventDTO struct {
+	Time              int64  `json:"time"`
+	TypeKey           string `json:"type_key"`
+	TypeDescription   string `json:"type_description"`
+	UserID            string `json:"user_id"`
+	UserDetails       string `json:"user_details"`
+	AccountID         int    `json:"account_id"`
+	ResourceTypeKey   string `json:"resource_type_key"`
+	ResourceID        string `json:"resource_id"`
+	ActionKey         string `json:"action_key"`
+	ActionDescription string `json:"action_description"`
+	Message           string `json:"message"`
+}
+
+// AuditEventsResponse is a page of the audit trail
+type AuditEventsResponse struct {
+	Elements []AuditEventDTO `json:"elements"`
+	Total    int             `json:"total"`
+}
+
+// AuditEvent is a change made in an account: who changed what, and when
+type AuditEvent struct {
+	Time         time.Time
+	Type         string
+	UserID       string
+	UserDetails  string
+	AccountID    int
+	ResourceType string
+	ResourceID   string
+	Action       string
+	Message      string
+}
+
+// GetAccountAuditLog gets the audit events of an account between from and to, oldest first. The time range can't exceed
+// 90 days and at most 1000 events are returned.
+func (c *Client) GetAccountAuditLog(accountID int, from, to time.Time) ([]AuditEvent, error) {
+	log.Printf("[INFO] Getting Incapsula audit log for account %d from %s to %s\n", accountID, from, to)
+
+	err := validateAuditLogRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	auditEvents := make([]AuditEvent, 0)
+	for offset := 0; offset < maxAuditEvents; offset += auditEventsPageSize {
+		auditEventsResponse, err := c.getAuditEventsPage(accountID, from, to, offset)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range auditEventsResponse.Elements {
+			if len(auditEvents) == maxAuditEvents {
+				break
+			}
+			auditEvents = append(auditEvents, AuditEvent{
+				Time:         time.Unix(0, event.Time*int64(time.Millisecond)).UTC(),
+				Type:         event.TypeKey,
+				UserID:       event.UserID,
+				UserDetails:  event.UserDetails,
+				AccountID:    event.AccountID,
+				ResourceType: event.ResourceTypeKey,
+				ResourceID:   event.ResourceID,
+				Action:       event.ActionKey,
+				Message:      event.Message,
+			})
+		}
+
+		if len(auditEventsResponse.Elements) < auditEventsPageSize || offset+auditEventsPageSize >= auditEventsResponse.Total {
+			break
+		}
+	}
+
+	if len(auditEvents) == maxAuditEvents {
+		log.Printf("[WARN] Incapsula audit log for account %d was capped to %d events, narrow the time range to get all of them\n", accountID, maxAuditEvents)
+	}
+
+	return auditEvents, nil
+}
+
+// validateAuditLogRange checks that from is before to and that the range doesn't exceed maxAuditLogRange
+func validateAuditLogRange(from, to time.Time) error {
+	if !from.Before(to) {
+		return fmt.Errorf("Error - invalid audit log time range, from (%s) must be before to (%s)", from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+	if to.Sub(from) > maxAuditLogRange {
+		return fmt.Errorf("Error - invalid audit log time range, it can't exceed %d days", int(maxAuditLogRange.Hours()/24))
+	}
+	return nil
+}
+
+// getAuditEventsPage gets a single page of the audit events of an account
+func (c *Client) getAuditEventsPage(accountID int, from, to time.Time, offset int) (*AuditEventsResponse, error) {
+	params := GetRequestParamsWithCaid(accountID)
+	params["start"] = strconv.FormatInt(from.UnixNano()/int64(time.Millisecond), 10)
+	params["end"] = strconv.FormatInt(to.UnixNano()/int64(time.Millisecond), 10)
+	params["offset"] = strconv.Itoa(offset)
+	params["limit"] = strconv.Itoa(auditEventsPageSize)
+	reqURL := c.endpointURL(endpointAuditTrailEvents)
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(http.MethodGet, reqURL, nil, params, ReadAccountAuditLog)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error from Incapsula service when reading audit log for account %d: %s", accountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Read Audit Log (offset %d) JSON response: %s\n", offset, string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("[ERROR] Error status code %d from Incapsula service when reading audit log for account %d: %s", resp.StatusCode, accountID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var auditEventsResponse AuditEventsResponse
+	err = json.Unmarshal([]byte(responseBody), &auditEventsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing audit log JSON response for account %d: %s\nresponse: %s", accountID, err, string(responseBody))
+	}
+
+	return &auditEventsResponse, nil
+}
@@ -0,0 +1,109 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// GetAccountAuditLog Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetAccountAuditLogInvalidRange(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	from := time.Date(2022, 5, 8, 0, 0, 0, 0, time.UTC)
+
+	_, err := client.GetAccountAuditLog(42, from, from.Add(-time.Hour))
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid audit log time range, from") {
+		t.Errorf("Should have received an invalid time range error, got: %v", err)
+	}
+
+	_, err = client.GetAccountAuditLog(42, from, from.Add(91*24*time.Hour))
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid audit log time range, it can't exceed 90 days") {
+		t.Errorf("Should have received a time range too long error, got: %v", err)
+	}
+}
+
+func TestClientGetAccountAuditLogBadJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	from := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	_, err := client.GetAccountAuditLog(42, from, from.Add(time.Hour))
+	if err == nil || !strings.HasPrefix(err.Error(), "[ERROR] Error parsing audit log JSON response for account 42") {
+		t.Errorf("Should have received a JSON parse error, got: %v", err)
+	}
+}
+
+func TestClientGetAccountAuditLogValidEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != endpointAuditTrailEvents {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpointAuditTrailEvents, req.URL.Path)
+		}
+		query := req.URL.Query()
+		if query.Get("caid") != "42" || query.Get("start") != "1651363200000" || query.Get("end") != "1651968000000" {
+			t.Errorf("Unexpected query params, got: %s", req.URL.RawQuery)
+		}
+		rw.Write([]byte(`{"elements":[
+			{"time":1651395600000,"type_key":"SITE_SETTINGS_CHANGED","user_id":"7","user_details":"admin@example.com","account_id":42,"resource_type_key":"SITE","resource_id":"123","action_key":"UPDATE","message":"Changed acceleration level"},
+			{"time":1651482000000,"type_key":"USER_ADDED","user_id":"7","user_details":"admin@example.com","account_id":42,"resource_type_key":"USER","resource_id":"8","action_key":"CREATE","message":"Added user"}
+		],"total":2}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	from := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	auditEvents, err := client.GetAccountAuditLog(42, from, from.Add(7*24*time.Hour))
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(auditEvents) != 2 {
+		t.Fatalf("Should have received 2 events, got: %d", len(auditEvents))
+	}
+	if !auditEvents[0].Time.Equal(time.Date(2022, 5, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected event time, got: %s", auditEvents[0].Time)
+	}
+	if auditEvents[0].Type != "SITE_SETTINGS_CHANGED" || auditEvents[0].ResourceType != "SITE" || auditEvents[0].ResourceID != "123" || auditEvents[0].Action != "UPDATE" {
+		t.Errorf("Unexpected event, got: %+v", auditEvents[0])
+	}
+	if auditEvents[1].UserDetails != "admin@example.com" || auditEvents[1].AccountID != 42 || auditEvents[1].Message != "Added user" {
+		t.Errorf("Unexpected event, got: %+v", auditEvents[1])
+	}
+}
+
+func TestClientGetAccountAuditLogCapped(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		elements := make([]string, auditEventsPageSize)
+		for i := range elements {
+			elements[i] = fmt.Sprintf(`{"time":1651395600000,"resource_id":"%d"}`, i+1)
+		}
+		rw.Write([]byte(fmt.Sprintf(`{"elements":[%s],"total":5000}`, strings.Join(elements, ","))))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	from := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	auditEvents, err := client.GetAccountAuditLog(42, from, from.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(auditEvents) != maxAuditEvents {
+		t.Errorf("Should have capped the events to %d, got: %d", maxAuditEvents, len(auditEvents))
+	}
+	if requests != maxAuditEvents/auditEventsPageSize {
+		t.Errorf("Should have made %d requests, got: %d", maxAuditEvents/auditEventsPageSize, requests)
+	}
+}
@@ -0,0 +1,117 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const endpointAccountCertificates = "/certificates-ui/v3/certificates"
+
+// Number of certificates requested per page when listing the certificates of an account
+const accountCertificatesPageSize = 100
+
+// AccountCertificateDTO is a certificate as returned by the certificates list
+type AccountCertificateDTO struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	Status         string `json:"status"`
+	ExpirationDate int64  `json:"expirationDate"`
+	Sans           []struct {
+		SanValue string `json:"sanValue"`
+	} `json:"sans"`
+}
+
+// AccountCertificatesResponse is a page of the certificates list
+type AccountCertificatesResponse struct {
+	Data   []AccountCertificateDTO `json:"data"`
+	Errors []APIErrors             `json:"errors"`
+}
+
+// CertificateSummary contains the SANs, status and expiry of a certificate
+type CertificateSummary struct {
+	ID             int
+	Name           string
+	Type           string
+	Status         string
+	ExpirationDate int64
+	SANs           []string
+}
+
+// ExpiresWithin returns true when the certificate expires in less than d (or already expired).
+// ExpirationDate is in milliseconds since the epoch.
+func (s CertificateSummary) ExpiresWithin(d time.Duration) bool {
+	return time.Unix(0, s.ExpirationDate*int64(time.Millisecond)).Before(time.Now().Add(d))
+}
+
+// ListAccountCertificates gets all the certificates of an account, across its sites
+func (c *Client) ListAccountCertificates(accountID int) ([]CertificateSummary, error) {
+	log.Printf("[INFO] Getting Incapsula certificates for account: %d\n", accountID)
+
+	certificates := make([]CertificateSummary, 0)
+	for pageNum := 0; ; pageNum++ {
+		accountCertificatesResponse, err := c.getAccountCertificatesPage(accountID, pageNum)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, certificate := range accountCertificatesResponse.Data {
+			sans := make([]string, len(certificate.Sans))
+			for i, san := range certificate.Sans {
+				sans[i] = san.SanValue
+			}
+			certificates = append(certificates, CertificateSummary{
+				ID:             certificate.ID,
+				Name:           certificate.Name,
+				Type:           certificate.Type,
+				Status:         certificate.Status,
+				ExpirationDate: certificate.ExpirationDate,
+				SANs:           sans,
+			})
+		}
+
+		if len(accountCertificatesResponse.Data) < accountCertificatesPageSize {
+			break
+		}
+	}
+
+	return certificates, nil
+}
+
+// getAccountCertificatesPage gets a single page of the certificates of an account
+func (c *Client) getAccountCertificatesPage(accountID, pageNum int) (*AccountCertificatesResponse, error) {
+	params := GetRequestParamsWithCaid(accountID)
+	params["pageNum"] = strconv.Itoa(pageNum)
+	params["pageSize"] = strconv.Itoa(accountCertificatesPageSize)
+	reqURL := c.endpointURL(endpointAccountCertificates)
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(http.MethodGet, reqURL, nil, params, ReadAccountCertificates)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error from Incapsula service when reading certificates for account %d: %s", accountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Read Account Certificates (page %d) JSON response: %s\n", pageNum, string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("[ERROR] Error status code %d from Incapsula service when reading certificates for account %d: %s", resp.StatusCode, accountID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var accountCertificatesResponse AccountCertificatesResponse
+	err = json.Unmarshal([]byte(responseBody), &accountCertificatesResponse)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing certificates JSON response for account %d: %s\nresponse: %s", accountID, err, string(responseBody))
+	}
+
+	return &accountCertificatesResponse, nil
+}
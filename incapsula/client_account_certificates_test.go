@@ -0,0 +1,106 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// ListAccountCertificates Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientListAccountCertificates(t *testing.T) {
+	expiringDate := time.Now().Add(7*24*time.Hour).UnixNano() / int64(time.Millisecond)
+	validDate := time.Now().Add(300*24*time.Hour).UnixNano() / int64(time.Millisecond)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != endpointAccountCertificates {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpointAccountCertificates, req.URL.Path)
+		}
+		if req.URL.Query().Get("caid") != "7" {
+			t.Errorf("Expected caid to be 7, got: %s", req.URL.Query().Get("caid"))
+		}
+		if _, ok := req.URL.Query()["extSiteId"]; ok {
+			t.Errorf("Should not have filtered by site")
+		}
+		rw.Write([]byte(fmt.Sprintf(`{"data":[
+			{"id":1,"name":"www.example.com","type":"CUSTOM_CERTIFICATE","status":"ACTIVE","expirationDate":%d,"sans":[{"sanValue":"www.example.com"},{"sanValue":"example.com"}]},
+			{"id":2,"name":"*.example.org","type":"ATLAS","status":"ACTIVE","expirationDate":%d,"sans":[{"sanValue":"*.example.org"}]}]}`, expiringDate, validDate)))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	certificates, err := client.ListAccountCertificates(7)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	expected := []CertificateSummary{
+		{ID: 1, Name: "www.example.com", Type: "CUSTOM_CERTIFICATE", Status: "ACTIVE", ExpirationDate: expiringDate, SANs: []string{"www.example.com", "example.com"}},
+		{ID: 2, Name: "*.example.org", Type: "ATLAS", Status: "ACTIVE", ExpirationDate: validDate, SANs: []string{"*.example.org"}},
+	}
+	if !reflect.DeepEqual(certificates, expected) {
+		t.Errorf("Unexpected certificates, expected %+v, got: %+v", expected, certificates)
+	}
+	if !certificates[0].ExpiresWithin(30 * 24 * time.Hour) {
+		t.Errorf("Certificate %d should have been expiring within 30 days", certificates[0].ID)
+	}
+	if certificates[1].ExpiresWithin(30 * 24 * time.Hour) {
+		t.Errorf("Certificate %d should not have been expiring within 30 days", certificates[1].ID)
+	}
+}
+
+func TestClientListAccountCertificatesPagination(t *testing.T) {
+	requestedPages := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		pageNum := req.URL.Query().Get("pageNum")
+		requestedPages = append(requestedPages, pageNum)
+		// A full first page, then a partial last page
+		pageSize := accountCertificatesPageSize
+		if pageNum == "1" {
+			pageSize = 3
+		}
+		certificates := make([]string, pageSize)
+		for i := range certificates {
+			certificates[i] = fmt.Sprintf(`{"id":%d,"status":"ACTIVE"}`, i+1)
+		}
+		rw.Write([]byte(fmt.Sprintf(`{"data":[%s]}`, strings.Join(certificates, ","))))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	certificates, err := client.ListAccountCertificates(7)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(certificates) != accountCertificatesPageSize+3 {
+		t.Errorf("Expected %d certificates, got: %d", accountCertificatesPageSize+3, len(certificates))
+	}
+	if !reflect.DeepEqual(requestedPages, []string{"0", "1"}) {
+		t.Errorf("Expected pages 0 and 1 to be requested, got: %v", requestedPages)
+	}
+}
+
+func TestClientListAccountCertificatesBadStatusCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(401)
+		rw.Write([]byte(`{"errors":[{"status":401,"title":"Unauthorized"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.ListAccountCertificates(7)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "[ERROR] Error status code 401 from Incapsula service when reading certificates for account 7") {
+		t.Errorf("Should have received a bad status code error, got: %s", err)
+	}
+}
@@ -0,0 +1,93 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// AccountInput are the settings an account is created with. Only the email is required.
+type AccountInput struct {
+	Email         string
+	AccountName   string
+	UserName      string
+	PlanID        string
+	RefID         string
+	LogLevel      string
+	LogsAccountID int
+}
+
+// Account is an account created with CreateAccount
+type Account struct {
+	AccountID   int
+	ParentID    int
+	Email       string
+	AccountName string
+	UserName    string
+	PlanID      string
+}
+
+// CreateAccount adds an account under a parent account, the invoking account when parentAccountID is 0. The add
+// account response doesn't carry credentials, the account owner logs in with the email.
+func (c *Client) CreateAccount(parentAccountID int, input AccountInput) (*Account, error) {
+	log.Printf("[INFO] Creating Incapsula account for email: %s (parent account ID %d)\n", input.Email, parentAccountID)
+
+	if !strings.Contains(input.Email, "@") {
+		return nil, fmt.Errorf("Error - invalid account email (%s)", input.Email)
+	}
+	if input.LogLevel != "" && !contains(accountDefaultLogLevels, input.LogLevel) {
+		return nil, fmt.Errorf("Error - invalid account log level (%s), must be one of %v", input.LogLevel, accountDefaultLogLevels)
+	}
+
+	values := url.Values{
+		"email":        {input.Email},
+		"user_name":    {input.UserName},
+		"plan_id":      {input.PlanID},
+		"ref_id":       {input.RefID},
+		"account_name": {input.AccountName},
+		"log_level":    {input.LogLevel},
+	}
+	if parentAccountID != 0 {
+		values.Set("parent_id", strconv.Itoa(parentAccountID))
+	}
+	if input.LogsAccountID != 0 {
+		values.Set("logs_account_id", strconv.Itoa(input.LogsAccountID))
+	}
+
+	var accountAddResponse AccountAddResponse
+	responseBody, err := c.postFormAndDecode(c.endpointURL(endpointAccountAdd), values, CreateAccount, &accountAddResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating account for email %s: %s", input.Email, err)
+	}
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula create account JSON response: %s\n", string(responseBody))
+
+	if accountAddResponse.Res != 0 {
+		err = newIncapsulaError(strconv.Itoa(accountAddResponse.Res), accountAddResponse.DebugInfo, "Error from Incapsula service when creating account for email %s: %s", input.Email, string(responseBody))
+		return nil, createAccountError(parentAccountID, input.Email, err)
+	}
+
+	return &Account{
+		AccountID:   accountAddResponse.Account.AccountID,
+		ParentID:    accountAddResponse.Account.ParentID,
+		Email:       accountAddResponse.Account.Email,
+		AccountName: accountAddResponse.Account.AccountName,
+		UserName:    accountAddResponse.Account.UserName,
+		PlanID:      accountAddResponse.Account.PlanID,
+	}, nil
+}
+
+// createAccountError explains the errors of accounts/add that need an action from the user
+func createAccountError(parentAccountID int, email string, err error) error {
+	if isOperationNotAllowed(err) {
+		parent := "the invoking account"
+		if parentAccountID != 0 {
+			parent = fmt.Sprintf("parent account %d", parentAccountID)
+		}
+		return fmt.Errorf("Error creating account for email %s: %s isn't allowed to add more accounts, it may have reached its account quota: %s", email, parent, err)
+	}
+	return err
+}
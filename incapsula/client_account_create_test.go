@@ -0,0 +1,76 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// CreateAccount Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientCreateAccountInvalidInput(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.CreateAccount(0, AccountInput{Email: "example.com"})
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid account email (example.com)") {
+		t.Errorf("Should have received an invalid email error, got: %v", err)
+	}
+	_, err = client.CreateAccount(0, AccountInput{Email: "joe@example.com", LogLevel: "verbose"})
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid account log level (verbose)") {
+		t.Errorf("Should have received an invalid log level error, got: %v", err)
+	}
+}
+
+func TestClientCreateAccountValidParent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointAccountAdd) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointAccountAdd, req.URL.String())
+		}
+		req.ParseForm()
+		expected := url.Values{
+			"email":        {"joe@example.com"},
+			"user_name":    {"Joe Doe"},
+			"plan_id":      {"ent100"},
+			"ref_id":       {""},
+			"account_name": {"Joe's shop"},
+			"log_level":    {"security"},
+			"parent_id":    {"123"},
+		}
+		if !reflect.DeepEqual(req.PostForm, expected) {
+			t.Errorf("Expected request body %v, got: %v", expected, req.PostForm)
+		}
+		rw.Write([]byte(`{"account":{"account_id":456,"parent_id":123,"email":"joe@example.com","plan_id":"ent100","user_name":"Joe Doe","account_name":"Joe's shop"},"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	account, err := client.CreateAccount(123, AccountInput{Email: "joe@example.com", AccountName: "Joe's shop", UserName: "Joe Doe", PlanID: "ent100", LogLevel: "security"})
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	expected := Account{AccountID: 456, ParentID: 123, Email: "joe@example.com", AccountName: "Joe's shop", UserName: "Joe Doe", PlanID: "ent100"}
+	if *account != expected {
+		t.Errorf("Expected account %+v, got: %+v", expected, *account)
+	}
+}
+
+func TestClientCreateAccountQuotaReached(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":9415,"res_message":"Operation not allowed","debug_info":{"id-info":"13007"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.CreateAccount(123, AccountInput{Email: "joe@example.com"})
+	if err == nil || !strings.HasPrefix(err.Error(), "Error creating account for email joe@example.com: parent account 123 isn't allowed to add more accounts") {
+		t.Errorf("Should have received a quota error, got: %v", err)
+	}
+}
@@ -6,19 +6,24 @@ import (
 	"io/ioutil"
 	"log"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 const endpointAccountDataStorageRegionGet = "accounts/data-privacy/show"
 const endpointAccountDataStorageRegionUpdate = "accounts/data-privacy/set-region-default"
+const endpointAccountAllowedRegionsUpdate = "accounts/data-privacy/set-allowed-regions"
+
+// Data storage region codes
+var dataStorageRegions = []string{"APAC", "EU", "US", "AU"}
 
 // AccountDataStorageRegionResponse contains the relevant information when getting/setting a default data storage region
 type AccountDataStorageRegionResponse struct {
-	Region     string `json:"region"`
-	Res        int    `json:"res"`
-	ResMessage string `json:"res_message"`
-	DebugInfo  struct {
-		IDInfo string `json:"id-info"`
-	} `json:"debug_info"`
+	Region         string    `json:"region"`
+	AllowedRegions []string  `json:"allowed_regions,omitempty"`
+	Res            int       `json:"res"`
+	ResMessage     string    `json:"res_message"`
+	DebugInfo      DebugInfo `json:"debug_info"`
 }
 
 // GetAccountDataStorageRegion gets the default data storage region for sites in the account
@@ -27,7 +32,7 @@ func (c *Client) GetAccountDataStorageRegion(accountID string) (*AccountDataStor
 
 	// Post form to Incapsula
 	values := url.Values{"account_id": {accountID}}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointAccountDataStorageRegionGet)
+	reqURL := c.endpointURL(endpointAccountDataStorageRegionGet)
 	resp, err := c.PostFormWithHeaders(reqURL, values, ReadAccountDataStorageRegion)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting default data storage region for account id: %s: %s", accountID, err)
@@ -64,7 +69,7 @@ func (c *Client) UpdateAccountDataStorageRegion(accountID, region string) (*Acco
 		"account_id":          {accountID},
 		"data_storage_region": {region},
 	}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointAccountDataStorageRegionUpdate)
+	reqURL := c.endpointURL(endpointAccountDataStorageRegionUpdate)
 	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateAccountDataStorageRegion)
 	if err != nil {
 		return nil, fmt.Errorf("Error updating data storage region with value (%s) on account_id: %s: %s", region, accountID, err)
@@ -91,3 +96,74 @@ func (c *Client) UpdateAccountDataStorageRegion(accountID, region string) (*Acco
 
 	return &accountDataStorageRegionResponse, nil
 }
+
+// GetAccountDataRegions gets the data storage regions allowed for the sites of an account. Accounts which aren't on a
+// multi-region plan only have their default region.
+func (c *Client) GetAccountDataRegions(accountID int) ([]string, error) {
+	accountDataStorageRegionResponse, err := c.GetAccountDataStorageRegion(strconv.Itoa(accountID))
+	if err != nil {
+		return nil, err
+	}
+	return accountDataRegions(accountDataStorageRegionResponse), nil
+}
+
+// SetAccountAllowedRegions sets the data storage regions allowed for the sites of an account on a multi-region plan.
+// The default region of the account must be one of them.
+func (c *Client) SetAccountAllowedRegions(accountID int, regions []string) error {
+	log.Printf("[INFO] Setting Incapsula allowed data storage regions (%v) for account: %d\n", regions, accountID)
+
+	err := validateDataStorageRegions(regions)
+	if err != nil {
+		return err
+	}
+
+	values := url.Values{
+		"account_id":      {strconv.Itoa(accountID)},
+		"allowed_regions": {strings.Join(regions, ",")},
+	}
+	var accountDataStorageRegionResponse AccountDataStorageRegionResponse
+	responseBody, err := c.postFormAndDecode(c.endpointURL(endpointAccountAllowedRegionsUpdate), values, UpdateAccountDataStorageRegion, &accountDataStorageRegionResponse)
+	if err != nil {
+		return fmt.Errorf("Error setting allowed data storage regions for account %d: %s", accountID, err)
+	}
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula set account allowed data storage regions JSON response: %s\n", string(responseBody))
+
+	if accountDataStorageRegionResponse.Res != 0 {
+		err = newIncapsulaError(strconv.Itoa(accountDataStorageRegionResponse.Res), accountDataStorageRegionResponse.DebugInfo, "Error from Incapsula service when setting allowed data storage regions for account %d: %s", accountID, string(responseBody))
+		if isFeatureNotPermitted(err) {
+			return fmt.Errorf("Error setting allowed data storage regions for account %d: the account isn't on a multi-region plan: %s", accountID, err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func accountDataRegions(accountDataStorageRegionResponse *AccountDataStorageRegionResponse) []string {
+	if len(accountDataStorageRegionResponse.AllowedRegions) > 0 {
+		return accountDataStorageRegionResponse.AllowedRegions
+	}
+	if accountDataStorageRegionResponse.Region == "" {
+		return []string{}
+	}
+	return []string{accountDataStorageRegionResponse.Region}
+}
+
+func validateDataStorageRegions(regions []string) error {
+	if len(regions) == 0 {
+		return fmt.Errorf("Error - at least one data storage region must be allowed")
+	}
+	seenRegions := make(map[string]bool)
+	for _, region := range regions {
+		if !contains(dataStorageRegions, region) {
+			return fmt.Errorf("Error - invalid data storage region (%s), must be one of %v", region, dataStorageRegions)
+		}
+		if seenRegions[region] {
+			return fmt.Errorf("Error - duplicate data storage region (%s)", region)
+		}
+		seenRegions[region] = true
+	}
+	return nil
+}
@@ -202,3 +202,100 @@ func TestClientUpdateAccountDataStorageRegionValidSite(t *testing.T) {
 		t.Errorf("Response code doesn't match")
 	}
 }
+
+////////////////////////////////////////////////////////////////
+// GetAccountDataRegions Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetAccountDataRegionsMultiRegion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"region":"EU","allowed_regions":["EU","US"],"res":0,"res_message":"OK"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	regions, err := client.GetAccountDataRegions(42)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if strings.Join(regions, ",") != "EU,US" {
+		t.Errorf("Expected regions to be EU,US, got: %v", regions)
+	}
+}
+
+func TestClientGetAccountDataRegionsSingleRegion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"region":"US","res":0,"res_message":"OK"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	regions, err := client.GetAccountDataRegions(42)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if strings.Join(regions, ",") != "US" {
+		t.Errorf("Expected the default region only, got: %v", regions)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// SetAccountAllowedRegions Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetAccountAllowedRegionsRequestBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointAccountAllowedRegionsUpdate) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointAccountAllowedRegionsUpdate, req.URL.String())
+		}
+		req.ParseForm()
+		if req.PostForm.Get("account_id") != "42" || req.PostForm.Get("allowed_regions") != "EU,APAC" {
+			t.Errorf("Unexpected account_id/allowed_regions, got: %s/%s", req.PostForm.Get("account_id"), req.PostForm.Get("allowed_regions"))
+		}
+		rw.Write([]byte(`{"res":0,"res_message":"OK"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetAccountAllowedRegions(42, []string{"EU", "APAC"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetAccountAllowedRegionsInvalidRegions(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	cases := []struct {
+		regions       []string
+		expectedError string
+	}{
+		{[]string{"EU", "MARS"}, "Error - invalid data storage region (MARS)"},
+		{[]string{"eu"}, "Error - invalid data storage region (eu)"},
+		{[]string{"EU", "EU"}, "Error - duplicate data storage region (EU)"},
+		{[]string{}, "Error - at least one data storage region must be allowed"},
+	}
+	for _, c := range cases {
+		err := client.SetAccountAllowedRegions(42, c.regions)
+		if err == nil || !strings.HasPrefix(err.Error(), c.expectedError) {
+			t.Errorf("%v: Should have received an error starting with %q, got: %v", c.regions, c.expectedError, err)
+		}
+	}
+}
+
+func TestClientSetAccountAllowedRegionsNotMultiRegion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":9414,"res_message":"Feature not permitted","debug_info":{"id-info":"13008"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetAccountAllowedRegions(42, []string{"EU", "US"})
+	if err == nil || !strings.HasPrefix(err.Error(), "Error setting allowed data storage regions for account 42: the account isn't on a multi-region plan") {
+		t.Errorf("Should have received a not multi-region error, got: %v", err)
+	}
+}
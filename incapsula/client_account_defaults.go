@@ -0,0 +1,169 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// Account params applied to the sites created in the account
+const (
+	accountDefaultAccelerationLevelParam = "default_acceleration_level"
+	accountDefaultLogLevelParam          = "default_log_level"
+	accountNakedDomainSANParam           = "naked_domain_san_for_new_www_sites"
+	accountWildcardSANParam              = "wildcard_san_for_new_sites"
+	accountRestrictedCnameReuseParam     = "restricted_cname_reuse_for_new_sites"
+	accountBlockNonEssentialBotsParam    = "default_block_non_essential_bots"
+)
+
+var accountDefaultAccelerationLevels = []string{"none", "standard", "aggressive"}
+var accountDefaultLogLevels = []string{"full", "security", "none"}
+var accountWildcardSANModes = []string{"True", "False", "Default"}
+
+// AccountDefaults are the settings new sites of an account are created with
+type AccountDefaults struct {
+	AccelerationLevel            string
+	LogLevel                     string
+	NakedDomainSANForNewWWWSites bool
+	WildcardSANForNewSites       string
+	RestrictedCnameReuse         bool
+	BlockNonEssentialBots        bool
+}
+
+// SetAccountDefaultAcceleration sets the acceleration level new sites of an account are created with. Existing sites
+// keep their acceleration level.
+func (c *Client) SetAccountDefaultAcceleration(accountID int, level string) error {
+	log.Printf("[INFO] Setting Incapsula default acceleration level (%s) for account: %d\n", level, accountID)
+
+	if !contains(accountDefaultAccelerationLevels, level) {
+		return fmt.Errorf("Error - invalid default acceleration level (%s), must be one of %v", level, accountDefaultAccelerationLevels)
+	}
+
+	_, err := c.UpdateAccount(strconv.Itoa(accountID), accountDefaultAccelerationLevelParam, level)
+	if err != nil {
+		return fmt.Errorf("Error setting default acceleration level for account %d: %s", accountID, err)
+	}
+
+	return nil
+}
+
+// GetAccountDefaultAcceleration gets the acceleration level new sites of an account are created with
+func (c *Client) GetAccountDefaultAcceleration(accountID int) (string, error) {
+	accountDefaults, err := c.GetAccountDefaults(accountID)
+	if err != nil {
+		return "", err
+	}
+	return accountDefaults.AccelerationLevel, nil
+}
+
+// SetAccountDefaultLogLevel sets the log level new sites of an account are created with
+func (c *Client) SetAccountDefaultLogLevel(accountID int, logLevel string) error {
+	log.Printf("[INFO] Setting Incapsula default log level (%s) for account: %d\n", logLevel, accountID)
+
+	if !contains(accountDefaultLogLevels, logLevel) {
+		return fmt.Errorf("Error - invalid default log level (%s), must be one of %v", logLevel, accountDefaultLogLevels)
+	}
+
+	_, err := c.UpdateAccount(strconv.Itoa(accountID), accountDefaultLogLevelParam, logLevel)
+	if err != nil {
+		return fmt.Errorf("Error setting default log level for account %d: %s", accountID, err)
+	}
+
+	return nil
+}
+
+// SetAccountDefaultSANs sets the SANs added to the generated certificates of new sites of an account. wildcardSAN is
+// True, False or Default.
+func (c *Client) SetAccountDefaultSANs(accountID int, nakedDomainSAN bool, wildcardSAN string) error {
+	log.Printf("[INFO] Setting Incapsula default SANs (naked domain: %t, wildcard: %s) for account: %d\n", nakedDomainSAN, wildcardSAN, accountID)
+
+	if !contains(accountWildcardSANModes, wildcardSAN) {
+		return fmt.Errorf("Error - invalid wildcard SAN setting (%s), must be one of %v", wildcardSAN, accountWildcardSANModes)
+	}
+
+	_, err := c.UpdateAccount(strconv.Itoa(accountID), accountNakedDomainSANParam, strconv.FormatBool(nakedDomainSAN))
+	if err != nil {
+		return fmt.Errorf("Error setting default naked domain SAN for account %d: %s", accountID, err)
+	}
+
+	_, err = c.UpdateAccount(strconv.Itoa(accountID), accountWildcardSANParam, wildcardSAN)
+	if err != nil {
+		return fmt.Errorf("Error setting default wildcard SAN for account %d: %s", accountID, err)
+	}
+
+	return nil
+}
+
+// GetAccountNakedDomainSANDefault gets whether the naked domain SAN is added to the generated certificate of new www
+// sites of an account
+func (c *Client) GetAccountNakedDomainSANDefault(accountID int) (bool, error) {
+	accountDefaults, err := c.GetAccountDefaults(accountID)
+	if err != nil {
+		return false, err
+	}
+	return accountDefaults.NakedDomainSANForNewWWWSites, nil
+}
+
+// SetAccountCnameReuseDefault sets whether the CNAME reuse of new sites of an account is restricted. Sites can still
+// override it with their restricted_cname_reuse flag.
+func (c *Client) SetAccountCnameReuseDefault(accountID int, restricted bool) error {
+	log.Printf("[INFO] Setting Incapsula default restricted CNAME reuse (%t) for account: %d\n", restricted, accountID)
+
+	_, err := c.UpdateAccount(strconv.Itoa(accountID), accountRestrictedCnameReuseParam, strconv.FormatBool(restricted))
+	if err != nil {
+		return fmt.Errorf("Error setting default restricted CNAME reuse for account %d: %s", accountID, err)
+	}
+
+	return nil
+}
+
+// GetAccountCnameReuseDefault gets whether the CNAME reuse of new sites of an account is restricted
+func (c *Client) GetAccountCnameReuseDefault(accountID int) (bool, error) {
+	accountDefaults, err := c.GetAccountDefaults(accountID)
+	if err != nil {
+		return false, err
+	}
+	return accountDefaults.RestrictedCnameReuse, nil
+}
+
+// SetAccountBlockNonEssentialBotsDefault sets whether the bot access control rule of new sites of an account blocks
+// non-essential bots. Existing sites keep their setting, and sites can override it with their own bot access control rule.
+func (c *Client) SetAccountBlockNonEssentialBotsDefault(accountID int, block bool) error {
+	log.Printf("[INFO] Setting Incapsula default block non-essential bots (%t) for account: %d\n", block, accountID)
+
+	_, err := c.UpdateAccount(strconv.Itoa(accountID), accountBlockNonEssentialBotsParam, strconv.FormatBool(block))
+	if err != nil {
+		return fmt.Errorf("Error setting default block non-essential bots for account %d: %s", accountID, err)
+	}
+
+	return nil
+}
+
+// GetAccountBlockNonEssentialBotsDefault gets whether the bot access control rule of new sites of an account blocks
+// non-essential bots
+func (c *Client) GetAccountBlockNonEssentialBotsDefault(accountID int) (bool, error) {
+	accountDefaults, err := c.GetAccountDefaults(accountID)
+	if err != nil {
+		return false, err
+	}
+	return accountDefaults.BlockNonEssentialBots, nil
+}
+
+// GetAccountDefaults gets the settings new sites of an account are created with, from the account status
+func (c *Client) GetAccountDefaults(accountID int) (*AccountDefaults, error) {
+	log.Printf("[INFO] Getting Incapsula defaults for account: %d\n", accountID)
+
+	accountStatusResponse, err := c.AccountStatus(accountID, ReadAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountDefaults{
+		AccelerationLevel:            accountStatusResponse.Account.DefaultAccelerationLevel,
+		LogLevel:                     accountStatusResponse.Account.DefaultLogLevel,
+		NakedDomainSANForNewWWWSites: accountStatusResponse.Account.NakedDomainSANForNewWWWSites,
+		WildcardSANForNewSites:       accountStatusResponse.Account.WildcardSANForNewSites,
+		RestrictedCnameReuse:         accountStatusResponse.Account.RestrictedCnameReuseForNewSites,
+		BlockNonEssentialBots:        accountStatusResponse.Account.DefaultBlockNonEssentialBots,
+	}, nil
+}
@@ -0,0 +1,131 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetAccountDefaultAcceleration Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetAccountDefaultAcceleration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointAccountUpdate) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointAccountUpdate, req.URL.String())
+		}
+		req.ParseForm()
+		if req.PostForm.Get("account_id") != "42" || req.PostForm.Get("param") != "default_acceleration_level" || req.PostForm.Get("value") != "aggressive" {
+			t.Errorf("Unexpected account_id/param/value, got: %s/%s/%s", req.PostForm.Get("account_id"), req.PostForm.Get("param"), req.PostForm.Get("value"))
+		}
+		rw.Write([]byte(`{"account_id":42,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetAccountDefaultAcceleration(42, "aggressive")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetAccountDefaultAccelerationInvalidLevel(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetAccountDefaultAcceleration(42, "maximum")
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid default acceleration level (maximum)") {
+		t.Errorf("Should have received an invalid level error, got: %v", err)
+	}
+}
+
+func TestClientGetAccountDefaults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointAccountStatus) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointAccountStatus, req.URL.String())
+		}
+		rw.Write([]byte(`{"account":{"account_id":42,"default_acceleration_level":"standard","default_log_level":"security","naked_domain_san_for_new_www_sites":true,"wildcard_san_for_new_sites":"False"},"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	accountDefaults, err := client.GetAccountDefaults(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	expected := AccountDefaults{AccelerationLevel: "standard", LogLevel: "security", NakedDomainSANForNewWWWSites: true, WildcardSANForNewSites: "False"}
+	if !reflect.DeepEqual(*accountDefaults, expected) {
+		t.Errorf("Unexpected account defaults, expected %+v, got: %+v", expected, *accountDefaults)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// SetAccountDefaultSANs Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetAccountDefaultSANs(t *testing.T) {
+	params := make(map[string]string)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		params[req.PostForm.Get("param")] = req.PostForm.Get("value")
+		rw.Write([]byte(`{"account_id":42,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetAccountDefaultSANs(42, false, "True")
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if params["naked_domain_san_for_new_www_sites"] != "false" || params["wildcard_san_for_new_sites"] != "True" {
+		t.Errorf("Unexpected params, got: %v", params)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// SetAccountCnameReuseDefault Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetAccountCnameReuseDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointAccountUpdate) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointAccountUpdate, req.URL.String())
+		}
+		req.ParseForm()
+		if req.PostForm.Get("account_id") != "42" || req.PostForm.Get("param") != "restricted_cname_reuse_for_new_sites" || req.PostForm.Get("value") != "true" {
+			t.Errorf("Unexpected account_id/param/value, got: %s/%s/%s", req.PostForm.Get("account_id"), req.PostForm.Get("param"), req.PostForm.Get("value"))
+		}
+		rw.Write([]byte(`{"account_id":42,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetAccountCnameReuseDefault(42, true)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientGetAccountCnameReuseDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"account":{"account_id":42,"restricted_cname_reuse_for_new_sites":true},"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	restricted, err := client.GetAccountCnameReuseDefault(42)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if !restricted {
+		t.Errorf("Should have read the restricted CNAME reuse default")
+	}
+}
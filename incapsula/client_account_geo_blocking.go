@@ -0,0 +1,68 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Account params of the geo blocking applied to new sites
+const accountGeoBlockingCountriesParam = "default_blocked_countries"
+const accountGeoBlockingContinentsParam = "default_blocked_continents"
+
+// Continent codes, as used by the ACL rules and security rule exceptions
+var geoContinents = []string{"AF", "AN", "AS", "EU", "NA", "OC", "SA"}
+
+// ISO 3166-1 alpha-2 country code
+var geoCountryCodeRegex = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// SetAccountGeoBlocking sets the countries and continents blocked by default on new sites of the account
+func (c *Client) SetAccountGeoBlocking(accountID int, countries, continents []string) error {
+	log.Printf("[INFO] Setting Incapsula geo blocking (countries: %v, continents: %v) for account id: %d\n", countries, continents, accountID)
+
+	err := validateGeo(countries, continents)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.UpdateAccount(strconv.Itoa(accountID), accountGeoBlockingCountriesParam, strings.Join(countries, ","))
+	if err != nil {
+		return fmt.Errorf("Error setting geo blocking countries for account id %d: %s", accountID, err)
+	}
+
+	_, err = c.UpdateAccount(strconv.Itoa(accountID), accountGeoBlockingContinentsParam, strings.Join(continents, ","))
+	if err != nil {
+		return fmt.Errorf("Error setting geo blocking continents for account id %d: %s", accountID, err)
+	}
+
+	return nil
+}
+
+// GetAccountGeoBlocking gets the countries and continents blocked by default on new sites of the account
+func (c *Client) GetAccountGeoBlocking(accountID int) (*SecurityRuleGeo, error) {
+	log.Printf("[INFO] Getting Incapsula geo blocking for account id: %d\n", accountID)
+
+	accountStatusResponse, err := c.AccountStatus(accountID, ReadAccount)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting geo blocking for account id %d: %s", accountID, err)
+	}
+
+	return &accountStatusResponse.Account.DefaultGeoBlocking, nil
+}
+
+// validateGeo checks the country (ISO 3166-1 alpha-2) and continent codes
+func validateGeo(countries, continents []string) error {
+	for _, country := range countries {
+		if !geoCountryCodeRegex.MatchString(country) {
+			return fmt.Errorf("Error - invalid country code (%s), must be an uppercase ISO 3166-1 alpha-2 code, e.g. US", country)
+		}
+	}
+	for _, continent := range continents {
+		if !contains(geoContinents, continent) {
+			return fmt.Errorf("Error - invalid continent code (%s), must be one of %v", continent, geoContinents)
+		}
+	}
+	return nil
+}
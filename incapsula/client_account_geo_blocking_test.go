@@ -0,0 +1,73 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetAccountGeoBlocking Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetAccountGeoBlockingRequestBody(t *testing.T) {
+	updates := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointAccountUpdate) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointAccountUpdate, req.URL.String())
+		}
+		req.ParseForm()
+		if req.PostForm.Get("account_id") != "123" {
+			t.Errorf("Expected account_id to be 123, got: %s", req.PostForm.Get("account_id"))
+		}
+		updates = append(updates, fmt.Sprintf("%s=%s", req.PostForm.Get("param"), req.PostForm.Get("value")))
+		rw.Write([]byte(`{"account_id":123,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetAccountGeoBlocking(123, []string{"KP", "IR"}, []string{"AN"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	expected := []string{"default_blocked_countries=KP,IR", "default_blocked_continents=AN"}
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("Expected updates %v, got: %v", expected, updates)
+	}
+}
+
+func TestClientSetAccountGeoBlockingInvalidCodes(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	err := client.SetAccountGeoBlocking(123, []string{"usa"}, nil)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid country code (usa)") {
+		t.Errorf("Should have received an invalid country code error, got: %v", err)
+	}
+
+	err = client.SetAccountGeoBlocking(123, []string{"US"}, []string{"EUROPE"})
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid continent code (EUROPE)") {
+		t.Errorf("Should have received an invalid continent code error, got: %v", err)
+	}
+}
+
+func TestClientGetAccountGeoBlocking(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":0,"account":{"account_id":123,"default_geo_blocking":{"countries":["KP"],"continents":["AN"]}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	geoBlocking, err := client.GetAccountGeoBlocking(123)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if !reflect.DeepEqual(geoBlocking.Countries, []string{"KP"}) || !reflect.DeepEqual(geoBlocking.Continents, []string{"AN"}) {
+		t.Errorf("Unexpected geo blocking, got: %+v", geoBlocking)
+	}
+}
@@ -0,0 +1,85 @@
+package incapsula
+
+import (
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+// accountInventoryConcurrency is the number of site statuses read at the same time when building the inventory
+const accountInventoryConcurrency = 8
+
+// SiteInventoryItem contains the key attributes of a site of an account, e.g. for a CMDB. Error is set when the status
+// of the site couldn't be read, in which case only the attributes of the site list are filled.
+type SiteInventoryItem struct {
+	SiteID            int
+	Domain            string
+	Status            string
+	Active            string
+	AccountID         int
+	AccelerationLevel string
+	CertStatus        string
+	Error             string
+}
+
+// GetAccountInventory lists all the sites of an account and enriches each one with its status, reading at most
+// accountInventoryConcurrency site statuses at the same time. The error is only returned when the sites can't be
+// listed; the errors reading the status of a site are reported in the Error of its item. Items are sorted by site id.
+func (c *Client) GetAccountInventory(accountID int) ([]SiteInventoryItem, error) {
+	log.Printf("[INFO] Getting Incapsula inventory of account: %d\n", accountID)
+
+	sites, err := c.ListSites(accountID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]SiteInventoryItem, len(sites))
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, accountInventoryConcurrency)
+	for i := range sites {
+		items[i] = siteInventoryItem(&sites[i])
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		// Each goroutine only writes its own item, so the items don't need a lock
+		go func(item *SiteInventoryItem) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			siteStatusResponse, err := c.SiteStatusFields(item.SiteID, []string{"ssl"})
+			if err != nil {
+				log.Printf("[WARN] Could not get Incapsula status of site %d for the inventory of account %d: %s\n", item.SiteID, accountID, err)
+				item.Error = err.Error()
+				return
+			}
+			*item = siteInventoryItem(siteStatusResponse)
+		}(&items[i])
+	}
+	wg.Wait()
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].SiteID < items[j].SiteID
+	})
+
+	return items, nil
+}
+
+// siteInventoryItem extracts the inventory attributes of a site status. The certificate status is the validation
+// status of the managed certificate, or ACTIVE when a custom certificate is in use.
+func siteInventoryItem(siteStatusResponse *SiteStatusResponse) SiteInventoryItem {
+	certStatus := siteStatusResponse.Ssl.GeneratedCertificate.ValidationStatus
+	if siteStatusResponse.Ssl.CustomCertificate.Active {
+		certStatus = "ACTIVE"
+	}
+
+	return SiteInventoryItem{
+		SiteID:            siteStatusResponse.SiteID,
+		Domain:            siteStatusResponse.Domain,
+		Status:            siteStatusResponse.Status,
+		Active:            siteStatusResponse.Active,
+		AccountID:         siteStatusResponse.AccountID,
+		AccelerationLevel: siteStatusResponse.AccelerationLevelRaw,
+		CertStatus:        certStatus,
+	}
+}
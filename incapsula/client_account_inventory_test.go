@@ -0,0 +1,108 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// GetAccountInventory Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetAccountInventory(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		switch req.URL.String() {
+		case fmt.Sprintf("/%s", endpointSiteList):
+			if req.PostForm.Get("account_id") != "7" {
+				t.Errorf("Expected account_id to be 7, got: %s", req.PostForm.Get("account_id"))
+			}
+			sites := make([]string, 0)
+			for siteID := 12; siteID >= 1; siteID-- {
+				sites = append(sites, fmt.Sprintf(`{"site_id":%d,"domain":"site%d.example.com","account_id":7,"status":"pending-dns-changes"}`, siteID, siteID))
+			}
+			rw.Write([]byte(fmt.Sprintf(`{"sites":[%s],"res":0}`, strings.Join(sites, ","))))
+		case fmt.Sprintf("/%s", endpointSiteStatus):
+			current := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				observed := atomic.LoadInt32(&maxInFlight)
+				if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+
+			if req.PostForm.Get("fields") != "ssl" {
+				t.Errorf("Expected fields to be ssl, got: %s", req.PostForm.Get("fields"))
+			}
+			siteID := req.PostForm.Get("site_id")
+			switch siteID {
+			case "5":
+				rw.Write([]byte(`{"res":9413,"res_message":"Unknown/unauthorized site_id","debug_info":{"id-info":"13007"}}`))
+			case "6":
+				rw.Write([]byte(`{"site_id":6,"domain":"site6.example.com","account_id":7,"status":"fully_configured","active":"active","acceleration_level_raw":"standard","ssl":{"custom_certificate":{"active":true}},"res":0}`))
+			default:
+				rw.Write([]byte(fmt.Sprintf(`{"site_id":%s,"domain":"site%s.example.com","account_id":7,"status":"fully_configured","active":"bypass","acceleration_level_raw":"none","ssl":{"generated_certificate":{"validation_status":"done"}},"res":0}`, siteID, siteID)))
+			}
+		default:
+			t.Errorf("Unexpected request: %s", req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	items, err := client.GetAccountInventory(7)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(items) != 12 {
+		t.Fatalf("Should have received 12 sites, got: %d", len(items))
+	}
+	for i, item := range items {
+		if item.SiteID != i+1 {
+			t.Errorf("Sites should be sorted by site id, got %d at position %d", item.SiteID, i)
+		}
+		switch item.SiteID {
+		case 5:
+			if !strings.Contains(item.Error, "Unknown/unauthorized site_id") || item.Domain != "site5.example.com" || item.Status != "pending-dns-changes" {
+				t.Errorf("Site 5 should have kept its list attributes along with the error, got: %+v", item)
+			}
+		case 6:
+			if item.CertStatus != "ACTIVE" || item.Active != "active" || item.AccelerationLevel != "standard" || item.Error != "" {
+				t.Errorf("Unexpected inventory of site 6, got: %+v", item)
+			}
+		default:
+			if item.CertStatus != "done" || item.Active != "bypass" || item.Status != "fully_configured" || item.AccountID != 7 || item.Error != "" {
+				t.Errorf("Unexpected inventory of site %d, got: %+v", item.SiteID, item)
+			}
+		}
+	}
+	if maxInFlight > accountInventoryConcurrency {
+		t.Errorf("Should not have read more than %d site statuses at the same time, got: %d", accountInventoryConcurrency, maxInFlight)
+	}
+}
+
+func TestClientGetAccountInventoryListError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":9415,"res_message":"Operation not allowed","debug_info":{"id-info":"13008"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	items, err := client.GetAccountInventory(7)
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if items != nil {
+		t.Errorf("Should not have received an inventory, got: %+v", items)
+	}
+}
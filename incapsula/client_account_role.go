@@ -61,7 +61,7 @@ func (c *Client) AddAccountRole(requestDTO RoleDetailsCreateDTO) (*RoleDetailsDT
 	roleJSON, err := json.Marshal(requestDTO)
 	log.Printf("[INFO]  roleJSON: %v\n", string(roleJSON))
 
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endpointRoleAdd)
+	reqURL := c.endpointURL(endpointRoleAdd)
 	log.Printf("[INFO]  reqURL: %v\n", reqURL)
 
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodPost, reqURL, roleJSON, CreateAccountRole)
@@ -97,7 +97,7 @@ func (c *Client) GetAccountRole(roleId int) (*RoleDetailsDTO, error) {
 	log.Printf("[INFO] Getting Account Role (Id: %d)\n", roleId)
 
 	// Get request to Incapsula
-	reqURL := fmt.Sprintf("%s/%s/%d", c.config.BaseURLAPI, endpointRoleGet, roleId)
+	reqURL := fmt.Sprintf("%s/%d", c.endpointURL(endpointRoleGet), roleId)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadAccountRole)
 	if err != nil {
 		return nil, fmt.Errorf("Error executing get Account Role request for role with id %d: %s", roleId, err)
@@ -129,7 +129,7 @@ func (c *Client) UpdateAccountRole(roleId int, requestDTO RoleDetailsBasicDTO) (
 	roleJSON, err := json.Marshal(requestDTO)
 	log.Printf("[INFO]  roleJSON: %v\n", string(roleJSON))
 
-	reqURL := fmt.Sprintf("%s/%s/%d", c.config.BaseURLAPI, endpointRoleUpdate, roleId)
+	reqURL := fmt.Sprintf("%s/%d", c.endpointURL(endpointRoleUpdate), roleId)
 	log.Printf("[INFO]  reqURL: %v\n", reqURL)
 
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodPost, reqURL, roleJSON, UpdateAccountRole)
@@ -165,7 +165,7 @@ func (c *Client) DeleteAccountRole(roleId int) error {
 	log.Printf("[INFO] Delete Account Role (Id: %d)\n", roleId)
 
 	// Get request to Incapsula
-	reqURL := fmt.Sprintf("%s/%s/%d", c.config.BaseURLAPI, endpointRoleDelete, roleId)
+	reqURL := fmt.Sprintf("%s/%d", c.endpointURL(endpointRoleDelete), roleId)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodDelete, reqURL, nil, DeleteAccountRole)
 	if err != nil {
 		return fmt.Errorf("Error executing delete Account Role request for role with id %d: %s", roleId, err)
@@ -193,7 +193,7 @@ func (c *Client) GetAccountAbilities(accountId int) (*[]RoleAbility, error) {
 	log.Printf("[INFO] Getting Account Abilities for account Id: %d\n", accountId)
 
 	// Get request to Incapsula
-	reqURL := fmt.Sprintf("%s/%s/%d", c.config.BaseURLAPI, endpointAbilitiesGet, accountId)
+	reqURL := fmt.Sprintf("%s/%d", c.endpointURL(endpointAbilitiesGet), accountId)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadAccountAbilities)
 	if err != nil {
 		return nil, fmt.Errorf("Error executing get Account Abilities request for account with id %d: %s", accountId, err)
@@ -221,7 +221,7 @@ func (c *Client) GetAccountRoles(accountId int) (*[]RoleDetailsDTO, error) {
 	log.Printf("[INFO] Getting Account Roles (Account Id: %d)\n", accountId)
 
 	// Get request to Incapsula
-	reqURL := fmt.Sprintf("%s/%s?accountId=%d", c.config.BaseURLAPI, endpointAccountRolesGet, accountId)
+	reqURL := fmt.Sprintf("%s?accountId=%d", c.endpointURL(endpointAccountRolesGet), accountId)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadAccountRoles)
 	if err != nil {
 		return nil, fmt.Errorf("Error executing get Account Roles request for account with id %d: %s", accountId, err)
@@ -24,6 +24,8 @@ type UserApisResponse struct {
 			RoleID   int    `json:"id"`
 			RoleName string `json:"name"`
 		} `json:"roles"`
+		SiteIds           []int  `json:"siteIds"`
+		TemporaryPassword string `json:"temporaryPassword,omitempty"`
 	} `json:"data"`
 }
 
@@ -52,6 +54,19 @@ type UserUpdateReq struct {
 	RoleIds []int `json:"roleIds"`
 }
 
+type UserSitesUpdateReq struct {
+	SiteIds []int `json:"siteIds"`
+}
+
+// AccountUserExistsError is returned when adding a user that already exists in the account
+type AccountUserExistsError struct {
+	Email string
+}
+
+func (e *AccountUserExistsError) Error() string {
+	return fmt.Sprintf("User %s already exists in the account", e.Email)
+}
+
 // AddAccountUser adds a user to Incapsula Account
 func (c *Client) AddAccountUser(accountID int, email, firstName, lastName string, roleIds []interface{}) (*UserApisResponse, error) {
 	log.Printf("[INFO] Adding Incapsula account user for email: %s (account ID %d)\n", email, accountID)
@@ -68,15 +83,15 @@ func (c *Client) AddAccountUser(accountID int, email, firstName, lastName string
 		return nil, fmt.Errorf("Failed to JSON marshal IncapRule: %s", err)
 	}
 
-	endpointUserAdd := endpointUserOperationNew
+	reqURL := c.endpointURL(endpointUserOperationNew)
 	operation := CreateAccountUser
 	accountStatusResponse, err := c.AccountStatus(accountID, ReadAccount)
 	if accountStatusResponse != nil && accountStatusResponse.AccountType == "Sub Account" {
-		endpointUserAdd = endpointUserOperationNew + "/" + email
+		reqURL = fmt.Sprintf("%s/%s", reqURL, email)
 		operation = CreateSubAccountUser
 	}
 
-	reqURL := fmt.Sprintf("%s/%s?caid=%d", c.config.BaseURLAPI, endpointUserAdd, accountID)
+	reqURL = fmt.Sprintf("%s?caid=%d", reqURL, accountID)
 	log.Printf("[INFO] Values: %s\n", userJSON)
 	log.Printf("[INFO] Req: %s\n", reqURL)
 	log.Printf("[INFO] json: %s\n", userJSON)
@@ -94,6 +109,9 @@ func (c *Client) AddAccountUser(accountID int, email, firstName, lastName string
 	log.Printf("[DEBUG] Incapsula add user JSON response: %s\n", string(responseBody))
 
 	// Look at the response status code from Incapsula
+	if resp.StatusCode == http.StatusConflict {
+		return nil, &AccountUserExistsError{Email: email}
+	}
 	if resp.StatusCode != 200 {
 		return nil, fmt.Errorf("Error status code %d from Incapsula service when adding User %s: %s", resp.StatusCode, email, string(responseBody))
 	}
@@ -114,7 +132,7 @@ func (c *Client) GetAccountUser(accountID int, email string) (*UserApisResponse,
 	log.Printf("[INFO] Getting Incapsula user status for email id: %s\n", email)
 
 	// Get to Incapsula
-	reqURL := fmt.Sprintf("%s/%s/%s?caid=%d", c.config.BaseURLAPI, endpointUserOperationNew, email, accountID)
+	reqURL := fmt.Sprintf("%s/%s?caid=%d", c.endpointURL(endpointUserOperationNew), email, accountID)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadAccountUser)
 
 	if err != nil {
@@ -143,6 +161,81 @@ func (c *Client) GetAccountUser(accountID int, email string) (*UserApisResponse,
 	return &userStatusResponse, nil
 }
 
+// ListAccountUsers gets the users of an Incapsula account
+func (c *Client) ListAccountUsers(accountID int) (*UserApisResponse, error) {
+	log.Printf("[INFO] Getting Incapsula users for account: %d\n", accountID)
+
+	// Get to Incapsula
+	reqURL := fmt.Sprintf("%s?caid=%d", c.endpointURL(endpointUserOperationNew), accountID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadAccountUser)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error getting users of account %d: %s", accountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula list users JSON response: %s\n", string(responseBody))
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from Incapsula service when getting Users of account %d: %s", resp.StatusCode, accountID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var usersResponse UserApisResponse
+	err = json.Unmarshal(responseBody, &usersResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing list users JSON response for account %d: %s", accountID, err)
+	}
+
+	return &usersResponse, nil
+}
+
+// SetAccountUserSites restricts the user to the given sites of the account. An empty list gives access to all the sites.
+func (c *Client) SetAccountUserSites(accountID int, email string, siteIds []interface{}) (*UserApisUpdateResponse, error) {
+	log.Printf("[INFO] Setting Incapsula User sites for email: %s (account ID %d)\n", email, accountID)
+	listSites := make([]int, len(siteIds))
+	for i, v := range siteIds {
+		listSites[i] = v.(int)
+	}
+
+	userJSON, err := json.Marshal(UserSitesUpdateReq{SiteIds: listSites})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to JSON marshal user sites: %s", err)
+	}
+
+	reqURL := fmt.Sprintf("%s/%s?caid=%d", c.endpointURL(endpointUserOperationNew), email, accountID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodPatch, reqURL, userJSON, UpdateAccountUser)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error setting sites of user email %s: %s", email, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula set user sites JSON response: %s\n", string(responseBody))
+
+	// Look at the response status code from Incapsula
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from Incapsula service when setting sites of User %s: %s", resp.StatusCode, email, string(responseBody))
+	}
+
+	// Parse the JSON
+	var userUpdateResponse UserApisUpdateResponse
+	err = json.Unmarshal(responseBody, &userUpdateResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing set user sites JSON response for email %s: %s", email, err)
+	}
+
+	return &userUpdateResponse, nil
+}
+
 // UpdateAccountUser User Roles
 func (c *Client) UpdateAccountUser(accountID int, email string, roleIds []interface{}) (*UserApisUpdateResponse, error) {
 	log.Printf("[INFO] Update Incapsula User for email: %s (account ID %d)\n", email, accountID)
@@ -158,7 +251,7 @@ func (c *Client) UpdateAccountUser(accountID int, email string, roleIds []interf
 		return nil, fmt.Errorf("Failed to JSON marshal IncapRule: %s", err)
 	}
 
-	reqURL := fmt.Sprintf("%s/%s/%s?caid=%d", c.config.BaseURLAPI, endpointUserOperationNew, email, accountID)
+	reqURL := fmt.Sprintf("%s/%s?caid=%d", c.endpointURL(endpointUserOperationNew), email, accountID)
 
 	log.Printf("[INFO] Req: %s\n", reqURL)
 	log.Printf("[INFO] json: %s\n", userJSON)
@@ -204,7 +297,7 @@ func (c *Client) DeleteAccountUser(accountID int, email string) error {
 
 	// Delete form to Incapsula
 
-	reqURL := fmt.Sprintf("%s/%s/%s?caid=%d", c.config.BaseURLAPI, endpointUserOperationNew, email, accountID)
+	reqURL := fmt.Sprintf("%s/%s?caid=%d", c.endpointURL(endpointUserOperationNew), email, accountID)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodDelete, reqURL, nil, DeleteAccountUser)
 
 	if err != nil {
@@ -1,9 +1,12 @@
 package incapsula
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -195,3 +198,156 @@ func TestClientUpdateUserBadJSON(t *testing.T) {
 		t.Errorf("Should have received a nil updateUserResponse instance")
 	}
 }
+
+////////////////////////////////////////////////////////////////
+// User request body Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientAddUserRequestBody(t *testing.T) {
+	accountID := 123
+	email := "example@example.com"
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != fmt.Sprintf("/%s", endpointUserOperationNew) {
+			// Account status lookup
+			rw.Write([]byte(`{"res":0,"account_type":"Enterprise"}`))
+			return
+		}
+		if req.Method != http.MethodPost {
+			t.Errorf("Expected method %s, got: %s", http.MethodPost, req.Method)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		var userAddReq UserAddReq
+		json.Unmarshal(body, &userAddReq)
+		expected := UserAddReq{UserEmail: email, RoleIds: []int{10, 11}, FirstName: "First", LastName: "Last"}
+		if !reflect.DeepEqual(userAddReq, expected) {
+			t.Errorf("Unexpected request body, expected %+v, got: %s", expected, string(body))
+		}
+		rw.Write([]byte(`{"data":[{"id":"1","accountId":123,"email":"example@example.com","temporaryPassword":"s3cr3t"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	userAddResponse, err := client.AddAccountUser(accountID, email, "First", "Last", []interface{}{10, 11})
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if userAddResponse.Data[0].TemporaryPassword != "s3cr3t" {
+		t.Errorf("Expected the temporary password to be returned, got: %s", userAddResponse.Data[0].TemporaryPassword)
+	}
+}
+
+func TestClientAddUserAlreadyExists(t *testing.T) {
+	email := "example@example.com"
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != fmt.Sprintf("/%s", endpointUserOperationNew) {
+			rw.Write([]byte(`{"res":0,"account_type":"Enterprise"}`))
+			return
+		}
+		rw.WriteHeader(http.StatusConflict)
+		rw.Write([]byte(`{"errors":[{"status":409,"title":"User already exists"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.AddAccountUser(123, email, "First", "Last", []interface{}{})
+	if _, exists := err.(*AccountUserExistsError); !exists {
+		t.Errorf("Should have received an AccountUserExistsError, got: %v", err)
+	}
+}
+
+func TestClientUpdateUserRequestBody(t *testing.T) {
+	accountID := 123
+	email := "example@example.com"
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s/%s?caid=%d", endpointUserOperationNew, email, accountID) {
+			t.Errorf("Should have have hit /%s/%s?caid=%d endpoint. Got: %s", endpointUserOperationNew, email, accountID, req.URL.String())
+		}
+		if req.Method != http.MethodPatch {
+			t.Errorf("Expected method %s, got: %s", http.MethodPatch, req.Method)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != `{"roleIds":[10]}` {
+			t.Errorf("Unexpected request body, got: %s", string(body))
+		}
+		rw.Write([]byte(`{"data":[{"id":"1","accountId":123,"email":"example@example.com","roles":[{"id":10,"name":"Reader"}]}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.UpdateAccountUser(accountID, email, []interface{}{10})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetUserSitesRequestBody(t *testing.T) {
+	accountID := 123
+	email := "example@example.com"
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s/%s?caid=%d", endpointUserOperationNew, email, accountID) {
+			t.Errorf("Should have have hit /%s/%s?caid=%d endpoint. Got: %s", endpointUserOperationNew, email, accountID, req.URL.String())
+		}
+		if req.Method != http.MethodPatch {
+			t.Errorf("Expected method %s, got: %s", http.MethodPatch, req.Method)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != `{"siteIds":[42,43]}` {
+			t.Errorf("Unexpected request body, got: %s", string(body))
+		}
+		rw.Write([]byte(`{"data":[{"id":"1","accountId":123,"email":"example@example.com","siteIds":[42,43]}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.SetAccountUserSites(accountID, email, []interface{}{42, 43})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientDeleteUserRequest(t *testing.T) {
+	accountID := 123
+	email := "example@example.com"
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s/%s?caid=%d", endpointUserOperationNew, email, accountID) {
+			t.Errorf("Should have have hit /%s/%s?caid=%d endpoint. Got: %s", endpointUserOperationNew, email, accountID, req.URL.String())
+		}
+		if req.Method != http.MethodDelete {
+			t.Errorf("Expected method %s, got: %s", http.MethodDelete, req.Method)
+		}
+		rw.Write([]byte(`{"code":0,"message":"OK"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.DeleteAccountUser(accountID, email)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientListAccountUsers(t *testing.T) {
+	accountID := 123
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s?caid=%d", endpointUserOperationNew, accountID) {
+			t.Errorf("Should have have hit /%s?caid=%d endpoint. Got: %s", endpointUserOperationNew, accountID, req.URL.String())
+		}
+		rw.Write([]byte(`{"data":[{"id":"1","accountId":123,"email":"first@example.com"},{"id":"2","accountId":123,"email":"second@example.com","siteIds":[42]}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	usersResponse, err := client.ListAccountUsers(accountID)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(usersResponse.Data) != 2 || usersResponse.Data[1].Email != "second@example.com" || !reflect.DeepEqual(usersResponse.Data[1].SiteIds, []int{42}) {
+		t.Errorf("Unexpected users: %+v", usersResponse.Data)
+	}
+}
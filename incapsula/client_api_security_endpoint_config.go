@@ -67,7 +67,7 @@ func (c *Client) PostApiSecurityEndpointConfig(apiId, endpointId int, endpointCo
 	}
 
 	writer.Close()
-	url := fmt.Sprintf("%s%s%d"+"/"+"%d", c.config.BaseURLAPI, endpointConfigUrl, apiId, endpointId)
+	url := fmt.Sprintf("%s%d"+"/"+"%d", c.endpointURL(endpointConfigUrl), apiId, endpointId)
 	contentType := writer.FormDataContentType()
 	resp, err := c.DoFormDataRequestWithHeaders(http.MethodPost, url, body.Bytes(), contentType, UpdateApiSecEndpointConfig)
 	if err != nil {
@@ -98,7 +98,7 @@ func (c *Client) PostApiSecurityEndpointConfig(apiId, endpointId int, endpointCo
 func (c *Client) GetApiSecurityEndpointConfig(apiId int, endpointId string) (*ApiSecurityEndpointConfigGetResponse, error) {
 	log.Printf("[INFO] Getting Incapsula Api-Security Endpoint Config on API: %d and Endpoint: %s\n", apiId, endpointId)
 
-	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, fmt.Sprintf("%s%s%d/%s", c.config.BaseURLAPI, endpointConfigUrl, apiId, endpointId), nil, ReadApiSecEndpointConfig)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, fmt.Sprintf("%s%d/%s", c.endpointURL(endpointConfigUrl), apiId, endpointId), nil, ReadApiSecEndpointConfig)
 	if err != nil {
 		return nil, fmt.Errorf("[ERROR] Error from Incapsula service while reading Api-Security Endpoint Config for API ID %d and Endpoint ID %s: %s", apiId, endpointId, err)
 	}
@@ -127,7 +127,7 @@ func (c *Client) GetApiSecurityEndpointConfig(apiId int, endpointId string) (*Ap
 func (c *Client) GetApiSecurityAllEndpointsConfig(apiId int) (*ApiSecurityEndpointConfigGetAllResponse, error) {
 	log.Printf("[INFO] Getting Incapsula Api-Security all Endpoints Config on API: %d\n", apiId)
 
-	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, fmt.Sprintf("%s%s%d", c.config.BaseURLAPI, endpointConfigUrl, apiId), nil, ReadApiSecEndpointConfig)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, fmt.Sprintf("%s%d", c.endpointURL(endpointConfigUrl), apiId), nil, ReadApiSecEndpointConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error from Incapsula service when reading Api-Security all Endpoints Config for API ID %d: %s", apiId, err)
 	}
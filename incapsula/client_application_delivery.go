@@ -21,11 +21,16 @@ type Compression struct {
 
 type ImageCompression struct {
 	CompressJpeg              bool `json:"compress_jpeg"`
+	JpegQuality               int  `json:"jpeg_quality,omitempty"`
 	ProgressiveImageRendering bool `json:"progressive_image_rendering"`
 	AggressiveCompression     bool `json:"aggressive_compression"`
-	CompressPng               bool `json:"compress_png"`
+	// The API has no PNG compression mode, PNG compression is always lossless
+	CompressPng bool `json:"compress_png"`
 }
 
+const minJpegQuality = 1
+const maxJpegQuality = 100
+
 type Network struct {
 	TcpPrePooling         bool    `json:"tcp_pre_pooling"`
 	OriginConnectionReuse bool    `json:"origin_connection_reuse"`
@@ -93,6 +98,63 @@ func (c *Client) UpdateApplicationDelivery(siteID int, applicationDelivery *Appl
 	return CrudApplicationDelivery("Update", siteID, http.MethodPut, applicationDeliveryJSON, c)
 }
 
+// SetJpegQuality enables JPEG compression with the given quality level (1-100), keeping the rest of the delivery settings
+func (c *Client) SetJpegQuality(siteID int, quality int) error {
+	log.Printf("[INFO] Setting Incapsula JPEG quality (%d) for Site ID %d", quality, siteID)
+	if quality < minJpegQuality || quality > maxJpegQuality {
+		return fmt.Errorf("Error - invalid JPEG quality (%d) for Site ID %d, must be between %d and %d", quality, siteID, minJpegQuality, maxJpegQuality)
+	}
+
+	return c.updateApplicationDeliverySetting(siteID, "JPEG quality", func(applicationDelivery *ApplicationDelivery) {
+		applicationDelivery.ImageCompression.CompressJpeg = true
+		applicationDelivery.ImageCompression.JpegQuality = quality
+	})
+}
+
+// SetAggressiveCompression sets the aggressive compression of the JPEG images, keeping the rest of the delivery settings
+func (c *Client) SetAggressiveCompression(siteID int, enabled bool) error {
+	log.Printf("[INFO] Setting Incapsula aggressive compression (%t) for Site ID %d", enabled, siteID)
+	return c.updateApplicationDeliverySetting(siteID, "aggressive compression", func(applicationDelivery *ApplicationDelivery) {
+		applicationDelivery.ImageCompression.AggressiveCompression = enabled
+	})
+}
+
+// SetMinifySettings sets the JavaScript, CSS and static HTML minification of a site in one update, keeping the rest
+// of the delivery settings
+func (c *Client) SetMinifySettings(siteID int, js, css, html bool) error {
+	log.Printf("[INFO] Setting Incapsula minify settings (js: %t, css: %t, html: %t) for Site ID %d", js, css, html, siteID)
+	return c.updateApplicationDeliverySetting(siteID, "minify settings", func(applicationDelivery *ApplicationDelivery) {
+		applicationDelivery.Compression.MinifyJs = js
+		applicationDelivery.Compression.MinifyCss = css
+		applicationDelivery.Compression.MinifyStaticHtml = html
+	})
+}
+
+// SetProgressiveRendering sets the progressive image rendering of a site, keeping the rest of the delivery settings
+func (c *Client) SetProgressiveRendering(siteID int, enabled bool) error {
+	log.Printf("[INFO] Setting Incapsula progressive image rendering (%t) for Site ID %d", enabled, siteID)
+	return c.updateApplicationDeliverySetting(siteID, "progressive image rendering", func(applicationDelivery *ApplicationDelivery) {
+		applicationDelivery.ImageCompression.ProgressiveImageRendering = enabled
+	})
+}
+
+// updateApplicationDeliverySetting reads the delivery settings of a site, applies the change of a single setting and
+// updates them, so the other settings are kept
+func (c *Client) updateApplicationDeliverySetting(siteID int, setting string, apply func(*ApplicationDelivery)) error {
+	applicationDelivery, diags := c.GetApplicationDelivery(siteID)
+	if diags != nil {
+		return fmt.Errorf("Error reading Application Delivery before setting %s for Site ID %d: %s", setting, siteID, diags[0].Detail)
+	}
+
+	apply(applicationDelivery)
+	_, diags = c.UpdateApplicationDelivery(siteID, applicationDelivery)
+	if diags != nil {
+		return fmt.Errorf("Error setting %s for Site ID %d: %s", setting, siteID, diags[0].Detail)
+	}
+
+	return nil
+}
+
 func (c *Client) DeleteApplicationDelivery(siteID int) (*ApplicationDelivery, diag.Diagnostics) {
 	log.Printf("[INFO] Deleting Incapsula Application Delivery for Site ID %d", siteID)
 	return CrudApplicationDelivery("Delete", siteID, http.MethodDelete, nil, c)
@@ -1,6 +1,7 @@
 package incapsula
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -553,3 +554,155 @@ func TestDeleteApplicationDeliveryConfig(t *testing.T) {
 		t.Errorf("Should not have received an error")
 	}
 }
+
+// //////////////////////////////////////////////////////////////
+// SetJpegQuality Tests
+// //////////////////////////////////////////////////////////////
+func TestSetJpegQualityValidQuality(t *testing.T) {
+	siteID := 42
+	applicationDeliveryEndpoint := fmt.Sprintf("/sites/%d/settings/delivery", siteID)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != applicationDeliveryEndpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", applicationDeliveryEndpoint, req.URL.String())
+		}
+		if req.Method == http.MethodPut {
+			var applicationDelivery ApplicationDelivery
+			json.NewDecoder(req.Body).Decode(&applicationDelivery)
+			if !applicationDelivery.ImageCompression.CompressJpeg || applicationDelivery.ImageCompression.JpegQuality != 75 {
+				t.Errorf("Should have sent compress_jpeg true with jpeg_quality 75, got: %v", applicationDelivery.ImageCompression)
+			}
+			if !applicationDelivery.ImageCompression.CompressPng {
+				t.Errorf("Should have kept the existing image compression settings, got: %v", applicationDelivery.ImageCompression)
+			}
+		}
+		rw.WriteHeader(200)
+		rw.Write([]byte(`{"image_compression":{"compress_jpeg":false,"compress_png":true},"network":{"port":{"to":"80"},"ssl_port":{"to":"443"}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	err := client.SetJpegQuality(siteID, 75)
+	if err != nil {
+		t.Errorf("Should not have received an error: %s", err)
+	}
+}
+
+func TestSetJpegQualityOutOfRange(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com", BaseURLRev2: "badness.incapsula.com", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	for _, quality := range []int{0, 101} {
+		err := client.SetJpegQuality(42, quality)
+		if err == nil {
+			t.Errorf("Should have received an error for quality %d", quality)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error - invalid JPEG quality (%d)", quality)) {
+			t.Errorf("Should have received an invalid JPEG quality error, got: %s", err)
+		}
+	}
+}
+
+// //////////////////////////////////////////////////////////////
+// SetAggressiveCompression Tests
+// //////////////////////////////////////////////////////////////
+func TestSetAggressiveCompression(t *testing.T) {
+	siteID := 42
+	applicationDeliveryEndpoint := fmt.Sprintf("/sites/%d/settings/delivery", siteID)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != applicationDeliveryEndpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", applicationDeliveryEndpoint, req.URL.String())
+		}
+		if req.Method == http.MethodPut {
+			var applicationDelivery ApplicationDelivery
+			json.NewDecoder(req.Body).Decode(&applicationDelivery)
+			if !applicationDelivery.ImageCompression.AggressiveCompression {
+				t.Errorf("Should have sent aggressive_compression true, got: %v", applicationDelivery.ImageCompression)
+			}
+			if !applicationDelivery.ImageCompression.CompressJpeg || !applicationDelivery.ImageCompression.CompressPng {
+				t.Errorf("Should have kept the existing image compression settings, got: %v", applicationDelivery.ImageCompression)
+			}
+		}
+		rw.WriteHeader(200)
+		rw.Write([]byte(`{"image_compression":{"compress_jpeg":true,"compress_png":true},"network":{"port":{"to":"80"},"ssl_port":{"to":"443"}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	err := client.SetAggressiveCompression(siteID, true)
+	if err != nil {
+		t.Errorf("Should not have received an error: %s", err)
+	}
+}
+
+// //////////////////////////////////////////////////////////////
+// SetMinifySettings Tests
+// //////////////////////////////////////////////////////////////
+func TestSetMinifySettings(t *testing.T) {
+	siteID := 42
+	updates := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPut {
+			updates++
+			var applicationDelivery ApplicationDelivery
+			json.NewDecoder(req.Body).Decode(&applicationDelivery)
+			compression := applicationDelivery.Compression
+			if !compression.MinifyJs || compression.MinifyCss || !compression.MinifyStaticHtml {
+				t.Errorf("Should have sent minify_js true, minify_css false and minify_static_html true, got: %v", compression)
+			}
+			if !compression.FileCompression || compression.CompressionType != "BROTLI" {
+				t.Errorf("Should have kept the existing compression settings, got: %v", compression)
+			}
+		}
+		rw.WriteHeader(200)
+		rw.Write([]byte(`{"compression":{"file_compression":true,"compression_type":"BROTLI","minify_js":false,"minify_css":true,"minify_static_html":false},"network":{"port":{"to":"80"},"ssl_port":{"to":"443"}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	err := client.SetMinifySettings(siteID, true, false, true)
+	if err != nil {
+		t.Errorf("Should not have received an error: %s", err)
+	}
+	if updates != 1 {
+		t.Errorf("Should have set the minify settings in a single update, got %d updates", updates)
+	}
+}
+
+// //////////////////////////////////////////////////////////////
+// SetProgressiveRendering Tests
+// //////////////////////////////////////////////////////////////
+func TestSetProgressiveRendering(t *testing.T) {
+	siteID := 42
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPut {
+			var applicationDelivery ApplicationDelivery
+			json.NewDecoder(req.Body).Decode(&applicationDelivery)
+			if applicationDelivery.ImageCompression.ProgressiveImageRendering {
+				t.Errorf("Should have sent progressive_image_rendering false, got: %v", applicationDelivery.ImageCompression)
+			}
+			if !applicationDelivery.ImageCompression.CompressJpeg {
+				t.Errorf("Should have kept the existing image compression settings, got: %v", applicationDelivery.ImageCompression)
+			}
+		}
+		rw.WriteHeader(200)
+		rw.Write([]byte(`{"image_compression":{"compress_jpeg":true,"progressive_image_rendering":true},"network":{"port":{"to":"80"},"ssl_port":{"to":"443"}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	err := client.SetProgressiveRendering(siteID, false)
+	if err != nil {
+		t.Errorf("Should not have received an error: %s", err)
+	}
+}
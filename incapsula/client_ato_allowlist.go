@@ -160,9 +160,9 @@ func (c *Client) GetAtoSiteAllowlist(accountId, siteId int) (*ATOAllowlistDTO, i
 	// Get request to ATO
 	var reqURL string
 	if accountId == 0 {
-		reqURL = fmt.Sprintf("%s%s/%d%s", c.config.BaseURLAPI, endpointATOSiteBase, siteId, endpointAtoAllowlist)
+		reqURL = fmt.Sprintf("%s/%d%s", c.endpointURL(endpointATOSiteBase), siteId, endpointAtoAllowlist)
 	} else {
-		reqURL = fmt.Sprintf("%s%s/%d%s?caid=%d", c.config.BaseURLAPI, endpointATOSiteBase, siteId, endpointAtoAllowlist, accountId)
+		reqURL = fmt.Sprintf("%s/%d%s?caid=%d", c.endpointURL(endpointATOSiteBase), siteId, endpointAtoAllowlist, accountId)
 	}
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadATOSiteAllowlistOperation)
 	if err != nil {
@@ -225,9 +225,9 @@ func (c *Client) UpdateATOSiteAllowlist(atoSiteAllowlistDTO *ATOAllowlistDTO) er
 	}
 	var reqURL string
 	if atoSiteAllowlistDTO.AccountId == 0 {
-		reqURL = fmt.Sprintf("%s%s/%d%s", c.config.BaseURLAPI, endpointATOSiteBase, atoSiteAllowlistDTO.SiteId, endpointAtoAllowlist)
+		reqURL = fmt.Sprintf("%s/%d%s", c.endpointURL(endpointATOSiteBase), atoSiteAllowlistDTO.SiteId, endpointAtoAllowlist)
 	} else {
-		reqURL = fmt.Sprintf("%s%s/%d%s?caid=%d", c.config.BaseURLAPI, endpointATOSiteBase, atoSiteAllowlistDTO.SiteId, endpointAtoAllowlist, atoSiteAllowlistDTO.AccountId)
+		reqURL = fmt.Sprintf("%s/%d%s?caid=%d", c.endpointURL(endpointATOSiteBase), atoSiteAllowlistDTO.SiteId, endpointAtoAllowlist, atoSiteAllowlistDTO.AccountId)
 	}
 
 	// Update request to ATO
@@ -59,9 +59,9 @@ func (c *Client) GetAtoEndpointMitigationConfiguration(accountId, siteId int, en
 	// Get request to ATO
 	var reqURL string
 	if accountId == 0 {
-		reqURL = fmt.Sprintf("%s%s/%d%s", c.config.BaseURLAPI, endpointATOSiteBase, siteId, endpointATOMitigation)
+		reqURL = fmt.Sprintf("%s/%d%s", c.endpointURL(endpointATOSiteBase), siteId, endpointATOMitigation)
 	} else {
-		reqURL = fmt.Sprintf("%s%s/%d%s?caid=%d", c.config.BaseURLAPI, endpointATOSiteBase, siteId, endpointATOMitigation, accountId)
+		reqURL = fmt.Sprintf("%s/%d%s?caid=%d", c.endpointURL(endpointATOSiteBase), siteId, endpointATOMitigation, accountId)
 	}
 	// Adding specific endpoint ID from the API spec at https://docs.imperva.com/bundle/account-takeover/page/account-takeover/ato-api-definition.htm
 	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(http.MethodGet, reqURL, nil, map[string]string{"endpointIds": endpointId}, ReadATOSiteMitigationConfigurationOperation)
@@ -147,9 +147,9 @@ func (c *Client) UpdateATOSiteMitigationConfiguration(atoSiteMitigationConfigura
 	}
 	var reqURL string
 	if atoSiteMitigationConfigurationDTO.AccountId == 0 {
-		reqURL = fmt.Sprintf("%s%s/%d%s", c.config.BaseURLAPI, endpointATOSiteBase, atoSiteMitigationConfigurationDTO.SiteId, endpointATOMitigation)
+		reqURL = fmt.Sprintf("%s/%d%s", c.endpointURL(endpointATOSiteBase), atoSiteMitigationConfigurationDTO.SiteId, endpointATOMitigation)
 	} else {
-		reqURL = fmt.Sprintf("%s%s/%d%s?caid=%d", c.config.BaseURLAPI, endpointATOSiteBase, atoSiteMitigationConfigurationDTO.SiteId, endpointATOMitigation, atoSiteMitigationConfigurationDTO.AccountId)
+		reqURL = fmt.Sprintf("%s/%d%s?caid=%d", c.endpointURL(endpointATOSiteBase), atoSiteMitigationConfigurationDTO.SiteId, endpointATOMitigation, atoSiteMitigationConfigurationDTO.AccountId)
 	}
 
 	// Update request to ATO
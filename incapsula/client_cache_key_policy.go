@@ -0,0 +1,75 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// Cache key query string mode enumerations
+const (
+	CacheKeyQueryStringIncludeAll       = "include_all"
+	CacheKeyQueryStringIgnoreAll        = "ignore_all"
+	CacheKeyQueryStringIncludeSpecified = "include_specified"
+)
+
+var cacheKeyQueryStringModes = []string{CacheKeyQueryStringIncludeAll, CacheKeyQueryStringIgnoreAll, CacheKeyQueryStringIncludeSpecified}
+
+// CacheKeyPolicy controls what the cache key of a site's resources is made of
+type CacheKeyPolicy struct {
+	// ComplyVary caches resources in accordance with the Vary response header
+	ComplyVary bool
+	// QueryStringMode is one of cacheKeyQueryStringModes, an empty mode keeps the current one
+	QueryStringMode string
+	// QueryStringParams are the query string parameters included in the key with the include_specified mode
+	QueryStringParams []string
+	// Cookies are the names of the cookies included in the key
+	Cookies []string
+}
+
+// SetCacheKeyPolicy sets the cache key policy of a site, keeping the rest of its performance settings
+func (c *Client) SetCacheKeyPolicy(siteID int, cfg CacheKeyPolicy) error {
+	log.Printf("[INFO] Setting Incapsula cache key policy (comply vary: %t, query string mode: %s) for site_id: %d\n", cfg.ComplyVary, cfg.QueryStringMode, siteID)
+
+	err := validateCacheKeyPolicy(cfg)
+	if err != nil {
+		return err
+	}
+
+	performanceSettings, _, err := c.GetPerformanceSettings(strconv.Itoa(siteID))
+	if err != nil {
+		return err
+	}
+
+	applyCacheKeyPolicy(performanceSettings, cfg)
+
+	_, err = c.UpdatePerformanceSettings(strconv.Itoa(siteID), performanceSettings)
+	if err != nil {
+		return fmt.Errorf("Error setting cache key policy for site_id %d: %s", siteID, err)
+	}
+
+	return nil
+}
+
+// validateCacheKeyPolicy checks the query string mode and that parameters are only set with the include_specified mode
+func validateCacheKeyPolicy(cfg CacheKeyPolicy) error {
+	if cfg.QueryStringMode != "" && !contains(cacheKeyQueryStringModes, cfg.QueryStringMode) {
+		return fmt.Errorf("Error - invalid cache key query string mode (%s), must be one of %v", cfg.QueryStringMode, cacheKeyQueryStringModes)
+	}
+	if cfg.QueryStringMode == CacheKeyQueryStringIncludeSpecified && len(cfg.QueryStringParams) == 0 {
+		return fmt.Errorf("Error - at least one query string parameter must be set with the %s cache key query string mode", CacheKeyQueryStringIncludeSpecified)
+	}
+	if cfg.QueryStringMode != CacheKeyQueryStringIncludeSpecified && len(cfg.QueryStringParams) > 0 {
+		return fmt.Errorf("Error - query string parameters can only be set with the %s cache key query string mode", CacheKeyQueryStringIncludeSpecified)
+	}
+	return nil
+}
+
+// applyCacheKeyPolicy sets the cache key policy on the performance settings. Empty lists are sent as such so removing
+// every query string parameter or cookie clears them.
+func applyCacheKeyPolicy(performanceSettings *PerformanceSettings, cfg CacheKeyPolicy) {
+	performanceSettings.Key.ComplyVary = cfg.ComplyVary
+	performanceSettings.Key.QueryStringMode = cfg.QueryStringMode
+	performanceSettings.Key.QueryStringParams = append([]string{}, cfg.QueryStringParams...)
+	performanceSettings.Key.Cookies = append([]string{}, cfg.Cookies...)
+}
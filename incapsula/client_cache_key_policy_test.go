@@ -0,0 +1,112 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetCacheKeyPolicy Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetCacheKeyPolicyRequestBody(t *testing.T) {
+	endpoint := "/sites/42/settings/cache"
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		if req.Method == http.MethodGet {
+			rw.Write([]byte(`{"mode":{"level":"standard"},"key":{"unite_naked_full_cache":true,"comply_vary":false},"ttl":{"use_shortest_caching":true}}`))
+			return
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		var performanceSettings PerformanceSettings
+		err := json.Unmarshal(body, &performanceSettings)
+		if err != nil {
+			t.Fatalf("Failed to parse request body: %s", err)
+		}
+		if !performanceSettings.Key.ComplyVary || performanceSettings.Key.QueryStringMode != CacheKeyQueryStringIncludeSpecified {
+			t.Errorf("Unexpected comply_vary/query_string_mode, got: %t/%s", performanceSettings.Key.ComplyVary, performanceSettings.Key.QueryStringMode)
+		}
+		if !reflect.DeepEqual(performanceSettings.Key.QueryStringParams, []string{"page", "lang"}) {
+			t.Errorf("Unexpected query_string_params, got: %v", performanceSettings.Key.QueryStringParams)
+		}
+		if !reflect.DeepEqual(performanceSettings.Key.Cookies, []string{"currency"}) {
+			t.Errorf("Unexpected cookies, got: %v", performanceSettings.Key.Cookies)
+		}
+		if !performanceSettings.Key.UniteNakedFullCache || performanceSettings.Mode.Level != "standard" || !performanceSettings.TTL.UseShortestCaching {
+			t.Errorf("Should have kept the rest of the performance settings, got: %s", string(body))
+		}
+		rw.Write(body)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetCacheKeyPolicy(42, CacheKeyPolicy{
+		ComplyVary:        true,
+		QueryStringMode:   CacheKeyQueryStringIncludeSpecified,
+		QueryStringParams: []string{"page", "lang"},
+		Cookies:           []string{"currency"},
+	})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetCacheKeyPolicyClearsLists(t *testing.T) {
+	endpoint := "/sites/42/settings/cache"
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		if req.Method == http.MethodGet {
+			rw.Write([]byte(`{"mode":{"level":"standard"},"key":{"query_string_mode":"include_specified","query_string_params":["page"],"cookies":["currency"]}}`))
+			return
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		if !strings.Contains(string(body), `"query_string_params":[]`) || !strings.Contains(string(body), `"cookies":[]`) {
+			t.Errorf("Should have sent empty query_string_params and cookies, got: %s", string(body))
+		}
+		rw.Write(body)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetCacheKeyPolicy(42, CacheKeyPolicy{QueryStringMode: CacheKeyQueryStringIgnoreAll})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetCacheKeyPolicyInvalidQueryStringMode(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetCacheKeyPolicy(42, CacheKeyPolicy{QueryStringMode: "include_some"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error - invalid cache key query string mode (include_some)") {
+		t.Errorf("Should have received an invalid query string mode error, got: %s", err)
+	}
+}
+
+func TestValidateCacheKeyPolicyQueryStringParams(t *testing.T) {
+	err := validateCacheKeyPolicy(CacheKeyPolicy{QueryStringMode: CacheKeyQueryStringIncludeSpecified})
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - at least one query string parameter must be set") {
+		t.Errorf("Should have received a missing query string parameters error, got: %v", err)
+	}
+
+	err = validateCacheKeyPolicy(CacheKeyPolicy{QueryStringMode: CacheKeyQueryStringIgnoreAll, QueryStringParams: []string{"page"}})
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - query string parameters can only be set") {
+		t.Errorf("Should have received an unexpected query string parameters error, got: %v", err)
+	}
+}
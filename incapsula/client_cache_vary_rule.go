@@ -0,0 +1,155 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Cache vary rule type enumerations
+const (
+	CacheVaryRuleTypeCookie = "cookie"
+	CacheVaryRuleTypeHeader = "header"
+)
+
+var cacheVaryRuleTypes = []string{CacheVaryRuleTypeCookie, CacheVaryRuleTypeHeader}
+
+// Cookie and header names are HTTP tokens (RFC 7230), e.g. device_type or X-Device-Type
+var cacheVaryNameRegex = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+.^_`|~-]+$")
+
+// CacheVaryRule caches a separate copy of the resources for each value of a cookie or a header, e.g. a device type
+// cookie. When Values is set, only these values get their own copy and all the other values share one.
+type CacheVaryRule struct {
+	Type   string   `json:"type"`
+	Name   string   `json:"name"`
+	Values []string `json:"values,omitempty"`
+}
+
+// CacheVaryRuleWithID contains the CacheVaryRule as well as the rule identifier
+type CacheVaryRuleWithID struct {
+	CacheVaryRule
+	RuleID int `json:"rule_id"`
+}
+
+// CacheVaryRulesResponse contains the cache vary rules of a site
+type CacheVaryRulesResponse struct {
+	Rules []CacheVaryRuleWithID `json:"rules"`
+}
+
+// AddCacheVaryRule adds a cache vary rule to a site
+func (c *Client) AddCacheVaryRule(siteID int, rule CacheVaryRule) (*CacheVaryRuleWithID, error) {
+	log.Printf("[INFO] Adding Incapsula Cache Vary Rule (%s %s) for Site ID %d\n", rule.Type, rule.Name, siteID)
+
+	err := validateCacheVaryRule(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleJSON, err := json.Marshal(rule)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to JSON marshal CacheVaryRule: %s", err)
+	}
+
+	// Dump Request JSON
+	log.Printf("[DEBUG] Incapsula Add Cache Vary Rule JSON request body: %s\n", string(ruleJSON))
+
+	// Post to Incapsula
+	reqURL := fmt.Sprintf("%s/%d/settings/cache/vary-rules", c.endpointURL(endpointSiteSettingsBase), siteID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodPost, reqURL, ruleJSON, CreateCacheVaryRule)
+	if err != nil {
+		return nil, fmt.Errorf("Error from Incapsula service when adding Cache Vary Rule for Site ID %d: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Add Cache Vary Rule JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from Incapsula service when adding Cache Vary Rule for Site ID %d: %s", resp.StatusCode, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var ruleWithID CacheVaryRuleWithID
+	err = json.Unmarshal([]byte(responseBody), &ruleWithID)
+	if err != nil || !strings.Contains(string(responseBody), "\"rule_id\":") {
+		return nil, fmt.Errorf("Error parsing Cache Vary Rule JSON response for Site ID %d: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	return &ruleWithID, nil
+}
+
+// ListCacheVaryRules gets the cache vary rules of a site
+func (c *Client) ListCacheVaryRules(siteID int) ([]CacheVaryRuleWithID, int, error) {
+	log.Printf("[INFO] Getting Incapsula Cache Vary Rules for Site ID %d\n", siteID)
+
+	reqURL := fmt.Sprintf("%s/%d/settings/cache/vary-rules", c.endpointURL(endpointSiteSettingsBase), siteID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadCacheVaryRule)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error from Incapsula service when reading Cache Vary Rules for Site ID %d: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Read Cache Vary Rules JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, resp.StatusCode, fmt.Errorf("Error status code %d from Incapsula service when reading Cache Vary Rules for Site ID %d: %s", resp.StatusCode, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var rulesResponse CacheVaryRulesResponse
+	err = json.Unmarshal([]byte(responseBody), &rulesResponse)
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("Error parsing Cache Vary Rules JSON response for Site ID %d: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	return rulesResponse.Rules, resp.StatusCode, nil
+}
+
+// DeleteCacheVaryRule deletes a cache vary rule of a site
+func (c *Client) DeleteCacheVaryRule(siteID int, ruleID int) error {
+	log.Printf("[INFO] Deleting Incapsula Cache Vary Rule %d for Site ID %d\n", ruleID, siteID)
+
+	reqURL := fmt.Sprintf("%s/%d/settings/cache/vary-rules/%d", c.endpointURL(endpointSiteSettingsBase), siteID, ruleID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodDelete, reqURL, nil, DeleteCacheVaryRule)
+	if err != nil {
+		return fmt.Errorf("Error from Incapsula service when deleting Cache Vary Rule %d for Site ID %d: %s", ruleID, siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Delete Cache Vary Rule JSON response: %s\n", string(responseBody))
+
+	// Check the response code, a rule that is already gone is considered deleted
+	if resp.StatusCode != 200 && resp.StatusCode != 404 {
+		return fmt.Errorf("Error status code %d from Incapsula service when deleting Cache Vary Rule %d for Site ID %d: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
+	}
+
+	return nil
+}
+
+// validateCacheVaryRule checks the type and the cookie or header name of a rule
+func validateCacheVaryRule(rule CacheVaryRule) error {
+	if !contains(cacheVaryRuleTypes, rule.Type) {
+		return fmt.Errorf("Error - invalid cache vary rule type (%s), must be one of %v", rule.Type, cacheVaryRuleTypes)
+	}
+	if !cacheVaryNameRegex.MatchString(rule.Name) {
+		return fmt.Errorf("Error - invalid %s name (%s), must only contain letters, digits and !#$%%&'*+.^_`|~-", rule.Type, rule.Name)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package incapsula
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// AddCacheVaryRule Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientAddCacheVaryRuleRequestBody(t *testing.T) {
+	expectedBodies := map[string]CacheVaryRule{
+		`{"type":"header","name":"X-Device-Type","values":["mobile","tablet"]}`: {Type: CacheVaryRuleTypeHeader, Name: "X-Device-Type", Values: []string{"mobile", "tablet"}},
+		`{"type":"cookie","name":"currency"}`:                                   {Type: CacheVaryRuleTypeCookie, Name: "currency"},
+	}
+	for expectedBody, rule := range expectedBodies {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if req.Method != http.MethodPost || req.URL.String() != "/sites/42/settings/cache/vary-rules" {
+				t.Errorf("Should have have posted to /sites/42/settings/cache/vary-rules endpoint. Got: %s %s", req.Method, req.URL.String())
+			}
+			body, _ := ioutil.ReadAll(req.Body)
+			if string(body) != expectedBody {
+				t.Errorf("Unexpected request body, expected %s, got: %s", expectedBody, string(body))
+			}
+			rw.Write([]byte(`{"rule_id":3,"type":"header","name":"X-Device-Type"}`))
+		}))
+
+		config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+		client := &Client{config: config, httpClient: &http.Client{}}
+		ruleWithID, err := client.AddCacheVaryRule(42, rule)
+		if err != nil {
+			t.Errorf("Should not have received an error, got: %s", err)
+		} else if ruleWithID.RuleID != 3 {
+			t.Errorf("Expected rule ID 3, got: %d", ruleWithID.RuleID)
+		}
+		server.Close()
+	}
+}
+
+func TestClientAddCacheVaryRuleInvalid(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	invalidRules := map[string]CacheVaryRule{
+		"Error - invalid cache vary rule type (query)": {Type: "query", Name: "device"},
+		"Error - invalid header name (X Device)":       {Type: CacheVaryRuleTypeHeader, Name: "X Device"},
+		"Error - invalid cookie name (device=)":        {Type: CacheVaryRuleTypeCookie, Name: "device="},
+		"Error - invalid cookie name ()":               {Type: CacheVaryRuleTypeCookie},
+	}
+	for expectedError, rule := range invalidRules {
+		_, err := client.AddCacheVaryRule(42, rule)
+		if err == nil || !strings.HasPrefix(err.Error(), expectedError) {
+			t.Errorf("Should have received an error starting with %q, got: %v", expectedError, err)
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// ListCacheVaryRules / DeleteCacheVaryRule Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientListCacheVaryRules(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet || req.URL.String() != "/sites/42/settings/cache/vary-rules" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		rw.Write([]byte(`{"rules":[{"rule_id":3,"type":"cookie","name":"currency","values":["EUR","USD"]}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	rules, statusCode, err := client.ListCacheVaryRules(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if statusCode != 200 || len(rules) != 1 || rules[0].RuleID != 3 || rules[0].Type != CacheVaryRuleTypeCookie || len(rules[0].Values) != 2 {
+		t.Errorf("Unexpected rules, got: %d %+v", statusCode, rules)
+	}
+}
+
+func TestClientDeleteCacheVaryRule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete || req.URL.String() != "/sites/42/settings/cache/vary-rules/3" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		rw.WriteHeader(404)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.DeleteCacheVaryRule(42, 3)
+	if err != nil {
+		t.Errorf("Should not have received an error for a rule that is already gone, got: %s", err)
+	}
+}
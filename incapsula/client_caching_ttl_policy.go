@@ -0,0 +1,61 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// Caching TTL policy enumerations, the TTL applied when caching rules, modes or headers conflict
+const (
+	CachingTTLPolicyShortest = "shortest"
+	CachingTTLPolicyLongest  = "longest"
+)
+
+var cachingTTLPolicies = []string{CachingTTLPolicyShortest, CachingTTLPolicyLongest}
+
+// SetCachingTTLPolicy sets the TTL applied when caching rules, modes or headers conflict, keeping the rest of the site
+// performance settings
+func (c *Client) SetCachingTTLPolicy(siteID int, policy string) error {
+	log.Printf("[INFO] Setting Incapsula caching TTL policy (%s) for site_id: %d\n", policy, siteID)
+
+	err := validateCachingTTLPolicy(policy)
+	if err != nil {
+		return err
+	}
+
+	performanceSettings, _, err := c.GetPerformanceSettings(strconv.Itoa(siteID))
+	if err != nil {
+		return err
+	}
+
+	applyCachingTTLPolicy(performanceSettings, policy)
+
+	_, err = c.UpdatePerformanceSettings(strconv.Itoa(siteID), performanceSettings)
+	if err != nil {
+		return fmt.Errorf("Error setting caching TTL policy for site_id %d: %s", siteID, err)
+	}
+
+	return nil
+}
+
+// validateCachingTTLPolicy checks the policy is one of the caching TTL policies
+func validateCachingTTLPolicy(policy string) error {
+	if !contains(cachingTTLPolicies, policy) {
+		return fmt.Errorf("Error - invalid caching TTL policy (%s), must be one of %v", policy, cachingTTLPolicies)
+	}
+	return nil
+}
+
+// applyCachingTTLPolicy sets the caching TTL policy on the performance settings
+func applyCachingTTLPolicy(performanceSettings *PerformanceSettings, policy string) {
+	performanceSettings.TTL.UseShortestCaching = policy == CachingTTLPolicyShortest
+}
+
+// cachingTTLPolicy derives the caching TTL policy from the performance settings
+func cachingTTLPolicy(performanceSettings *PerformanceSettings) string {
+	if performanceSettings.TTL.UseShortestCaching {
+		return CachingTTLPolicyShortest
+	}
+	return CachingTTLPolicyLongest
+}
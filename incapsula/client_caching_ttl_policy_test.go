@@ -0,0 +1,100 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetCachingTTLPolicy Tests
+////////////////////////////////////////////////////////////////
+
+// setCachingTTLPolicy sets the policy on a site currently using the longest duration and returns the ttl sent
+func setCachingTTLPolicy(t *testing.T, policy string) map[string]interface{} {
+	endpoint := "/sites/42/settings/cache"
+	var ttl map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		if req.Method == http.MethodGet {
+			rw.Write([]byte(`{"mode":{"level":"standard"},"key":{"unite_naked_full_cache":true},"ttl":{"use_shortest_caching":false,"prefer_last_modified":true}}`))
+			return
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		var requestBody map[string]interface{}
+		err := json.Unmarshal(body, &requestBody)
+		if err != nil {
+			t.Fatalf("Failed to parse request body: %s", err)
+		}
+		ttl, _ = requestBody["ttl"].(map[string]interface{})
+		if mode, _ := requestBody["mode"].(map[string]interface{}); mode["level"] != "standard" {
+			t.Errorf("Should have kept the rest of the performance settings, got: %s", string(body))
+		}
+		rw.Write(body)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetCachingTTLPolicy(42, policy)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	return ttl
+}
+
+func TestClientSetCachingTTLPolicyShortest(t *testing.T) {
+	ttl := setCachingTTLPolicy(t, CachingTTLPolicyShortest)
+	if ttl["use_shortest_caching"] != true || ttl["prefer_last_modified"] != true {
+		t.Errorf("Unexpected use_shortest_caching/prefer_last_modified, got: %v", ttl)
+	}
+}
+
+func TestClientSetCachingTTLPolicyLongest(t *testing.T) {
+	ttl := setCachingTTLPolicy(t, CachingTTLPolicyLongest)
+	if ttl["use_shortest_caching"] != false || ttl["prefer_last_modified"] != true {
+		t.Errorf("Unexpected use_shortest_caching/prefer_last_modified, got: %v", ttl)
+	}
+}
+
+func TestClientSetCachingTTLPolicyInvalid(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	for _, policy := range []string{"average", "fixed"} {
+		err := client.SetCachingTTLPolicy(42, policy)
+		expectedError := fmt.Sprintf("Error - invalid caching TTL policy (%s)", policy)
+		if err == nil || !strings.HasPrefix(err.Error(), expectedError) {
+			t.Errorf("Should have received an error starting with %q, got: %v", expectedError, err)
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// cachingTTLPolicy Tests
+////////////////////////////////////////////////////////////////
+
+func TestCachingTTLPolicyReadBack(t *testing.T) {
+	cases := map[string]string{
+		`{"ttl":{"use_shortest_caching":true}}`:  CachingTTLPolicyShortest,
+		`{"ttl":{"use_shortest_caching":false}}`: CachingTTLPolicyLongest,
+		`{}`:                                     CachingTTLPolicyLongest,
+	}
+	for response, expectedPolicy := range cases {
+		var performanceSettings PerformanceSettings
+		err := json.Unmarshal([]byte(response), &performanceSettings)
+		if err != nil {
+			t.Fatalf("Should not have received an error, got: %s", err)
+		}
+		if policy := cachingTTLPolicy(&performanceSettings); policy != expectedPolicy {
+			t.Errorf("Expected caching TTL policy %s for %s, got: %s", expectedPolicy, response, policy)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"log"
 	"net/url"
+	"strconv"
 )
 
 // Endpoints (unexported consts)
@@ -34,11 +35,32 @@ type CertificateEditResponse struct {
 }
 
 type SSL struct {
-	CustomCertificate CustomCertificate `json:"custom_certificate"`
+	CustomCertificate    CustomCertificate    `json:"custom_certificate"`
+	GeneratedCertificate GeneratedCertificate `json:"generated_certificate"`
+}
+
+// GeneratedCertificate is the certificate managed by Imperva for a site
+type GeneratedCertificate struct {
+	Ca string `json:"ca"`
+	// RenewalHistory lists the issuances of the managed certificate. See GetCertRenewalHistory.
+	RenewalHistory []certRenewalEventDto `json:"renewal_history,omitempty"`
 }
 
 type CustomCertificate struct {
-	InputHash string `json:"inputHash"`
+	InputHash      string `json:"inputHash"`
+	Active         bool   `json:"active"`
+	ExpirationDate int64  `json:"expirationDate"`
+	Issuer         string `json:"issuer"`
+	// Chain is the PEM encoded certificate chain, leaf first. See GetCertificateChain.
+	Chain string `json:"chain,omitempty"`
+}
+
+// CustomCertInfo contains the state of the custom certificate of a site.
+// Active is false (and the other fields are empty) when the site has no custom certificate.
+type CustomCertInfo struct {
+	Active         bool
+	ExpirationDate int64
+	Issuer         string
 }
 
 // AddCertificate adds a custom SSL certificate to a site in Incapsula
@@ -64,7 +86,7 @@ func (c *Client) AddCertificate(siteID, certificate, privateKey, passphrase, aut
 	log.Printf("AddCertificate certificate\n%v", values)
 	log.Printf("certificate\n%v", certificate)
 	// Post to Incapsula
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointCertificateAdd)
+	reqURL := c.endpointURL(endpointCertificateAdd)
 	resp, err := c.PostFormWithHeaders(reqURL, values, CreateCustomCertificate)
 	if err != nil {
 		return nil, fmt.Errorf("Error from Incapsula service when adding custom certificate for site_id %s: %s", siteID, err)
@@ -98,25 +120,19 @@ func (c *Client) ListCertificates(siteID, operation string) (*CertificateListRes
 
 	// Post form to Incapsula
 	values := url.Values{"site_id": {siteID}}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointCertificateList)
-	resp, err := c.PostFormWithHeaders(reqURL, values, operation)
-	if err != nil {
-		return nil, fmt.Errorf("Error getting custom certificates for site_id %s: %s", siteID, err)
-	}
-
-	// Read the body
-	defer resp.Body.Close()
-	responseBody, err := ioutil.ReadAll(resp.Body)
+	reqURL := c.endpointURL(endpointCertificateList)
+	var certificateListResponse CertificateListResponse
+	responseBody, err := c.postFormAndDecode(reqURL, values, operation, &certificateListResponse)
 
 	// Dump JSON
 	log.Printf("[DEBUG] Incapsula list certificate (site status) JSON response: %s\n", string(responseBody))
 
-	// Parse the JSON
-	var certificateListResponse CertificateListResponse
-	err = json.Unmarshal([]byte(responseBody), &certificateListResponse)
-	if err != nil {
+	if _, invalidJSON := err.(*jsonDecodeError); invalidJSON {
 		return nil, fmt.Errorf("Error parsing certificates list JSON response for site_id: %s %s\nresponse: %s", siteID, err, string(responseBody))
 	}
+	if err != nil {
+		return nil, fmt.Errorf("Error getting custom certificates for site_id %s: %s", siteID, err)
+	}
 
 	// Look at the response status code from Incapsula
 	if certificateListResponse.Res != 0 {
@@ -126,6 +142,26 @@ func (c *Client) ListCertificates(siteID, operation string) (*CertificateListRes
 	return &certificateListResponse, nil
 }
 
+// GetCustomCertificateInfo gets whether a custom certificate is active on a site, along with its expiration date and issuer
+func (c *Client) GetCustomCertificateInfo(siteID int) (*CustomCertInfo, error) {
+	certificateListResponse, err := c.ListCertificates(strconv.Itoa(siteID), ReadCustomCertificate)
+	if err != nil {
+		return nil, err
+	}
+
+	customCertificate := certificateListResponse.SSL.CustomCertificate
+	if !customCertificate.Active {
+		log.Printf("[INFO] No custom certificate is active for site_id: %d\n", siteID)
+		return &CustomCertInfo{Active: false}, nil
+	}
+
+	return &CustomCertInfo{
+		Active:         true,
+		ExpirationDate: customCertificate.ExpirationDate,
+		Issuer:         customCertificate.Issuer,
+	}, nil
+}
+
 // EditCertificate updates the custom certifiacte on an Incapsula site
 func (c *Client) EditCertificate(siteID, certificate, privateKey, passphrase, authType, inputHash string) (*CertificateEditResponse, error) {
 
@@ -149,7 +185,7 @@ func (c *Client) EditCertificate(siteID, certificate, privateKey, passphrase, au
 	}
 
 	// Post to Incapsula
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointCertificateEdit)
+	reqURL := c.endpointURL(endpointCertificateEdit)
 	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateCustomCertificate)
 	if err != nil {
 		return nil, fmt.Errorf("Error editing custom certificate for site_id: %s: %s", siteID, err)
@@ -195,7 +231,7 @@ func (c *Client) DeleteCertificate(siteID, authType string) error {
 		values.Set("auth_type", authType)
 	}
 
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointCertificateDelete)
+	reqURL := c.endpointURL(endpointCertificateDelete)
 	resp, err := c.PostFormWithHeaders(reqURL, values, DeleteCustomCertificate)
 	if err != nil {
 		return fmt.Errorf("Error deleting custom certificate for site_id: %s %s", siteID, err)
@@ -0,0 +1,112 @@
+package incapsula
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// CertInfo contains the details of a certificate of a chain
+type CertInfo struct {
+	Subject      string
+	Issuer       string
+	SerialNumber string
+	NotBefore    time.Time
+	NotAfter     time.Time
+	IsCA         bool
+	// SHA-256 of the DER encoded certificate, in the openssl format e.g. A6:6E:...:13
+	Fingerprint string
+}
+
+// GetCertificateChain gets the chain of the custom certificate of a site, leaf first followed by the intermediates.
+// The chain is empty when the site has no custom certificate. The certificates themselves are never logged.
+func (c *Client) GetCertificateChain(siteID string) ([]CertInfo, error) {
+	log.Printf("[INFO] Getting Incapsula custom certificate chain for site_id: %s\n", siteID)
+
+	// Not read with ListCertificates, which dumps the whole response
+	values := url.Values{"site_id": {siteID}}
+	reqURL := c.endpointURL(endpointCertificateList)
+	var certificateListResponse CertificateListResponse
+	_, err := c.postFormAndDecode(reqURL, values, ReadCustomCertificate, &certificateListResponse)
+	if _, invalidJSON := err.(*jsonDecodeError); invalidJSON {
+		return nil, fmt.Errorf("Error parsing certificate chain JSON response for site_id %s: %s", siteID, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error getting custom certificate chain for site_id %s: %s", siteID, err)
+	}
+	if certificateListResponse.Res != 0 {
+		return nil, fmt.Errorf("Error from Incapsula service when getting custom certificate chain for site_id %s: res %d", siteID, certificateListResponse.Res)
+	}
+
+	chain, err := parseCertificateChain(certificateListResponse.SSL.CustomCertificate.Chain)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing custom certificate chain for site_id %s: %s", siteID, err)
+	}
+
+	return chain, nil
+}
+
+// GetCertificateFingerprint gets the SHA-256 fingerprint of the leaf certificate of a site, e.g. for certificate
+// pinning. It's an error when the site has no issued custom certificate.
+func (c *Client) GetCertificateFingerprint(siteID string) (string, error) {
+	log.Printf("[INFO] Getting Incapsula custom certificate fingerprint for site_id: %s\n", siteID)
+
+	chain, err := c.GetCertificateChain(siteID)
+	if err != nil {
+		return "", err
+	}
+	if len(chain) == 0 {
+		return "", fmt.Errorf("Error - site_id %s has no issued custom certificate to get the fingerprint of", siteID)
+	}
+
+	return chain[0].Fingerprint, nil
+}
+
+// certificateFingerprint returns the SHA-256 fingerprint of a DER encoded certificate, in uppercase hex pairs
+// separated by colons like openssl x509 -fingerprint -sha256
+func certificateFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	pairs := make([]string, len(sum))
+	for i, b := range sum {
+		pairs[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(pairs, ":")
+}
+
+// parseCertificateChain parses the PEM encoded certificates of a chain, in order. Blocks other than certificates are
+// skipped, and errors don't include the certificate material.
+func parseCertificateChain(pemChain string) ([]CertInfo, error) {
+	chain := make([]CertInfo, 0)
+	rest := []byte(pemChain)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate at position %d of the chain: %s", len(chain), err)
+		}
+		chain = append(chain, CertInfo{
+			Subject:      certificate.Subject.String(),
+			Issuer:       certificate.Issuer.String(),
+			SerialNumber: certificate.SerialNumber.String(),
+			NotBefore:    certificate.NotBefore,
+			NotAfter:     certificate.NotAfter,
+			IsCA:         certificate.IsCA,
+			Fingerprint:  certificateFingerprint(block.Bytes),
+		})
+	}
+
+	return chain, nil
+}
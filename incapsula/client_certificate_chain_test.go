@@ -0,0 +1,199 @@
+package incapsula
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCertificateChain returns a PEM encoded chain of a leaf certificate issued by an intermediate CA
+func testCertificateChain(t *testing.T, notBefore time.Time) string {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Intermediate CA", Organization: []string{"Test"}},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(365 * 24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("Failed to create CA certificate: %s", err)
+	}
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %s", err)
+	}
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(4242),
+		Subject:      pkix.Name{CommonName: "www.example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(90 * 24 * time.Hour),
+		DNSNames:     []string{"www.example.com"},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, caTemplate, &leafKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("Failed to create leaf certificate: %s", err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}))
+}
+
+////////////////////////////////////////////////////////////////
+// parseCertificateChain Tests
+////////////////////////////////////////////////////////////////
+
+func TestParseCertificateChain(t *testing.T) {
+	notBefore := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	chain, err := parseCertificateChain(testCertificateChain(t, notBefore))
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(chain) != 2 {
+		t.Fatalf("Should have parsed 2 certificates, got: %d", len(chain))
+	}
+
+	leaf := chain[0]
+	if leaf.Subject != "CN=www.example.com" || leaf.Issuer != "CN=Test Intermediate CA,O=Test" || leaf.SerialNumber != "4242" || leaf.IsCA {
+		t.Errorf("Unexpected leaf certificate, got: %+v", leaf)
+	}
+	if !leaf.NotBefore.Equal(notBefore) || !leaf.NotAfter.Equal(notBefore.Add(90*24*time.Hour)) {
+		t.Errorf("Unexpected leaf validity, got: %s - %s", leaf.NotBefore, leaf.NotAfter)
+	}
+
+	intermediate := chain[1]
+	if intermediate.Subject != "CN=Test Intermediate CA,O=Test" || !intermediate.IsCA {
+		t.Errorf("Unexpected intermediate certificate, got: %+v", intermediate)
+	}
+}
+
+func TestParseCertificateChainInvalidCertificate(t *testing.T) {
+	invalid := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("not a certificate")}))
+	_, err := parseCertificateChain(invalid)
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "invalid certificate at position 0 of the chain") {
+		t.Errorf("Should have received an invalid certificate error, got: %s", err)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetCertificateChain Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetCertificateChain(t *testing.T) {
+	pemChain := testCertificateChain(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		if req.PostForm.Get("site_id") != "42" {
+			t.Errorf("Expected site_id to be 42, got: %s", req.PostForm.Get("site_id"))
+		}
+		response, _ := json.Marshal(map[string]interface{}{
+			"res": 0,
+			"ssl": map[string]interface{}{"custom_certificate": map[string]interface{}{"active": true, "chain": pemChain}},
+		})
+		rw.Write(response)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	chain, err := client.GetCertificateChain("42")
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(chain) != 2 || chain[0].Subject != "CN=www.example.com" {
+		t.Errorf("Unexpected chain, got: %+v", chain)
+	}
+}
+
+func TestClientGetCertificateChainNoCustomCertificate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":0,"ssl":{"custom_certificate":{"active":false}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	chain, err := client.GetCertificateChain("42")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(chain) != 0 {
+		t.Errorf("Should have received an empty chain, got: %+v", chain)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetCertificateFingerprint Tests
+////////////////////////////////////////////////////////////////
+
+// fingerprintTestCertificate is a self-signed certificate whose SHA-256 fingerprint was computed with
+// openssl x509 -noout -fingerprint -sha256
+const fingerprintTestCertificate = `-----BEGIN CERTIFICATE-----
+MIIBjzCCATWgAwIBAgIUZRIeqg1UBFlJsbjAaNB+/HBr078wCgYIKoZIzj0EAwIw
+HTEbMBkGA1UEAwwScGlubmVkLmV4YW1wbGUuY29tMB4XDTI2MTAxNjEwMDIyN1oX
+DTM2MTAxMzEwMDIyN1owHTEbMBkGA1UEAwwScGlubmVkLmV4YW1wbGUuY29tMFkw
+EwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAENY/Fpv5zCUL03AVKmdqc2+zF/0WioWLb
+VRuxDXJXg6vs6L8iXHVXJdgn7u1EUGWDe5mB4UxOlZiMaedsffnSm6NTMFEwHQYD
+VR0OBBYEFPMmelkTBb71d7KGY24oQJZcvKFYMB8GA1UdIwQYMBaAFPMmelkTBb71
+d7KGY24oQJZcvKFYMA8GA1UdEwEB/wQFMAMBAf8wCgYIKoZIzj0EAwIDSAAwRQIh
+AJmw7vuE3sCvnW/5B3KLXdA3Ea6yaB+4au0KEyOzs5PpAiAy1KTHkl5FjE7BJSD5
+KuPpj9YFWB4y+dBzwBaLyuMx5A==
+-----END CERTIFICATE-----
+`
+
+const fingerprintTestCertificateSHA256 = "A6:6E:AD:73:C5:C0:A9:0E:18:05:78:D3:0B:08:AE:96:78:E8:20:0C:D8:D9:55:72:62:20:A1:02:C9:4E:71:13"
+
+func TestClientGetCertificateFingerprint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		response, _ := json.Marshal(map[string]interface{}{
+			"res": 0,
+			"ssl": map[string]interface{}{"custom_certificate": map[string]interface{}{"active": true, "chain": fingerprintTestCertificate + testCertificateChain(t, time.Now())}},
+		})
+		rw.Write(response)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	fingerprint, err := client.GetCertificateFingerprint("42")
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if fingerprint != fingerprintTestCertificateSHA256 {
+		t.Errorf("Expected the leaf certificate fingerprint %s, got: %s", fingerprintTestCertificateSHA256, fingerprint)
+	}
+}
+
+func TestClientGetCertificateFingerprintNoCustomCertificate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":0,"ssl":{"custom_certificate":{"active":false}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.GetCertificateFingerprint("42")
+	if err == nil || err.Error() != "Error - site_id 42 has no issued custom certificate to get the fingerprint of" {
+		t.Errorf("Should have received a no issued certificate error, got: %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// certRenewalHistoryLimit caps the number of renewal events returned, most recent first
+const certRenewalHistoryLimit = 50
+
+// CertRenewalEvent is an issuance of the managed certificate of a site
+type CertRenewalEvent struct {
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	CA        string
+	Status    string
+}
+
+// certRenewalEventDto is a renewal event as returned by the API, with the dates in epoch milliseconds
+type certRenewalEventDto struct {
+	IssuedDate     int64  `json:"issued_date"`
+	ExpirationDate int64  `json:"expiration_date"`
+	Ca             string `json:"ca"`
+	Status         string `json:"status"`
+}
+
+// GetCertRenewalHistory gets the renewal history of the managed certificate of a site, most recent first and capped at
+// certRenewalHistoryLimit events. The history is empty when the site has never had a managed certificate.
+func (c *Client) GetCertRenewalHistory(siteID string) ([]CertRenewalEvent, error) {
+	log.Printf("[INFO] Getting Incapsula managed certificate renewal history for site_id: %s\n", siteID)
+
+	// Not read with ListCertificates, which dumps the whole response
+	values := url.Values{"site_id": {siteID}}
+	reqURL := c.endpointURL(endpointCertificateList)
+	var certificateListResponse CertificateListResponse
+	_, err := c.postFormAndDecode(reqURL, values, ReadCustomCertificate, &certificateListResponse)
+	if _, invalidJSON := err.(*jsonDecodeError); invalidJSON {
+		return nil, fmt.Errorf("Error parsing certificate renewal history JSON response for site_id %s: %s", siteID, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error getting certificate renewal history for site_id %s: %s", siteID, err)
+	}
+	if certificateListResponse.Res != 0 {
+		return nil, fmt.Errorf("Error from Incapsula service when getting certificate renewal history for site_id %s: res %d", siteID, certificateListResponse.Res)
+	}
+
+	return parseCertRenewalHistory(certificateListResponse.SSL.GeneratedCertificate.RenewalHistory, certRenewalHistoryLimit), nil
+}
+
+// parseCertRenewalHistory converts the renewal events, sorted by issue date descending and capped at limit events.
+// A limit of 0 keeps all the events.
+func parseCertRenewalHistory(eventDtos []certRenewalEventDto, limit int) []CertRenewalEvent {
+	events := make([]CertRenewalEvent, 0, len(eventDtos))
+	for _, eventDto := range eventDtos {
+		event := CertRenewalEvent{
+			IssuedAt: time.Unix(0, eventDto.IssuedDate*int64(time.Millisecond)).UTC(),
+			CA:       eventDto.Ca,
+			Status:   eventDto.Status,
+		}
+		if eventDto.ExpirationDate != 0 {
+			event.ExpiresAt = time.Unix(0, eventDto.ExpirationDate*int64(time.Millisecond)).UTC()
+		}
+		events = append(events, event)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].IssuedAt.After(events[j].IssuedAt)
+	})
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+
+	return events
+}
@@ -0,0 +1,64 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// parseCertRenewalHistory Tests
+////////////////////////////////////////////////////////////////
+
+func TestParseCertRenewalHistory(t *testing.T) {
+	var certificateListResponse CertificateListResponse
+	err := json.Unmarshal([]byte(`{"res":0,"ssl":{"generated_certificate":{"ca":"GS","renewal_history":[
+		{"issued_date":1767225600000,"expiration_date":1774915200000,"ca":"GS","status":"ISSUED"},
+		{"issued_date":1774915200000,"expiration_date":1782691200000,"ca":"LE","status":"ISSUED"},
+		{"issued_date":1759363200000,"ca":"GS","status":"FAILED"}]}}}`), &certificateListResponse)
+	if err != nil {
+		t.Fatalf("Failed to parse response: %s", err)
+	}
+
+	events := parseCertRenewalHistory(certificateListResponse.SSL.GeneratedCertificate.RenewalHistory, 0)
+	if len(events) != 3 {
+		t.Fatalf("Should have parsed 3 renewal events, got: %d", len(events))
+	}
+	if events[0].CA != "LE" || !events[0].IssuedAt.Equal(time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)) || !events[0].ExpiresAt.Equal(time.Date(2026, 6, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected most recent renewal event, got: %+v", events[0])
+	}
+	if events[1].CA != "GS" || !events[1].IssuedAt.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected second renewal event, got: %+v", events[1])
+	}
+	if events[2].Status != "FAILED" || !events[2].ExpiresAt.IsZero() {
+		t.Errorf("Unexpected oldest renewal event, got: %+v", events[2])
+	}
+
+	capped := parseCertRenewalHistory(certificateListResponse.SSL.GeneratedCertificate.RenewalHistory, 2)
+	if len(capped) != 2 || capped[0].CA != "LE" || capped[1].CA != "GS" {
+		t.Errorf("Should have kept the 2 most recent renewal events, got: %+v", capped)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetCertRenewalHistory Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetCertRenewalHistoryNoManagedCertificate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":0,"ssl":{"custom_certificate":{"active":false}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	events, err := client.GetCertRenewalHistory("42")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("Should have received an empty history, got: %+v", events)
+	}
+}
@@ -58,7 +58,7 @@ func (c *Client) CreateCertificateSigningRequest(siteID, domain, email, country,
 	}
 	log.Printf("CertificateSigningRequest\n%v", values)
 	// Post to Incapsula
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointCertificateSigningRequestCreate)
+	reqURL := c.endpointURL(endpointCertificateSigningRequestCreate)
 	resp, err := c.PostFormWithHeaders(reqURL, values, CreateCertificateSigningRequest)
 	if err != nil {
 		return nil, fmt.Errorf("Error from Incapsula service when creating certificate signing request for site_id %s: %s", siteID, err)
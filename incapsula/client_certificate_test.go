@@ -184,6 +184,57 @@ func TestClientListCertificatesInvalidRequest(t *testing.T) {
 	}
 }
 
+////////////////////////////////////////////////////////////////
+// GetCustomCertificateInfo Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetCustomCertificateInfoActive(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_certificate_test.TestClientGetCustomCertificateInfoActive")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointCertificateList) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointCertificateList, req.URL.String())
+		}
+		rw.Write([]byte(`{"res":0,"ssl":{"custom_certificate":{"active":true,"expirationDate":1735689600000,"issuer":"DigiCert Inc","inputHash":"abc"}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	customCertInfo, err := client.GetCustomCertificateInfo(1234)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if customCertInfo == nil || !customCertInfo.Active {
+		t.Fatalf("Should have received an active custom certificate")
+	}
+	if customCertInfo.ExpirationDate != 1735689600000 {
+		t.Errorf("Expiration date doesn't match, got: %d", customCertInfo.ExpirationDate)
+	}
+	if customCertInfo.Issuer != "DigiCert Inc" {
+		t.Errorf("Issuer doesn't match, got: %s", customCertInfo.Issuer)
+	}
+}
+
+func TestClientGetCustomCertificateInfoInactive(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_certificate_test.TestClientGetCustomCertificateInfoInactive")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":0,"ssl":{"custom_certificate":{"active":false}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	customCertInfo, err := client.GetCustomCertificateInfo(1234)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if customCertInfo == nil || customCertInfo.Active || customCertInfo.ExpirationDate != 0 || customCertInfo.Issuer != "" {
+		t.Errorf("Should have received an inactive custom certificate, got: %+v", customCertInfo)
+	}
+}
+
 ////////////////////////////////////////////////////////////////
 // EditCertificate Tests
 ////////////////////////////////////////////////////////////////
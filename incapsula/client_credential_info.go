@@ -0,0 +1,47 @@
+package incapsula
+
+import (
+	"log"
+)
+
+const resellerAccountType = "Reseller"
+
+// CredentialInfo describes the account the API credentials belong to
+type CredentialInfo struct {
+	AccountID   int
+	AccountName string
+	// Role is the account type of the credentials, e.g. Reseller, Enterprise or Sub Account
+	Role       string
+	IsReseller bool
+}
+
+// WhoAmI returns the account the API credentials belong to, from the account status
+func (c *Client) WhoAmI() (*CredentialInfo, error) {
+	accountStatusResponse, err := c.Verify()
+	if err != nil {
+		return nil, err
+	}
+
+	return credentialInfoFromAccountStatus(accountStatusResponse), nil
+}
+
+// credentialInfoFromAccountStatus returns the credential info of an account status. The account of a reseller is
+// nested under account, so it's preferred over the top level fields when set.
+func credentialInfoFromAccountStatus(accountStatusResponse *AccountStatusResponse) *CredentialInfo {
+	credentialInfo := &CredentialInfo{
+		AccountID:   accountStatusResponse.AccountID,
+		AccountName: accountStatusResponse.AccountName,
+		Role:        accountStatusResponse.AccountType,
+		IsReseller:  accountStatusResponse.AccountType == resellerAccountType,
+	}
+	if accountStatusResponse.Account.AccountID != 0 {
+		credentialInfo.AccountID = accountStatusResponse.Account.AccountID
+		credentialInfo.AccountName = accountStatusResponse.Account.AccountName
+	}
+	return credentialInfo
+}
+
+// logCredentialInfo logs the account the API credentials belong to, to catch wrong credentials early
+func logCredentialInfo(credentialInfo *CredentialInfo) {
+	log.Printf("[INFO] Incapsula API credentials belong to account %d (%s), role: %s, reseller: %t\n", credentialInfo.AccountID, credentialInfo.AccountName, credentialInfo.Role, credentialInfo.IsReseller)
+}
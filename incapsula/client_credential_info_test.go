@@ -0,0 +1,64 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// WhoAmI Tests
+////////////////////////////////////////////////////////////////
+
+func whoAmI(t *testing.T, accountStatus string) *CredentialInfo {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointAccountStatus) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointAccountStatus, req.URL.String())
+		}
+		rw.Write([]byte(accountStatus))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	credentialInfo, err := client.WhoAmI()
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	return credentialInfo
+}
+
+func TestClientWhoAmIReseller(t *testing.T) {
+	credentialInfo := whoAmI(t, `{"account":{"account_id":100,"account_name":"Reseller Inc"},"account_type":"Reseller","res":0}`)
+	if credentialInfo.AccountID != 100 || credentialInfo.AccountName != "Reseller Inc" {
+		t.Errorf("Unexpected account, got: %d (%s)", credentialInfo.AccountID, credentialInfo.AccountName)
+	}
+	if credentialInfo.Role != "Reseller" || !credentialInfo.IsReseller {
+		t.Errorf("Should have been a reseller, got role: %s", credentialInfo.Role)
+	}
+}
+
+func TestClientWhoAmIDirectAccount(t *testing.T) {
+	credentialInfo := whoAmI(t, `{"account_id":200,"account_name":"Example Corp","account_type":"Enterprise","res":0}`)
+	if credentialInfo.AccountID != 200 || credentialInfo.AccountName != "Example Corp" {
+		t.Errorf("Unexpected account, got: %d (%s)", credentialInfo.AccountID, credentialInfo.AccountName)
+	}
+	if credentialInfo.Role != "Enterprise" || credentialInfo.IsReseller {
+		t.Errorf("Should not have been a reseller, got role: %s", credentialInfo.Role)
+	}
+}
+
+func TestClientWhoAmIBadCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":4001,"res_message":"Authentication parameters missing or incorrect"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.WhoAmI()
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+}
@@ -26,7 +26,9 @@ type DataCenterListResponse struct {
 	DCs []struct {
 		ID      string `json:"id"`
 		Enabled string `json:"enabled"`
-		Servers []struct {
+		// HealthCheck is nil when the data center has no origin health check
+		HealthCheck *HealthCheckConfig `json:"healthCheck"`
+		Servers     []struct {
 			ID        string `json:"id"`
 			Enabled   string `json:"enabled"`
 			Address   string `json:"address"`
@@ -57,7 +59,7 @@ func (c *Client) AddDataCenter(siteID, name, serverAddress, isContent, isEnabled
 		"is_content":     {isContent},
 		"is_enabled":     {isEnabled},
 	}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointDataCenterAdd)
+	reqURL := c.endpointURL(endpointDataCenterAdd)
 	resp, err := c.PostFormWithHeaders(reqURL, values, CreateDataCenter)
 	if err != nil {
 		return nil, fmt.Errorf("Error from Incapsula service when adding data center for siteID %s: %s", siteID, err)
@@ -101,7 +103,7 @@ func (c *Client) ListDataCenters(siteID string) (*DataCenterListResponse, error)
 
 	// Post form to Incapsula
 	values := url.Values{"site_id": {siteID}}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointDataCenterList)
+	reqURL := c.endpointURL(endpointDataCenterList)
 	resp, err := c.PostFormWithHeaders(reqURL, values, ReadDataCenter)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting data centers for siteID %s: %s", siteID, err)
@@ -160,7 +162,7 @@ func (c *Client) EditDataCenter(dcID, name, isContent, isEnabled string) (*DataC
 	}
 
 	// Post form to Incapsula
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointDataCenterEdit)
+	reqURL := c.endpointURL(endpointDataCenterEdit)
 	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateDataCenter)
 	if err != nil {
 		return nil, fmt.Errorf("Error editing data center (%s): %s", dcID, err)
@@ -215,7 +217,7 @@ func (c *Client) DeleteDataCenter(dcID string) error {
 
 	// Post form to Incapsula
 	values := url.Values{"dc_id": {dcID}}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointDataCenterDelete)
+	reqURL := c.endpointURL(endpointDataCenterDelete)
 	resp, err := c.PostFormWithHeaders(reqURL, values, DeleteDataCenter)
 	if err != nil {
 		return fmt.Errorf("Error deleting data center (%s): %s", dcID, err)
@@ -0,0 +1,115 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Endpoints (unexported consts)
+const endpointDataCenterHealthCheck = "sites/dataCenters/healthCheck"
+
+// Origin health check limits
+const (
+	healthCheckMinInterval  = 10
+	healthCheckMaxInterval  = 300
+	healthCheckMaxThreshold = 10
+)
+
+// HealthCheckConfig is the health check of the origin servers of a data center. A server is marked down after
+// UnhealthyThreshold consecutive failed checks, and up again after HealthyThreshold consecutive successful checks.
+type HealthCheckConfig struct {
+	Path               string `json:"path"`
+	Interval           int    `json:"interval"`
+	HealthyThreshold   int    `json:"healthyThreshold"`
+	UnhealthyThreshold int    `json:"unhealthyThreshold"`
+	ExpectedStatus     int    `json:"expectedStatus"`
+}
+
+// DataCenterHealthCheckResponse contains the response of setting the origin health check of a data center
+type DataCenterHealthCheckResponse struct {
+	Res        interface{} `json:"res"`
+	ResMessage string      `json:"res_message"`
+	DebugInfo  DebugInfo   `json:"debug_info"`
+}
+
+// validateHealthCheckConfig checks the path, interval (in seconds), thresholds and expected status of a health check
+func validateHealthCheckConfig(check HealthCheckConfig) error {
+	if !strings.HasPrefix(check.Path, "/") || strings.ContainsAny(check.Path, " \t\n") {
+		return fmt.Errorf("Error - invalid health check path (%s), must be a path starting with /", check.Path)
+	}
+	if check.Interval < healthCheckMinInterval || check.Interval > healthCheckMaxInterval {
+		return fmt.Errorf("Error - invalid health check interval (%d), must be between %d and %d seconds", check.Interval, healthCheckMinInterval, healthCheckMaxInterval)
+	}
+	if check.HealthyThreshold < 1 || check.HealthyThreshold > healthCheckMaxThreshold {
+		return fmt.Errorf("Error - invalid health check healthy threshold (%d), must be between 1 and %d", check.HealthyThreshold, healthCheckMaxThreshold)
+	}
+	if check.UnhealthyThreshold < 1 || check.UnhealthyThreshold > healthCheckMaxThreshold {
+		return fmt.Errorf("Error - invalid health check unhealthy threshold (%d), must be between 1 and %d", check.UnhealthyThreshold, healthCheckMaxThreshold)
+	}
+	if check.ExpectedStatus < 100 || check.ExpectedStatus > 599 {
+		return fmt.Errorf("Error - invalid health check expected status (%d), must be between 100 and 599", check.ExpectedStatus)
+	}
+	return nil
+}
+
+// SetOriginHealthCheck sets the health check of the origin servers of a data center. The servers failing the health
+// check stop receiving traffic, and the site fails over to another data center when all of them do.
+func (c *Client) SetOriginHealthCheck(siteID int, dcID string, check HealthCheckConfig) error {
+	log.Printf("[INFO] Setting Incapsula origin health check (%+v) for data center %s of site_id: %d\n", check, dcID, siteID)
+
+	if err := validateHealthCheckConfig(check); err != nil {
+		return err
+	}
+
+	values := url.Values{
+		"site_id":             {strconv.Itoa(siteID)},
+		"dc_id":               {dcID},
+		"path":                {check.Path},
+		"interval":            {strconv.Itoa(check.Interval)},
+		"healthy_threshold":   {strconv.Itoa(check.HealthyThreshold)},
+		"unhealthy_threshold": {strconv.Itoa(check.UnhealthyThreshold)},
+		"expected_status":     {strconv.Itoa(check.ExpectedStatus)},
+	}
+	var healthCheckResponse DataCenterHealthCheckResponse
+	responseBody, err := c.postFormAndDecode(c.endpointURL(endpointDataCenterHealthCheck), values, UpdateDataCenter, &healthCheckResponse)
+	if err != nil {
+		return fmt.Errorf("Error setting origin health check for data center %s of site_id %d: %s", dcID, siteID, err)
+	}
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula set origin health check JSON response: %s\n", string(responseBody))
+
+	var resString string
+	if resNumber, ok := healthCheckResponse.Res.(float64); ok {
+		resString = fmt.Sprintf("%d", int(resNumber))
+	} else {
+		resString, _ = healthCheckResponse.Res.(string)
+	}
+
+	if resString != "0" {
+		return newIncapsulaError(resString, healthCheckResponse.DebugInfo, "Error from Incapsula service when setting origin health check for data center %s of site_id %d: %s", dcID, siteID, string(responseBody))
+	}
+
+	return nil
+}
+
+// GetOriginHealthCheck gets the health check of the origin servers of a data center, nil when it has none
+func (c *Client) GetOriginHealthCheck(siteID int, dcID string) (*HealthCheckConfig, error) {
+	log.Printf("[INFO] Getting Incapsula origin health check for data center %s of site_id: %d\n", dcID, siteID)
+
+	listDataCentersResponse, err := c.ListDataCenters(strconv.Itoa(siteID))
+	if err != nil {
+		return nil, fmt.Errorf("Error getting origin health check for data center %s of site_id %d: %s", dcID, siteID, err)
+	}
+
+	for _, dataCenter := range listDataCentersResponse.DCs {
+		if dataCenter.ID == dcID {
+			return dataCenter.HealthCheck, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Error getting origin health check for data center %s of site_id %d: data center not found", dcID, siteID)
+}
@@ -0,0 +1,109 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetOriginHealthCheck Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetOriginHealthCheckInvalidConfig(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	valid := HealthCheckConfig{Path: "/health", Interval: 30, HealthyThreshold: 2, UnhealthyThreshold: 3, ExpectedStatus: 200}
+
+	cases := []struct {
+		update        func(check *HealthCheckConfig)
+		expectedError string
+	}{
+		{func(check *HealthCheckConfig) { check.Path = "health" }, "Error - invalid health check path (health)"},
+		{func(check *HealthCheckConfig) { check.Path = "/he alth" }, "Error - invalid health check path (/he alth)"},
+		{func(check *HealthCheckConfig) { check.Interval = 5 }, "Error - invalid health check interval (5)"},
+		{func(check *HealthCheckConfig) { check.Interval = 301 }, "Error - invalid health check interval (301)"},
+		{func(check *HealthCheckConfig) { check.HealthyThreshold = 0 }, "Error - invalid health check healthy threshold (0)"},
+		{func(check *HealthCheckConfig) { check.UnhealthyThreshold = 11 }, "Error - invalid health check unhealthy threshold (11)"},
+		{func(check *HealthCheckConfig) { check.ExpectedStatus = 99 }, "Error - invalid health check expected status (99)"},
+	}
+	for _, c := range cases {
+		check := valid
+		c.update(&check)
+		err := client.SetOriginHealthCheck(42, "123", check)
+		if err == nil || !strings.HasPrefix(err.Error(), c.expectedError) {
+			t.Errorf("Should have received an error starting with %q, got: %v", c.expectedError, err)
+		}
+	}
+}
+
+func TestClientSetOriginHealthCheckValidConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		switch req.URL.String() {
+		case fmt.Sprintf("/%s", endpointDataCenterHealthCheck):
+			expected := url.Values{
+				"site_id":             {"42"},
+				"dc_id":               {"123"},
+				"path":                {"/health"},
+				"interval":            {"30"},
+				"healthy_threshold":   {"2"},
+				"unhealthy_threshold": {"3"},
+				"expected_status":     {"204"},
+			}
+			if !reflect.DeepEqual(req.PostForm, expected) {
+				t.Errorf("Expected request body %v, got: %v", expected, req.PostForm)
+			}
+			rw.Write([]byte(`{"res":0,"res_message":"OK"}`))
+		case fmt.Sprintf("/%s", endpointDataCenterList):
+			rw.Write([]byte(`{"res":0,"DCs":[
+				{"id":"122","name":"Backup","servers":[{"id":"1","address":"1.2.3.4"}]},
+				{"id":"123","name":"Main","healthCheck":{"path":"/health","interval":30,"healthyThreshold":2,"unhealthyThreshold":3,"expectedStatus":204},"servers":[{"id":"2","address":"5.6.7.8"}]}]}`))
+		default:
+			t.Errorf("Unexpected request to %s", req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	check := HealthCheckConfig{Path: "/health", Interval: 30, HealthyThreshold: 2, UnhealthyThreshold: 3, ExpectedStatus: 204}
+	err := client.SetOriginHealthCheck(42, "123", check)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	readCheck, err := client.GetOriginHealthCheck(42, "123")
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if readCheck == nil || *readCheck != check {
+		t.Errorf("Expected health check %+v to be read back, got: %+v", check, readCheck)
+	}
+
+	readCheck, err = client.GetOriginHealthCheck(42, "122")
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if readCheck != nil {
+		t.Errorf("Should not have read a health check for a data center without one, got: %+v", readCheck)
+	}
+}
+
+func TestClientSetOriginHealthCheckBadResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":2,"res_message":"Invalid input","debug_info":{"id-info":"999999"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetOriginHealthCheck(42, "123", HealthCheckConfig{Path: "/health", Interval: 30, HealthyThreshold: 2, UnhealthyThreshold: 3, ExpectedStatus: 200})
+	if err == nil || !strings.HasPrefix(err.Error(), "Error from Incapsula service when setting origin health check for data center 123 of site_id 42") {
+		t.Errorf("Should have received a bad response error, got: %v", err)
+	}
+}
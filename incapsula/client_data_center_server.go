@@ -42,7 +42,7 @@ func (c *Client) AddDataCenterServer(dcID, serverAddress, isStandby string, isEn
 		"is_standby":     {isStandby},
 		"is_disabled":    {strconv.FormatBool(!bIsEnabled)},
 	}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointDataCenterServerAdd)
+	reqURL := c.endpointURL(endpointDataCenterServerAdd)
 	resp, err := c.PostFormWithHeaders(reqURL, values, CreateDataCenterServer)
 	if err != nil {
 		return nil, fmt.Errorf("Error from Incapsula service when adding data center server for dcID %s: %s", dcID, err)
@@ -91,7 +91,7 @@ func (c *Client) EditDataCenterServer(serverID, serverAddress, isStandby, isEnab
 		"is_standby":     {isStandby},
 		"is_enabled":     {isEnabled},
 	}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointDataCenterServerEdit)
+	reqURL := c.endpointURL(endpointDataCenterServerEdit)
 	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateDataCenterServer)
 	if err != nil {
 		return nil, fmt.Errorf("Error editing data center server for serverID: %s: %s", serverID, err)
@@ -142,7 +142,7 @@ func (c *Client) DeleteDataCenterServer(serverID string) error {
 
 	// Post form to Incapsula
 	values := url.Values{"server_id": {serverID}}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointDataCenterServerDelete)
+	reqURL := c.endpointURL(endpointDataCenterServerDelete)
 	resp, err := c.PostFormWithHeaders(reqURL, values, DeleteDataCenterServer)
 	if err != nil {
 		return fmt.Errorf("Error deleting data center server (server_id: %s): %s", serverID, err)
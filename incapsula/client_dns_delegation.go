@@ -0,0 +1,110 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strings"
+)
+
+// DNSResolver looks up the live DNS records of a domain. *net.Resolver implements it.
+type DNSResolver interface {
+	LookupCNAME(ctx context.Context, host string) (string, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// DelegationMismatch is a DNS record of the site that doesn't point at Incapsula yet
+type DelegationMismatch struct {
+	RecordName string
+	RecordType string
+	Expected   []string
+	Actual     []string
+}
+
+// DelegationResult is the outcome of comparing the DNS instructions of a site with the live DNS
+type DelegationResult struct {
+	Domain     string
+	Delegated  bool
+	Mismatches []DelegationMismatch
+}
+
+// dnsResolver returns the resolver used for the live DNS lookups
+func (c *Client) dnsResolver() DNSResolver {
+	if c.resolver != nil {
+		return c.resolver
+	}
+	return net.DefaultResolver
+}
+
+// VerifyDNSDelegation checks that the live DNS records of the site match the DNS instructions from the site status,
+// i.e. that traffic will actually flow through Incapsula. CNAME records must resolve to the expected target and A records
+// must only resolve to the expected Incapsula IPs.
+func (c *Client) VerifyDNSDelegation(siteID int) (*DelegationResult, error) {
+	log.Printf("[INFO] Verifying DNS delegation for site_id: %d\n", siteID)
+
+	siteStatusResponse, err := c.SiteStatus("", siteID)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting DNS instructions for site_id %d: %s", siteID, err)
+	}
+
+	result := DelegationResult{Domain: siteStatusResponse.Domain, Mismatches: make([]DelegationMismatch, 0)}
+	resolver := c.dnsResolver()
+	for _, record := range siteStatusResponse.DNS {
+		expected := normalizeDNSValues(record.SetDataTo)
+		var actual []string
+		switch strings.ToUpper(record.SetTypeTo) {
+		case "CNAME":
+			cname, err := resolver.LookupCNAME(context.Background(), record.DNSRecordName)
+			if err != nil && !isDNSNotFound(err) {
+				return nil, fmt.Errorf("Error looking up the CNAME of %s for site_id %d: %s", record.DNSRecordName, siteID, err)
+			}
+			if cname != "" {
+				actual = normalizeDNSValues([]string{cname})
+			}
+			if len(actual) != 1 || !contains(expected, actual[0]) {
+				result.Mismatches = append(result.Mismatches, DelegationMismatch{RecordName: record.DNSRecordName, RecordType: "CNAME", Expected: expected, Actual: actual})
+			}
+		case "A":
+			addresses, err := resolver.LookupHost(context.Background(), record.DNSRecordName)
+			if err != nil && !isDNSNotFound(err) {
+				return nil, fmt.Errorf("Error looking up the addresses of %s for site_id %d: %s", record.DNSRecordName, siteID, err)
+			}
+			actual = normalizeDNSValues(addresses)
+			if len(actual) == 0 || !isSubset(actual, expected) {
+				result.Mismatches = append(result.Mismatches, DelegationMismatch{RecordName: record.DNSRecordName, RecordType: "A", Expected: expected, Actual: actual})
+			}
+		default:
+			log.Printf("[WARN] Skipping DNS record %s of unsupported type %s for site_id: %d\n", record.DNSRecordName, record.SetTypeTo, siteID)
+		}
+	}
+	result.Delegated = len(result.Mismatches) == 0
+
+	return &result, nil
+}
+
+// isDNSNotFound returns true when err is a lookup of a name that doesn't exist, which is reported as a mismatch
+func isDNSNotFound(err error) bool {
+	dnsError, ok := err.(*net.DNSError)
+	return ok && dnsError.IsNotFound
+}
+
+// normalizeDNSValues lowercases the values, strips the trailing dot of fully qualified names and sorts them
+func normalizeDNSValues(values []string) []string {
+	normalized := make([]string, len(values))
+	for i, value := range values {
+		normalized[i] = strings.TrimSuffix(strings.ToLower(value), ".")
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+func isSubset(values, of []string) bool {
+	for _, value := range values {
+		if !contains(of, value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type fakeDNSResolver struct {
+	cnames    map[string]string
+	addresses map[string][]string
+}
+
+func (r *fakeDNSResolver) LookupCNAME(ctx context.Context, host string) (string, error) {
+	if cname, ok := r.cnames[host]; ok {
+		return cname, nil
+	}
+	return "", &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func (r *fakeDNSResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	if addresses, ok := r.addresses[host]; ok {
+		return addresses, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+func dnsDelegationServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteStatus) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteStatus, req.URL.String())
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0,"domain":"www.example.com","dns":[
+			{"dns_record_name":"www.example.com","set_type_to":"CNAME","set_data_to":["abcd.x.incapdns.net"]},
+			{"dns_record_name":"example.com","set_type_to":"A","set_data_to":["192.0.2.10","192.0.2.11"]}]}`))
+	}))
+}
+
+////////////////////////////////////////////////////////////////
+// VerifyDNSDelegation Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientVerifyDNSDelegationMatching(t *testing.T) {
+	server := dnsDelegationServer(t)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}, resolver: &fakeDNSResolver{
+		cnames:    map[string]string{"www.example.com": "ABCD.x.incapdns.net."},
+		addresses: map[string][]string{"example.com": {"192.0.2.11"}},
+	}}
+	result, err := client.VerifyDNSDelegation(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if !result.Delegated || len(result.Mismatches) != 0 {
+		t.Errorf("Should have been delegated, got mismatches: %+v", result.Mismatches)
+	}
+	if result.Domain != "www.example.com" {
+		t.Errorf("Expected domain to be www.example.com, got: %s", result.Domain)
+	}
+}
+
+func TestClientVerifyDNSDelegationMismatching(t *testing.T) {
+	server := dnsDelegationServer(t)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}, resolver: &fakeDNSResolver{
+		cnames:    map[string]string{"www.example.com": "origin.example.net."},
+		addresses: map[string][]string{"example.com": {"192.0.2.10", "198.51.100.1"}},
+	}}
+	result, err := client.VerifyDNSDelegation(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if result.Delegated {
+		t.Errorf("Should not have been delegated")
+	}
+	expected := []DelegationMismatch{
+		{RecordName: "www.example.com", RecordType: "CNAME", Expected: []string{"abcd.x.incapdns.net"}, Actual: []string{"origin.example.net"}},
+		{RecordName: "example.com", RecordType: "A", Expected: []string{"192.0.2.10", "192.0.2.11"}, Actual: []string{"192.0.2.10", "198.51.100.1"}},
+	}
+	if !reflect.DeepEqual(result.Mismatches, expected) {
+		t.Errorf("Unexpected mismatches, expected %+v, got: %+v", expected, result.Mismatches)
+	}
+}
+
+func TestClientVerifyDNSDelegationNotFound(t *testing.T) {
+	server := dnsDelegationServer(t)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}, resolver: &fakeDNSResolver{}}
+	result, err := client.VerifyDNSDelegation(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if result.Delegated || len(result.Mismatches) != 2 {
+		t.Errorf("Should have reported both records as mismatches, got: %+v", result.Mismatches)
+	}
+}
@@ -0,0 +1,180 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+)
+
+// Endpoints (unexported consts)
+const endpointLogDeliveryGet = "accounts/getSiemStorage"
+const endpointCreateSyslog = "accounts/setSyslogSiemStorage"
+
+// Log delivery destination types
+const (
+	LogDeliveryDestinationDefault = "DEFAULT"
+	LogDeliveryDestinationS3      = "S3"
+	LogDeliveryDestinationSFTP    = "SFTP"
+	LogDeliveryDestinationSyslog  = "SYSLOG"
+)
+
+var logDeliveryDestinations = []string{LogDeliveryDestinationDefault, LogDeliveryDestinationS3, LogDeliveryDestinationSFTP, LogDeliveryDestinationSyslog}
+var logDeliveryFormats = []string{"CEF", "W3C", "LEEF"}
+var logDeliverySyslogProtocols = []string{"TCP", "UDP", "TLS"}
+
+// LogDeliveryConfig is where the logs of an account are shipped to. Only the fields of the destination type are used.
+// The credentials (S3 keys, SFTP password) are write-only: they are never returned by GetLogDelivery.
+type LogDeliveryConfig struct {
+	DestinationType string
+	Format          string
+
+	// S3
+	BucketName string
+	AccessKey  string
+	SecretKey  string
+
+	// SFTP and syslog
+	Host string
+
+	// SFTP
+	UserName          string
+	Password          string
+	DestinationFolder string
+
+	// Syslog
+	Port     int
+	Protocol string
+}
+
+// LogDeliveryResponse contains the log delivery configuration of an account
+type LogDeliveryResponse struct {
+	Res                   interface{} `json:"res"`
+	ResMessage            string      `json:"res_message"`
+	DebugInfo             DebugInfo   `json:"debug_info"`
+	StorageType           string      `json:"storage_type"`
+	Format                string      `json:"format"`
+	S3BucketName          string      `json:"s3_bucket_name"`
+	SftpHost              string      `json:"sftp_host"`
+	SftpUserName          string      `json:"sftp_user_name"`
+	SftpDestinationFolder string      `json:"sftp_destination_folder"`
+	SyslogHost            string      `json:"syslog_host"`
+	SyslogPort            int         `json:"syslog_port"`
+	SyslogProtocol        string      `json:"syslog_protocol"`
+}
+
+// GetLogDelivery gets the log delivery configuration of an account
+func (c *Client) GetLogDelivery(accountID int) (*LogDeliveryConfig, error) {
+	log.Printf("[INFO] Getting Incapsula log delivery for account: %d\n", accountID)
+
+	values := url.Values{"account_id": {strconv.Itoa(accountID)}}
+	var logDeliveryResponse LogDeliveryResponse
+	responseBody, err := c.postFormAndDecode(c.endpointURL(endpointLogDeliveryGet), values, ReadLogDelivery, &logDeliveryResponse)
+	if err != nil {
+		if _, ok := err.(*jsonDecodeError); ok {
+			return nil, fmt.Errorf("Error parsing log delivery JSON response for account %d: %s", accountID, err)
+		}
+		return nil, fmt.Errorf("Error getting log delivery for account %d: %s", accountID, err)
+	}
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula get log delivery JSON response: %s\n", string(responseBody))
+
+	resString := fmt.Sprintf("%v", logDeliveryResponse.Res)
+	if resNumber, ok := logDeliveryResponse.Res.(float64); ok {
+		resString = strconv.Itoa(int(resNumber))
+	}
+	if resString != "0" {
+		return nil, newIncapsulaError(resString, logDeliveryResponse.DebugInfo, "Error from Incapsula service when getting log delivery for account %d: %s", accountID, string(responseBody))
+	}
+
+	cfg := &LogDeliveryConfig{
+		DestinationType: logDeliveryResponse.StorageType,
+		Format:          logDeliveryResponse.Format,
+	}
+	switch logDeliveryResponse.StorageType {
+	case LogDeliveryDestinationS3:
+		cfg.BucketName = logDeliveryResponse.S3BucketName
+	case LogDeliveryDestinationSFTP:
+		cfg.Host = logDeliveryResponse.SftpHost
+		cfg.UserName = logDeliveryResponse.SftpUserName
+		cfg.DestinationFolder = logDeliveryResponse.SftpDestinationFolder
+	case LogDeliveryDestinationSyslog:
+		cfg.Host = logDeliveryResponse.SyslogHost
+		cfg.Port = logDeliveryResponse.SyslogPort
+		cfg.Protocol = logDeliveryResponse.SyslogProtocol
+	}
+
+	return cfg, nil
+}
+
+// SetLogDelivery sets where the logs of an account are shipped to. The DEFAULT destination keeps the logs in Incapsula.
+func (c *Client) SetLogDelivery(accountID int, cfg LogDeliveryConfig) error {
+	// The credentials are never logged
+	log.Printf("[INFO] Setting Incapsula log delivery (%s) for account: %d\n", cfg.DestinationType, accountID)
+
+	endpoint, values, err := logDeliveryValues(accountID, cfg)
+	if err != nil {
+		return err
+	}
+
+	var logDeliveryResponse WAFLogSetupResponse
+	_, err = c.postFormAndDecode(c.endpointURL(endpoint), values, UpdateLogDelivery, &logDeliveryResponse)
+	if err != nil {
+		return fmt.Errorf("Error setting log delivery (%s) for account %d: %s", cfg.DestinationType, accountID, err)
+	}
+
+	// The response isn't dumped, it may echo the credentials
+	if logDeliveryResponse.Res != 0 {
+		return fmt.Errorf("Error from Incapsula service when setting log delivery (%s) for account %d: %d %s", cfg.DestinationType, accountID, logDeliveryResponse.Res, logDeliveryResponse.ResMessage)
+	}
+
+	return nil
+}
+
+// logDeliveryValues validates the configuration and returns the endpoint and the values of its destination type
+func logDeliveryValues(accountID int, cfg LogDeliveryConfig) (string, url.Values, error) {
+	values := url.Values{"account_id": {strconv.Itoa(accountID)}}
+
+	if cfg.DestinationType == LogDeliveryDestinationDefault {
+		return endpointCreateDefault, values, nil
+	}
+
+	if !contains(logDeliveryFormats, cfg.Format) {
+		return "", nil, fmt.Errorf("Error - invalid log delivery format (%s), must be one of %v", cfg.Format, logDeliveryFormats)
+	}
+	values.Set("format", cfg.Format)
+
+	switch cfg.DestinationType {
+	case LogDeliveryDestinationS3:
+		if cfg.BucketName == "" || cfg.AccessKey == "" || cfg.SecretKey == "" {
+			return "", nil, fmt.Errorf("Error - the %s log delivery requires a bucket name, an access key and a secret key", cfg.DestinationType)
+		}
+		values.Set("bucket_name", cfg.BucketName)
+		values.Set("access_key", cfg.AccessKey)
+		values.Set("secret_key", cfg.SecretKey)
+		return endpointCreateS3, values, nil
+	case LogDeliveryDestinationSFTP:
+		if cfg.Host == "" || cfg.UserName == "" || cfg.Password == "" || cfg.DestinationFolder == "" {
+			return "", nil, fmt.Errorf("Error - the %s log delivery requires a host, a user name, a password and a destination folder", cfg.DestinationType)
+		}
+		values.Set("host", cfg.Host)
+		values.Set("user_name", cfg.UserName)
+		values.Set("password", cfg.Password)
+		values.Set("destination_folder", cfg.DestinationFolder)
+		return endpointCreateSFTP, values, nil
+	case LogDeliveryDestinationSyslog:
+		if cfg.Host == "" || cfg.Port < 1 || cfg.Port > 65535 {
+			return "", nil, fmt.Errorf("Error - the %s log delivery requires a host and a port between 1 and 65535", cfg.DestinationType)
+		}
+		if !contains(logDeliverySyslogProtocols, cfg.Protocol) {
+			return "", nil, fmt.Errorf("Error - invalid syslog protocol (%s), must be one of %v", cfg.Protocol, logDeliverySyslogProtocols)
+		}
+		values.Set("host", cfg.Host)
+		values.Set("port", strconv.Itoa(cfg.Port))
+		values.Set("protocol", cfg.Protocol)
+		return endpointCreateSyslog, values, nil
+	}
+
+	return "", nil, fmt.Errorf("Error - invalid log delivery destination type (%s), must be one of %v", cfg.DestinationType, logDeliveryDestinations)
+}
@@ -0,0 +1,126 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetLogDelivery Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetLogDeliveryRequestBodies(t *testing.T) {
+	tests := []struct {
+		cfg      LogDeliveryConfig
+		endpoint string
+		expected url.Values
+	}{
+		{
+			cfg:      LogDeliveryConfig{DestinationType: LogDeliveryDestinationS3, Format: "CEF", BucketName: "my-logs", AccessKey: "AKIA", SecretKey: "s3cr3t"},
+			endpoint: endpointCreateS3,
+			expected: url.Values{"account_id": {"42"}, "format": {"CEF"}, "bucket_name": {"my-logs"}, "access_key": {"AKIA"}, "secret_key": {"s3cr3t"}},
+		},
+		{
+			cfg:      LogDeliveryConfig{DestinationType: LogDeliveryDestinationSFTP, Format: "W3C", Host: "10.0.0.1", UserName: "logs", Password: "p4ss", DestinationFolder: "/incapsula"},
+			endpoint: endpointCreateSFTP,
+			expected: url.Values{"account_id": {"42"}, "format": {"W3C"}, "host": {"10.0.0.1"}, "user_name": {"logs"}, "password": {"p4ss"}, "destination_folder": {"/incapsula"}},
+		},
+		{
+			cfg:      LogDeliveryConfig{DestinationType: LogDeliveryDestinationSyslog, Format: "LEEF", Host: "syslog.example.com", Port: 6514, Protocol: "TLS"},
+			endpoint: endpointCreateSyslog,
+			expected: url.Values{"account_id": {"42"}, "format": {"LEEF"}, "host": {"syslog.example.com"}, "port": {"6514"}, "protocol": {"TLS"}},
+		},
+		{
+			cfg:      LogDeliveryConfig{DestinationType: LogDeliveryDestinationDefault},
+			endpoint: endpointCreateDefault,
+			expected: url.Values{"account_id": {"42"}},
+		},
+	}
+
+	for _, test := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if req.URL.String() != fmt.Sprintf("/%s", test.endpoint) {
+				t.Errorf("%s: Should have have hit /%s endpoint. Got: %s", test.cfg.DestinationType, test.endpoint, req.URL.String())
+			}
+			req.ParseForm()
+			if req.PostForm.Encode() != test.expected.Encode() {
+				t.Errorf("%s: Unexpected request body, expected %s, got: %s", test.cfg.DestinationType, test.expected.Encode(), req.PostForm.Encode())
+			}
+			rw.Write([]byte(`{"res":0,"res_message":"OK"}`))
+		}))
+
+		config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+		client := &Client{config: config, httpClient: &http.Client{}}
+		err := client.SetLogDelivery(42, test.cfg)
+		if err != nil {
+			t.Errorf("%s: Should not have received an error, got: %s", test.cfg.DestinationType, err)
+		}
+		server.Close()
+	}
+}
+
+func TestClientSetLogDeliveryInvalidConfig(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	tests := map[string]LogDeliveryConfig{
+		"Error - invalid log delivery destination type (KAFKA)": {DestinationType: "KAFKA", Format: "CEF"},
+		"Error - invalid log delivery format (JSON)":            {DestinationType: LogDeliveryDestinationS3, Format: "JSON"},
+		"Error - the S3 log delivery requires":                  {DestinationType: LogDeliveryDestinationS3, Format: "CEF", BucketName: "my-logs"},
+		"Error - the SYSLOG log delivery requires":              {DestinationType: LogDeliveryDestinationSyslog, Format: "CEF", Host: "syslog.example.com", Protocol: "TCP"},
+		"Error - invalid syslog protocol (HTTP)":                {DestinationType: LogDeliveryDestinationSyslog, Format: "CEF", Host: "syslog.example.com", Port: 514, Protocol: "HTTP"},
+	}
+	for expected, cfg := range tests {
+		err := client.SetLogDelivery(42, cfg)
+		if err == nil || !strings.HasPrefix(err.Error(), expected) {
+			t.Errorf("Should have received an error starting with %q, got: %v", expected, err)
+		}
+	}
+}
+
+func TestClientSetLogDeliveryErrorHidesCredentials(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":2,"res_message":"Connection failed","debug_info":{"secret_key":"s3cr3t"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	cfg := LogDeliveryConfig{DestinationType: LogDeliveryDestinationS3, Format: "CEF", BucketName: "my-logs", AccessKey: "AKIA", SecretKey: "s3cr3t"}
+	err := client.SetLogDelivery(42, cfg)
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if strings.Contains(err.Error(), "s3cr3t") {
+		t.Errorf("Should not have included the credentials in the error, got: %s", err)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetLogDelivery Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetLogDeliverySyslog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointLogDeliveryGet) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointLogDeliveryGet, req.URL.String())
+		}
+		rw.Write([]byte(`{"res":0,"storage_type":"SYSLOG","format":"CEF","syslog_host":"syslog.example.com","syslog_port":514,"syslog_protocol":"UDP"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	cfg, err := client.GetLogDelivery(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	expected := LogDeliveryConfig{DestinationType: LogDeliveryDestinationSyslog, Format: "CEF", Host: "syslog.example.com", Port: 514, Protocol: "UDP"}
+	if *cfg != expected {
+		t.Errorf("Unexpected log delivery, expected %+v, got: %+v", expected, *cfg)
+	}
+}
@@ -6,12 +6,67 @@ import (
 	"io/ioutil"
 	"log"
 	"net/url"
+	"strconv"
 )
 
 const endpointSiteLogLevel = "sites/setlog"
 
+// Site log levels: all the events, only the security events or no logs
+var siteLogLevels = []string{"full", "security", "none"}
+
+// Site log formats
+var siteLogFormats = []string{"CEF", "LEEF", "JSON"}
+
 // UpdateLogLevel will update the site log level
 func (c *Client) UpdateLogLevel(siteID, logLevel, logsAccountId string) error {
+	// Post form to Incapsula
+	values := url.Values{
+		"site_id":         {siteID},
+		"log_level":       {logLevel},
+		"logs_account_id": {logsAccountId},
+	}
+	return c.postLogLevel(siteID, logLevel, values)
+}
+
+// SetLogConfig sets which events are logged for a site along with the log format. The format can't be set when the
+// logs are disabled with the none level.
+func (c *Client) SetLogConfig(siteID int, level, format string) error {
+	values, err := logConfigValues(siteID, level, format)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Updating Incapsula log format (%s) for siteID: %d\n", format, siteID)
+
+	return c.postLogLevel(strconv.Itoa(siteID), level, values)
+}
+
+func logConfigValues(siteID int, level, format string) (url.Values, error) {
+	if !contains(siteLogLevels, level) {
+		return nil, fmt.Errorf("Error - invalid log level (%s), must be one of %v", level, siteLogLevels)
+	}
+
+	values := url.Values{
+		"site_id":   {strconv.Itoa(siteID)},
+		"log_level": {level},
+	}
+
+	if level == "none" {
+		if format != "" {
+			return nil, fmt.Errorf("Error - a log format can't be set with the none log level")
+		}
+		return values, nil
+	}
+
+	if !contains(siteLogFormats, format) {
+		return nil, fmt.Errorf("Error - invalid log format (%s), must be one of %v", format, siteLogFormats)
+	}
+	values.Set("log_format", format)
+
+	return values, nil
+}
+
+func (c *Client) postLogLevel(siteID, logLevel string, values url.Values) error {
 	type LogLevelResponse struct {
 		Res        int    `json:"res"`
 		ResMessage string `json:"res_message"`
@@ -23,13 +78,7 @@ func (c *Client) UpdateLogLevel(siteID, logLevel, logsAccountId string) error {
 
 	log.Printf("[INFO] Updating Incapsula log level (%s) for siteID: %s\n", logLevel, siteID)
 
-	// Post form to Incapsula
-	values := url.Values{
-		"site_id":         {siteID},
-		"log_level":       {logLevel},
-		"logs_account_id": {logsAccountId},
-	}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointSiteLogLevel)
+	reqURL := c.endpointURL(endpointSiteLogLevel)
 	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateLogLevel)
 	if err != nil {
 		return fmt.Errorf("Error updating log level (%s) on site_id: %s: %s", logLevel, siteID, err)
@@ -93,3 +93,85 @@ func TestClientUpdateLogLevelValidSite(t *testing.T) {
 		t.Errorf("Should not have received an error")
 	}
 }
+
+////////////////////////////////////////////////////////////////
+// SetLogConfig Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetLogConfigCombinedRequestBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteLogLevel) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteLogLevel, req.URL.String())
+		}
+		req.ParseForm()
+		expected := map[string]string{
+			"site_id":    "42",
+			"log_level":  "security",
+			"log_format": "LEEF",
+		}
+		for key, value := range expected {
+			if req.PostForm.Get(key) != value {
+				t.Errorf("Expected %s to be %s, got: %s", key, value, req.PostForm.Get(key))
+			}
+		}
+		if _, ok := req.PostForm["logs_account_id"]; ok {
+			t.Errorf("Should not have sent logs_account_id")
+		}
+		rw.Write([]byte(`{"res":0,"res_message":"OK","debug_info":{"log_level":"security"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetLogConfig(42, "security", "LEEF")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetLogConfigNoneLevel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		if req.PostForm.Get("log_level") != "none" {
+			t.Errorf("Expected log_level to be none, got: %s", req.PostForm.Get("log_level"))
+		}
+		if _, ok := req.PostForm["log_format"]; ok {
+			t.Errorf("Should not have sent log_format with the none log level")
+		}
+		rw.Write([]byte(`{"res":0,"res_message":"OK"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetLogConfig(42, "none", "")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetLogConfigInvalidEnums(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	cases := []struct {
+		level    string
+		format   string
+		expected string
+	}{
+		{"all", "CEF", "Error - invalid log level (all)"},
+		{"full", "cef", "Error - invalid log format (cef)"},
+		{"security", "", "Error - invalid log format ()"},
+		{"none", "JSON", "Error - a log format can't be set with the none log level"},
+	}
+	for _, c := range cases {
+		err := client.SetLogConfig(42, c.level, c.format)
+		if err == nil {
+			t.Errorf("Should have received an error for level %s and format %s", c.level, c.format)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), c.expected) {
+			t.Errorf("Expected error %s, got: %s", c.expected, err)
+		}
+	}
+}
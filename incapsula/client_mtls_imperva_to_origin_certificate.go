@@ -34,18 +34,18 @@ type MTLSCertificateResponse struct {
 
 func (c *Client) AddMTLSCertificate(certificate, privateKey []byte, passphrase, certificateName, inputHash, accountID string) (*MTLSCertificate, error) {
 	log.Printf("[INFO] Adding mutual TLS Imperva to Origin Certificate")
-	reqURL := fmt.Sprintf("%s%s", c.config.BaseURLAPI, endpointMTLSCertificate)
+	reqURL := c.endpointURL(endpointMTLSCertificate)
 	if accountID != "" {
-		reqURL = fmt.Sprintf("%s%s?caid=%s", c.config.BaseURLAPI, endpointMTLSCertificate, accountID)
+		reqURL = fmt.Sprintf("%s?caid=%s", c.endpointURL(endpointMTLSCertificate), accountID)
 	}
 	return c.editMTLSCertificate(http.MethodPost, reqURL, certificate, privateKey, passphrase, certificateName, inputHash, "Create", CreateMtlsImpervaToOriginCertifiate)
 }
 
 func (c *Client) UpdateMTLSCertificate(certificateID string, certificate, privateKey []byte, passphrase, certificateName, inputHash, accountID string) (*MTLSCertificate, error) {
 	log.Printf("[INFO] Updating mutual TLS Imperva to Origin Certificate with ID %s", certificateID)
-	reqURL := fmt.Sprintf("%s%s/%s", c.config.BaseURLAPI, endpointMTLSCertificate, certificateID)
+	reqURL := fmt.Sprintf("%s/%s", c.endpointURL(endpointMTLSCertificate), certificateID)
 	if accountID != "" {
-		reqURL = fmt.Sprintf("%s%s/%s?caid=%s", c.config.BaseURLAPI, endpointMTLSCertificate, certificateID, accountID)
+		reqURL = fmt.Sprintf("%s/%s?caid=%s", c.endpointURL(endpointMTLSCertificate), certificateID, accountID)
 	}
 	return c.editMTLSCertificate(http.MethodPut, reqURL, certificate, privateKey, passphrase, certificateName, inputHash, "Update", UpdateMtlsImpervaToOriginCertifiate)
 }
@@ -53,9 +53,9 @@ func (c *Client) UpdateMTLSCertificate(certificateID string, certificate, privat
 func (c *Client) GetMTLSCertificate(certificateID, accountID string) (*MTLSCertificate, error) {
 	log.Printf("[INFO] Reading mutual TLS Imperva to Origin Certificate with ID %s", certificateID)
 	//todo refactor !! move to separate method
-	reqURL := fmt.Sprintf("%s%s/%s", c.config.BaseURLAPI, endpointMTLSCertificate, certificateID)
+	reqURL := fmt.Sprintf("%s/%s", c.endpointURL(endpointMTLSCertificate), certificateID)
 	if accountID != "" {
-		reqURL = fmt.Sprintf("%s%s/%s?caid=%s", c.config.BaseURLAPI, endpointMTLSCertificate, certificateID, accountID)
+		reqURL = fmt.Sprintf("%s/%s?caid=%s", c.endpointURL(endpointMTLSCertificate), certificateID, accountID)
 	}
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadMtlsImpervaToOriginCertifiate)
 	if err != nil {
@@ -135,7 +135,7 @@ func (c *Client) editMTLSCertificate(hhtpMethod, reqURL string, certificate, pri
 func (c *Client) DeleteMTLSCertificate(certificateID, accountID string) error {
 	log.Printf("[INFO] Deleting mTLS certificate with ID %s", certificateID)
 
-	reqURL := fmt.Sprintf("%s%s/%s", c.config.BaseURLAPI, endpointMTLSCertificate, certificateID)
+	reqURL := fmt.Sprintf("%s/%s", c.endpointURL(endpointMTLSCertificate), certificateID)
 
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodDelete, reqURL, nil, DeleteMtlsImpervaToOriginCertifiate)
 	if err != nil {
@@ -10,7 +10,7 @@ import (
 
 func (c *Client) GetSiteMtlsCertificateAssociation(certificateID, siteID int) (bool, error) {
 	log.Printf("[INFO] Getting Site to mutual TLS Imperva to Origin Certificate association for Site ID %d", siteID)
-	reqURL := fmt.Sprintf("%s%s/%d/associated-sites/%d", c.config.BaseURLAPI, endpointMTLSCertificate, certificateID, siteID)
+	reqURL := fmt.Sprintf("%s/%d/associated-sites/%d", c.endpointURL(endpointMTLSCertificate), certificateID, siteID)
 
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, "ReadSiteMtlsImpervaToOriginCertifiateAssociation")
 	if err != nil {
@@ -33,7 +33,7 @@ func (c *Client) GetSiteMtlsCertificateAssociation(certificateID, siteID int) (b
 
 func (c *Client) CreateSiteMtlsCertificateAssociation(certificateID, siteID int) error {
 	log.Printf("[INFO] Updating Site to mutual TLS Imperva to Origin Certificate association for certificate ID %d, Site ID %d", certificateID, siteID)
-	reqURL := fmt.Sprintf("%s%s/%d/associated-sites/%d", c.config.BaseURLAPI, endpointMTLSCertificate, certificateID, siteID)
+	reqURL := fmt.Sprintf("%s/%d/associated-sites/%d", c.endpointURL(endpointMTLSCertificate), certificateID, siteID)
 
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodPut, reqURL, nil, CreateSiteMtlsImpervaToOriginCertifiateAssociation)
 	if err != nil {
@@ -53,7 +53,7 @@ func (c *Client) CreateSiteMtlsCertificateAssociation(certificateID, siteID int)
 
 func (c *Client) DeleteSiteMtlsCertificateAssociation(certificateID, siteID int) error {
 	log.Printf("[INFO] Unassigning Site to mutual TLS Imperva to Origin Certificate association for certificate ID %d, Site ID %d", certificateID, siteID)
-	reqURL := fmt.Sprintf("%s%s/%d/associated-sites/%d", c.config.BaseURLAPI, endpointMTLSCertificate, certificateID, siteID)
+	reqURL := fmt.Sprintf("%s/%d/associated-sites/%d", c.endpointURL(endpointMTLSCertificate), certificateID, siteID)
 
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodDelete, reqURL, nil, DeleteSiteMtlsImpervaToOriginCertifiateAssociation)
 	if err != nil {
@@ -146,7 +146,7 @@ func (c *Client) DeleteNotificationCenterPolicy(policyId int, accountId int) err
 }
 
 func getRequestUrl(c *Client) string {
-	requestUrl := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endPointNotificationCenterPolicy)
+	requestUrl := c.endpointURL(endPointNotificationCenterPolicy)
 
 	return requestUrl
 }
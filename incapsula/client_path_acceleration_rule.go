@@ -0,0 +1,159 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Endpoints (unexported consts)
+// The API v2 site settings, e.g. /sites/{siteId}/settings/acceleration/rules
+const endpointSiteSettingsBase = "/sites"
+
+// Acceleration levels of a path acceleration rule, the same levels as the site acceleration_level
+var pathAccelerationLevels = []string{"none", "standard", "aggressive"}
+
+// A URL pattern is an absolute path which may contain * wildcards, e.g. /api/* or /static/*.js
+var pathAccelerationURLPatternRegex = regexp.MustCompile(`^/[A-Za-z0-9\-._~%!$&'()+,;=:@/*]*$`)
+
+// PathAccelerationRule overrides the site acceleration level for the paths matching URLPattern. Rules are evaluated by
+// ascending Priority and the first matching rule applies.
+type PathAccelerationRule struct {
+	URLPattern        string `json:"url_pattern"`
+	AccelerationLevel string `json:"acceleration_level"`
+	Priority          int    `json:"priority"`
+}
+
+// PathAccelerationRuleWithID contains the PathAccelerationRule as well as the rule identifier
+type PathAccelerationRuleWithID struct {
+	PathAccelerationRule
+	RuleID int `json:"rule_id"`
+}
+
+// PathAccelerationRulesResponse contains the path acceleration rules of a site
+type PathAccelerationRulesResponse struct {
+	Rules []PathAccelerationRuleWithID `json:"rules"`
+}
+
+// AddPathAccelerationRule adds a path acceleration rule to a site
+func (c *Client) AddPathAccelerationRule(siteID string, rule *PathAccelerationRule) (*PathAccelerationRuleWithID, error) {
+	log.Printf("[INFO] Adding Incapsula Path Acceleration Rule (%s) for Site ID %s\n", rule.URLPattern, siteID)
+
+	err := validatePathAccelerationRule(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleJSON, err := json.Marshal(rule)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to JSON marshal PathAccelerationRule: %s", err)
+	}
+
+	// Dump Request JSON
+	log.Printf("[DEBUG] Incapsula Add Path Acceleration Rule JSON request body: %s\n", string(ruleJSON))
+
+	// Post to Incapsula
+	reqURL := fmt.Sprintf("%s/%s/settings/acceleration/rules", c.endpointURL(endpointSiteSettingsBase), siteID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodPost, reqURL, ruleJSON, CreatePathAccelerationRule)
+	if err != nil {
+		return nil, fmt.Errorf("Error from Incapsula service when adding Path Acceleration Rule for Site ID %s: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Add Path Acceleration Rule JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from Incapsula service when adding Path Acceleration Rule for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var ruleWithID PathAccelerationRuleWithID
+	err = json.Unmarshal([]byte(responseBody), &ruleWithID)
+	if err != nil || !strings.Contains(string(responseBody), "\"rule_id\":") {
+		return nil, fmt.Errorf("Error parsing Path Acceleration Rule JSON response for Site ID %s: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	return &ruleWithID, nil
+}
+
+// ListPathAccelerationRules gets the path acceleration rules of a site, ordered by priority
+func (c *Client) ListPathAccelerationRules(siteID string) ([]PathAccelerationRuleWithID, int, error) {
+	log.Printf("[INFO] Getting Incapsula Path Acceleration Rules for Site ID %s\n", siteID)
+
+	reqURL := fmt.Sprintf("%s/%s/settings/acceleration/rules", c.endpointURL(endpointSiteSettingsBase), siteID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadPathAccelerationRule)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error from Incapsula service when reading Path Acceleration Rules for Site ID %s: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Read Path Acceleration Rules JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, resp.StatusCode, fmt.Errorf("Error status code %d from Incapsula service when reading Path Acceleration Rules for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var rulesResponse PathAccelerationRulesResponse
+	err = json.Unmarshal([]byte(responseBody), &rulesResponse)
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("Error parsing Path Acceleration Rules JSON response for Site ID %s: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	sort.SliceStable(rulesResponse.Rules, func(i, j int) bool {
+		return rulesResponse.Rules[i].Priority < rulesResponse.Rules[j].Priority
+	})
+
+	return rulesResponse.Rules, resp.StatusCode, nil
+}
+
+// DeletePathAccelerationRule deletes a path acceleration rule of a site
+func (c *Client) DeletePathAccelerationRule(siteID string, ruleID int) error {
+	log.Printf("[INFO] Deleting Incapsula Path Acceleration Rule %d for Site ID %s\n", ruleID, siteID)
+
+	reqURL := fmt.Sprintf("%s/%s/settings/acceleration/rules/%d", c.endpointURL(endpointSiteSettingsBase), siteID, ruleID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodDelete, reqURL, nil, DeletePathAccelerationRule)
+	if err != nil {
+		return fmt.Errorf("Error from Incapsula service when deleting Path Acceleration Rule %d for Site ID %s: %s", ruleID, siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Delete Path Acceleration Rule JSON response: %s\n", string(responseBody))
+
+	// Check the response code, a rule that is already gone is considered deleted
+	if resp.StatusCode != 200 && resp.StatusCode != 404 {
+		return fmt.Errorf("Error status code %d from Incapsula service when deleting Path Acceleration Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
+	}
+
+	return nil
+}
+
+// validatePathAccelerationRule checks the URL pattern and the acceleration level of a rule
+func validatePathAccelerationRule(rule *PathAccelerationRule) error {
+	if !pathAccelerationURLPatternRegex.MatchString(rule.URLPattern) {
+		return fmt.Errorf("Error - invalid URL pattern (%s), must be an absolute path optionally containing * wildcards, e.g. /api/*", rule.URLPattern)
+	}
+	if !contains(pathAccelerationLevels, rule.AccelerationLevel) {
+		return fmt.Errorf("Error - invalid acceleration level (%s) for URL pattern %s, must be one of %v", rule.AccelerationLevel, rule.URLPattern, pathAccelerationLevels)
+	}
+	return nil
+}
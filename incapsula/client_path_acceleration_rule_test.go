@@ -0,0 +1,138 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// AddPathAccelerationRule Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientAddPathAccelerationRuleInvalidURLPattern(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	for _, urlPattern := range []string{"", "api/*", "/api /*", "https://example.com/api"} {
+		rule := PathAccelerationRule{URLPattern: urlPattern, AccelerationLevel: "none"}
+		_, err := client.AddPathAccelerationRule("42", &rule)
+		if err == nil || !strings.HasPrefix(err.Error(), fmt.Sprintf("Error - invalid URL pattern (%s)", urlPattern)) {
+			t.Errorf("Should have received an invalid URL pattern error for %q, got: %v", urlPattern, err)
+		}
+	}
+}
+
+func TestClientAddPathAccelerationRuleInvalidAccelerationLevel(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	rule := PathAccelerationRule{URLPattern: "/api/*", AccelerationLevel: "fast"}
+	_, err := client.AddPathAccelerationRule("42", &rule)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid acceleration level (fast)") {
+		t.Errorf("Should have received an invalid acceleration level error, got: %v", err)
+	}
+}
+
+func TestClientAddPathAccelerationRuleValidRule(t *testing.T) {
+	endpoint := "/sites/42/settings/acceleration/rules"
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.String() != endpoint {
+			t.Errorf("Should have have hit POST %s endpoint. Got: %s %s", endpoint, req.Method, req.URL.String())
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		var rule PathAccelerationRule
+		json.Unmarshal(body, &rule)
+		if rule.URLPattern != "/static/*.js" || rule.AccelerationLevel != "aggressive" || rule.Priority != 2 {
+			t.Errorf("Unexpected request body, got: %s", string(body))
+		}
+		rw.Write([]byte(`{"rule_id":66770,"url_pattern":"/static/*.js","acceleration_level":"aggressive","priority":2}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	rule := PathAccelerationRule{URLPattern: "/static/*.js", AccelerationLevel: "aggressive", Priority: 2}
+	ruleWithID, err := client.AddPathAccelerationRule("42", &rule)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if ruleWithID.RuleID != 66770 {
+		t.Errorf("Should have received rule ID 66770, got: %d", ruleWithID.RuleID)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// ListPathAccelerationRules Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientListPathAccelerationRulesOrdered(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"rules":[
+			{"rule_id":2,"url_pattern":"/static/*","acceleration_level":"aggressive","priority":2},
+			{"rule_id":1,"url_pattern":"/api/*","acceleration_level":"none","priority":1}
+		]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	rules, _, err := client.ListPathAccelerationRules("42")
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(rules) != 2 || rules[0].URLPattern != "/api/*" || rules[1].URLPattern != "/static/*" {
+		t.Errorf("Should have received the rules ordered by priority, got: %+v", rules)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// DeletePathAccelerationRule Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientDeletePathAccelerationRuleValidRule(t *testing.T) {
+	endpoint := "/sites/42/settings/acceleration/rules/66770"
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete || req.URL.String() != endpoint {
+			t.Errorf("Should have have hit DELETE %s endpoint. Got: %s %s", endpoint, req.Method, req.URL.String())
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if len(body) != 0 {
+			t.Errorf("Should not have sent a request body, got: %s", string(body))
+		}
+		rw.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	err := client.DeletePathAccelerationRule("42", 66770)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientDeletePathAccelerationRuleBadStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(500)
+		rw.Write([]byte(`{"errors":[{"status":500}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	err := client.DeletePathAccelerationRule("42", 66770)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error status code 500 from Incapsula service when deleting Path Acceleration Rule 66770 for Site ID 42") {
+		t.Errorf("Should have received a bad status error, got: %v", err)
+	}
+}
@@ -19,8 +19,11 @@ type PerformanceSettings struct {
 		Time  int    `json:"time,omitempty"`
 	} `json:"mode"`
 	Key struct {
-		UniteNakedFullCache bool `json:"unite_naked_full_cache"`
-		ComplyVary          bool `json:"comply_vary"`
+		UniteNakedFullCache bool     `json:"unite_naked_full_cache"`
+		ComplyVary          bool     `json:"comply_vary"`
+		QueryStringMode     string   `json:"query_string_mode,omitempty"`
+		QueryStringParams   []string `json:"query_string_params"`
+		Cookies             []string `json:"cookies"`
 	} `json:"key,omitempty"`
 	Response struct {
 		StaleContent struct {
@@ -130,3 +133,9 @@ func (c *Client) UpdatePerformanceSettings(siteID string, performanceSettings *P
 
 	return &updatedPerformanceSettings, nil
 }
+
+// PreferLastModified returns whether the site prefers Last-Modified over ETag values for the cache TTL. The site
+// status has returned the flag as both perfer_last_modified (sic) and prefer_last_modified, so either is honored.
+func (s *SiteStatusResponse) PreferLastModified() bool {
+	return s.PerformanceConfiguration.PreferLastModified || s.PerformanceConfiguration.PerferLastModified
+}
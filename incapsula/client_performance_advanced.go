@@ -0,0 +1,67 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+)
+
+// Endpoints (unexported consts)
+const endpointPerformanceAdvanced = "sites/performance/advanced"
+
+// Advanced performance params
+const performanceAdvancedOnTheFlyCompression = "on_the_fly_compression"
+
+// UpdatePerformanceAdvancedSetting updates a single advanced performance setting (e.g. on_the_fly_compression) of a site
+func (c *Client) UpdatePerformanceAdvancedSetting(siteID, param, value string) error {
+	type PerformanceAdvancedResponse struct {
+		Res        interface{} `json:"res"`
+		ResMessage string      `json:"res_message"`
+		DebugInfo  DebugInfo   `json:"debug_info"`
+	}
+
+	log.Printf("[INFO] Updating Incapsula advanced performance setting (%s) with value (%s) for siteID: %s\n", param, value, siteID)
+
+	// Post form to Incapsula
+	values := url.Values{
+		"site_id": {siteID},
+		"param":   {param},
+		"value":   {value},
+	}
+	reqURL := c.endpointURL(endpointPerformanceAdvanced)
+	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateSitePerformance)
+	if err != nil {
+		return fmt.Errorf("Error updating advanced performance setting (%s) on site_id: %s: %s", param, siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula update advanced performance setting JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var performanceAdvancedResponse PerformanceAdvancedResponse
+	err = json.Unmarshal([]byte(responseBody), &performanceAdvancedResponse)
+	if err != nil {
+		return fmt.Errorf("Error parsing update advanced performance setting JSON response for siteID %s: %s", siteID, err)
+	}
+
+	var resString string
+
+	if resNumber, ok := performanceAdvancedResponse.Res.(float64); ok {
+		resString = fmt.Sprintf("%d", int(resNumber))
+	} else {
+		resString, _ = performanceAdvancedResponse.Res.(string)
+	}
+
+	// Look at the response status code from Incapsula
+	if resString != "0" {
+		return newIncapsulaError(resString, performanceAdvancedResponse.DebugInfo, "Error from Incapsula service when updating advanced performance setting (%s) for siteID %s: %s", param, siteID, string(responseBody))
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// UpdatePerformanceAdvancedSetting Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientUpdatePerformanceAdvancedSettingParams(t *testing.T) {
+	for attribute, param := range performanceAdvancedParams {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if req.URL.String() != fmt.Sprintf("/%s", endpointPerformanceAdvanced) {
+				t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointPerformanceAdvanced, req.URL.String())
+			}
+			req.ParseForm()
+			if req.PostForm.Get("param") != param || req.PostForm.Get("value") != "true" {
+				t.Errorf("%s: Unexpected param/value, got: %s/%s", attribute, req.PostForm.Get("param"), req.PostForm.Get("value"))
+			}
+			rw.Write([]byte(`{"res":0,"res_message":"OK"}`))
+		}))
+
+		config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+		client := &Client{config: config, httpClient: &http.Client{}}
+		err := client.UpdatePerformanceAdvancedSetting("42", param, "true")
+		if err != nil {
+			t.Errorf("%s: Should not have received an error, got: %s", attribute, err)
+		}
+		server.Close()
+	}
+}
+
+func TestClientUpdatePerformanceAdvancedSettingBadResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":1,"res_message":"Unexpected error"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.UpdatePerformanceAdvancedSetting("42", performanceAdvancedOnTheFlyCompression, "true")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+}
+
+func TestPerformanceAdvancedSettingsFromStatus(t *testing.T) {
+	var siteStatusResponse SiteStatusResponse
+	err := json.Unmarshal([]byte(`{"res":0,"performance_configuration":{"tcp_pre_pooling":true,"on_the_fly_compression":true}}`), &siteStatusResponse)
+	if err != nil {
+		t.Fatalf("Failed to parse site status: %s", err)
+	}
+	settings := performanceAdvancedSettings(&siteStatusResponse)
+	if len(settings) != 1 || settings["perf_on_the_fly_compression"] != true {
+		t.Errorf("Unexpected advanced performance settings, got: %v", settings)
+	}
+}
@@ -1,6 +1,7 @@
 package incapsula
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -194,3 +195,40 @@ func TestClientUpdatePerformanceSettingsValidSite(t *testing.T) {
 		t.Errorf("Should not have received an error")
 	}
 }
+
+////////////////////////////////////////////////////////////////
+// Cache TTL flags Tests
+////////////////////////////////////////////////////////////////
+
+func TestPerformanceSettingsTTLParams(t *testing.T) {
+	performanceSettings := PerformanceSettings{}
+	performanceSettings.TTL.UseShortestCaching = true
+	performanceSettings.TTL.PreferLastModified = true
+	performanceSettingsJSON, err := json.Marshal(performanceSettings.TTL)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	expected := `{"use_shortest_caching":true,"prefer_last_modified":true}`
+	if string(performanceSettingsJSON) != expected {
+		t.Errorf("Expected TTL params %s, got: %s", expected, string(performanceSettingsJSON))
+	}
+}
+
+func TestSiteStatusPreferLastModified(t *testing.T) {
+	cases := map[string]bool{
+		`{"performance_configuration":{"perfer_last_modified":true}}`:                               true,
+		`{"performance_configuration":{"prefer_last_modified":true}}`:                               true,
+		`{"performance_configuration":{"perfer_last_modified":false,"prefer_last_modified":false}}`: false,
+		`{"performance_configuration":{}}`:                                                          false,
+	}
+	for siteStatusJSON, expected := range cases {
+		var siteStatusResponse SiteStatusResponse
+		err := json.Unmarshal([]byte(siteStatusJSON), &siteStatusResponse)
+		if err != nil {
+			t.Fatalf("Failed to parse site status: %s", err)
+		}
+		if siteStatusResponse.PreferLastModified() != expected {
+			t.Errorf("Expected prefer_last_modified to be %t for %s", expected, siteStatusJSON)
+		}
+	}
+}
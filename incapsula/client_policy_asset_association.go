@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"strconv"
 )
 
 type PolicyAssetAssociationStatus struct {
@@ -42,7 +43,8 @@ func (c *Client) AddPolicyAssetAssociation(policyID, assetID, assetType string,
 	return nil
 }
 
-// DeletePolicyAssetAssociation deletes a policy asset association currently managed by Incapsula
+// DeletePolicyAssetAssociation deletes a policy asset association currently managed by Incapsula.
+// Deleting an association which doesn't exist anymore succeeds.
 func (c *Client) DeletePolicyAssetAssociation(policyID, assetID, assetType string, currentAccountId *int) error {
 	log.Printf("[INFO] Deleting Incapsula Policy Asset Association: %s/%s/%s\n", policyID, assetID, assetType)
 
@@ -64,13 +66,85 @@ func (c *Client) DeletePolicyAssetAssociation(policyID, assetID, assetType strin
 	log.Printf("[DEBUG] Incapsula Delete Policy Asset Association JSON response: %s\n", string(responseBody))
 
 	// Check the response code
+	if resp.StatusCode == 404 {
+		// The association is already gone (e.g. removed from the portal), nothing to delete
+		log.Printf("[WARN] Incapsula Policy Asset Association %s/%s/%s not found, assuming it was already deleted\n", policyID, assetID, assetType)
+		return nil
+	}
 	if resp.StatusCode != 200 {
+		// Policies doesn't always answer with a 404 for a missing association, so check whether it still exists
+		isAssociated, checkErr := c.isPolicyAssetAssociated(policyID, assetID, assetType, currentAccountId)
+		if checkErr == nil && !isAssociated {
+			log.Printf("[WARN] Incapsula Policy Asset Association %s/%s/%s not found, assuming it was already deleted\n", policyID, assetID, assetType)
+			return nil
+		}
 		return fmt.Errorf("Error status code %d from Incapsula service when deleting Policy Asset Association: %s", resp.StatusCode, string(responseBody))
 	}
 
 	return nil
 }
 
+// ReplaceSiteWafPolicy replaces the WAF policy of a site: its current WAF policies are disassociated and the new one
+// is associated. A site can only have one WAF policy, so the new policy can't be associated first. When the new policy
+// can't be associated the previous ones are associated again, so the site isn't left without a WAF policy.
+func (c *Client) ReplaceSiteWafPolicy(siteID, newPolicyID string, currentAccountId *int) error {
+	log.Printf("[INFO] Replacing the Incapsula WAF Policy of site_id %s with Policy %s\n", siteID, newPolicyID)
+
+	// An unset account is sent as 0 by the resource
+	if currentAccountId != nil && *currentAccountId == 0 {
+		currentAccountId = nil
+	}
+
+	policy, err := c.GetPolicy(newPolicyID, currentAccountId)
+	if err != nil {
+		return err
+	}
+	if policy.Value.PolicyType != wafRulesPolicyType {
+		return fmt.Errorf("Error - Policy %s isn't a WAF Policy (%s), only the WAF Policy of a site can be replaced", newPolicyID, policy.Value.PolicyType)
+	}
+
+	siteIDInt, err := strconv.Atoi(siteID)
+	if err != nil {
+		return fmt.Errorf("Error - invalid site_id (%s): %s", siteID, err)
+	}
+	sitePolicies, err := c.GetSitePolicies(siteIDInt, currentAccountId)
+	if err != nil {
+		return err
+	}
+
+	replacedPolicyIDs := make([]string, 0)
+	for _, sitePolicy := range *sitePolicies {
+		sitePolicyID := strconv.Itoa(sitePolicy.ID)
+		if sitePolicyID == newPolicyID {
+			log.Printf("[INFO] Policy %s is already associated with site_id %s\n", newPolicyID, siteID)
+			return nil
+		}
+		if sitePolicy.PolicyType == wafRulesPolicyType {
+			replacedPolicyIDs = append(replacedPolicyIDs, sitePolicyID)
+		}
+	}
+
+	for _, replacedPolicyID := range replacedPolicyIDs {
+		err = c.DeletePolicyAssetAssociation(replacedPolicyID, siteID, "WEBSITE", currentAccountId)
+		if err != nil {
+			return fmt.Errorf("Error replacing WAF Policy %s of site_id %s: %s", replacedPolicyID, siteID, err)
+		}
+	}
+
+	err = c.AddPolicyAssetAssociation(newPolicyID, siteID, "WEBSITE", currentAccountId)
+	if err != nil {
+		for _, replacedPolicyID := range replacedPolicyIDs {
+			rollbackErr := c.AddPolicyAssetAssociation(replacedPolicyID, siteID, "WEBSITE", currentAccountId)
+			if rollbackErr != nil {
+				log.Printf("[ERROR] Could not associate WAF Policy %s with site_id %s again: %s\n", replacedPolicyID, siteID, rollbackErr)
+			}
+		}
+		return fmt.Errorf("Error associating WAF Policy %s with site_id %s, the previous WAF Policy %v was restored: %s", newPolicyID, siteID, replacedPolicyIDs, err)
+	}
+
+	return nil
+}
+
 func (c *Client) isPolicyAssetAssociated(policyID, assetID, assetType string, currentAccountId *int) (bool, error) {
 	log.Printf("[INFO] Checking Policy Asset Association: %s/%s/%s\n", policyID, assetID, assetType)
 
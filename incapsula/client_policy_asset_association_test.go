@@ -5,6 +5,8 @@ import (
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -58,3 +60,158 @@ func ClientPolicyAssetAssociatedBase(t *testing.T, shouldBeAssociated bool) (boo
 	return client.isPolicyAssetAssociated(policyID, assetID, assetType, nil)
 
 }
+
+func TestClientDeletePolicyAssetAssociationAlreadyDeleted(t *testing.T) {
+	endpoint := "/policies/v2/assets/WEBSITE/5432/policies/11"
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.WriteHeader(404)
+		rw.Write([]byte(`{"value":"Policy is not applied on asset","isError":true}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.DeletePolicyAssetAssociation("11", "5432", "WEBSITE", nil)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientDeletePolicyAssetAssociationAlreadyDeletedOtherStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.String() {
+		case "/policies/v2/assets/WEBSITE/5432/policies/11":
+			rw.WriteHeader(400)
+			rw.Write([]byte(`{"value":"Association does not exist","isError":true}`))
+		case "/policies/v2/policies/11/assets/WEBSITE/5432":
+			rw.WriteHeader(404)
+			rw.Write([]byte(`{"value":false,"isError":false}`))
+		default:
+			t.Errorf("Unexpected request to %s", req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.DeletePolicyAssetAssociation("11", "5432", "WEBSITE", nil)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientDeletePolicyAssetAssociationStillAssociated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.String() {
+		case "/policies/v2/assets/WEBSITE/5432/policies/11":
+			rw.WriteHeader(500)
+			rw.Write([]byte(`{"value":"Internal error","isError":true}`))
+		case "/policies/v2/policies/11/assets/WEBSITE/5432":
+			rw.Write([]byte(`{"value":true,"isError":false}`))
+		default:
+			t.Errorf("Unexpected request to %s", req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.DeletePolicyAssetAssociation("11", "5432", "WEBSITE", nil)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// ReplaceSiteWafPolicy Tests
+////////////////////////////////////////////////////////////////
+
+// replaceWafPolicyServer serves site 1 with the WAF Policy 20 and an ACL Policy. Associating the Policy 30 fails.
+func replaceWafPolicyServer(t *testing.T, added, deleted *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.String() == "/policies/v2/policies/10?extended=true&caid=7":
+			rw.Write([]byte(`{"value":{"id":10,"name":"New WAF","policyType":"WAF_RULES"},"isError":false}`))
+		case req.URL.String() == "/policies/v2/policies/30?extended=true&caid=7":
+			rw.Write([]byte(`{"value":{"id":30,"name":"Broken WAF","policyType":"WAF_RULES"},"isError":false}`))
+		case req.URL.String() == "/policies/v2/policies/40?extended=true&caid=7":
+			rw.Write([]byte(`{"value":{"id":40,"name":"ACL","policyType":"ACL"},"isError":false}`))
+		case req.Method == http.MethodGet && req.URL.String() == "/policies/v2/assets/WEBSITE/1/policies?caid=7":
+			rw.Write([]byte(`{"value":[{"id":20,"name":"Site WAF","policyType":"WAF_RULES"},{"id":21,"name":"Site ACL","policyType":"ACL"}],"isError":false}`))
+		case req.Method == http.MethodPost:
+			*added = append(*added, req.URL.String())
+			if strings.HasSuffix(req.URL.Path, "/policies/30") {
+				rw.WriteHeader(500)
+				rw.Write([]byte(`{"isError":true}`))
+				return
+			}
+			rw.Write([]byte(`{"value":true,"isError":false}`))
+		case req.Method == http.MethodDelete:
+			*deleted = append(*deleted, req.URL.String())
+			rw.Write([]byte(`{"value":true,"isError":false}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.String())
+		}
+	}))
+}
+
+func TestClientReplaceSiteWafPolicy(t *testing.T) {
+	var added, deleted []string
+	server := replaceWafPolicyServer(t, &added, &deleted)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	accountID := 7
+	err := client.ReplaceSiteWafPolicy("1", "10", &accountID)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if !reflect.DeepEqual(deleted, []string{"/policies/v2/assets/WEBSITE/1/policies/20?caid=7"}) {
+		t.Errorf("Should only have disassociated the WAF Policy 20, got: %v", deleted)
+	}
+	if !reflect.DeepEqual(added, []string{"/policies/v2/assets/WEBSITE/1/policies/10?caid=7"}) {
+		t.Errorf("Should have associated the Policy 10, got: %v", added)
+	}
+}
+
+func TestClientReplaceSiteWafPolicyRollback(t *testing.T) {
+	var added, deleted []string
+	server := replaceWafPolicyServer(t, &added, &deleted)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	accountID := 7
+	err := client.ReplaceSiteWafPolicy("1", "30", &accountID)
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error associating WAF Policy 30 with site_id 1, the previous WAF Policy [20] was restored") {
+		t.Errorf("Should have received a restored error, got: %s", err)
+	}
+	expectedAdded := []string{"/policies/v2/assets/WEBSITE/1/policies/30?caid=7", "/policies/v2/assets/WEBSITE/1/policies/20?caid=7"}
+	if !reflect.DeepEqual(added, expectedAdded) {
+		t.Errorf("Should have associated the WAF Policy 20 again, got: %v", added)
+	}
+}
+
+func TestClientReplaceSiteWafPolicyNotWafPolicy(t *testing.T) {
+	var added, deleted []string
+	server := replaceWafPolicyServer(t, &added, &deleted)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	accountID := 7
+	err := client.ReplaceSiteWafPolicy("1", "40", &accountID)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - Policy 40 isn't a WAF Policy (ACL)") {
+		t.Errorf("Should have received a not a WAF Policy error, got: %v", err)
+	}
+	if len(added) != 0 || len(deleted) != 0 {
+		t.Errorf("Should not have changed the associations, got added: %v, deleted: %v", added, deleted)
+	}
+}
@@ -0,0 +1,70 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+)
+
+// ApplyPolicyToAllSites associates a policy with all the sites of an account and returns the ids of the sites it was
+// associated with. A site can only have one WAF policy: sites with another WAF policy are skipped, unless
+// replaceConflicting is set, in which case their WAF policy is replaced. Sites created later are only covered when the
+// policy is also the account default WAF policy (see incapsula_account_policy_association).
+func (c *Client) ApplyPolicyToAllSites(policyID string, accountID int, replaceConflicting bool) ([]string, error) {
+	log.Printf("[INFO] Applying Incapsula Policy %s to all sites of account: %d\n", policyID, accountID)
+
+	policy, err := c.GetPolicy(policyID, &accountID)
+	if err != nil {
+		return nil, err
+	}
+	isWafPolicy := policy.Value.PolicyType == wafRulesPolicyType
+
+	sites, err := c.ListSites(accountID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	affectedSiteIDs := make([]string, 0, len(sites))
+	for _, site := range sites {
+		siteIDStr := strconv.Itoa(site.SiteID)
+		sitePolicies, err := c.GetSitePolicies(site.SiteID, &accountID)
+		if err != nil {
+			return affectedSiteIDs, err
+		}
+
+		alreadyAssociated := false
+		conflictingPolicyIDs := make([]string, 0)
+		for _, sitePolicy := range *sitePolicies {
+			sitePolicyID := strconv.Itoa(sitePolicy.ID)
+			if sitePolicyID == policyID {
+				alreadyAssociated = true
+			} else if isWafPolicy && sitePolicy.PolicyType == wafRulesPolicyType {
+				conflictingPolicyIDs = append(conflictingPolicyIDs, sitePolicyID)
+			}
+		}
+		if alreadyAssociated {
+			continue
+		}
+		if len(conflictingPolicyIDs) > 0 {
+			if !replaceConflicting {
+				log.Printf("[WARN] Skipping site_id %d, it already has the WAF Policy %v\n", site.SiteID, conflictingPolicyIDs)
+				continue
+			}
+			for _, conflictingPolicyID := range conflictingPolicyIDs {
+				err = c.DeletePolicyAssetAssociation(conflictingPolicyID, siteIDStr, "WEBSITE", &accountID)
+				if err != nil {
+					return affectedSiteIDs, fmt.Errorf("Error replacing WAF Policy %s of site_id %d: %s", conflictingPolicyID, site.SiteID, err)
+				}
+			}
+		}
+
+		err = c.AddPolicyAssetAssociation(policyID, siteIDStr, "WEBSITE", &accountID)
+		if err != nil {
+			return affectedSiteIDs, err
+		}
+		affectedSiteIDs = append(affectedSiteIDs, siteIDStr)
+	}
+
+	return affectedSiteIDs, nil
+}
@@ -0,0 +1,88 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// ApplyPolicyToAllSites Tests
+////////////////////////////////////////////////////////////////
+
+// bulkApplyServer serves an account with three sites: 1 without policies, 2 with the conflicting WAF Policy 20 and
+// 3 already associated with the applied Policy 10. Associations and deletions are recorded.
+func bulkApplyServer(t *testing.T, added, deleted *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch {
+		case req.URL.Path == fmt.Sprintf("/%s", endpointSiteList):
+			req.ParseForm()
+			if req.PostForm.Get("account_id") != "7" {
+				t.Errorf("Expected account_id to be 7, got: %s", req.PostForm.Get("account_id"))
+			}
+			rw.Write([]byte(`{"sites":[{"site_id":1},{"site_id":2},{"site_id":3}],"res":0}`))
+		case req.URL.String() == "/policies/v2/policies/10?extended=true&caid=7":
+			rw.Write([]byte(`{"value":{"id":10,"name":"Governance WAF","policyType":"WAF_RULES"},"isError":false}`))
+		case req.Method == http.MethodGet && req.URL.String() == "/policies/v2/assets/WEBSITE/1/policies?caid=7":
+			rw.Write([]byte(`{"value":[],"isError":false}`))
+		case req.Method == http.MethodGet && req.URL.String() == "/policies/v2/assets/WEBSITE/2/policies?caid=7":
+			rw.Write([]byte(`{"value":[{"id":20,"name":"Site WAF","policyType":"WAF_RULES"},{"id":21,"name":"Site ACL","policyType":"ACL"}],"isError":false}`))
+		case req.Method == http.MethodGet && req.URL.String() == "/policies/v2/assets/WEBSITE/3/policies?caid=7":
+			rw.Write([]byte(`{"value":[{"id":10,"name":"Governance WAF","policyType":"WAF_RULES"}],"isError":false}`))
+		case req.Method == http.MethodPost:
+			*added = append(*added, req.URL.String())
+			rw.Write([]byte(`{"value":true,"isError":false}`))
+		case req.Method == http.MethodDelete:
+			*deleted = append(*deleted, req.URL.String())
+			rw.Write([]byte(`{"value":true,"isError":false}`))
+		default:
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.String())
+		}
+	}))
+}
+
+func TestClientApplyPolicyToAllSitesSkipsConflicting(t *testing.T) {
+	var added, deleted []string
+	server := bulkApplyServer(t, &added, &deleted)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteIDs, err := client.ApplyPolicyToAllSites("10", 7, false)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if !reflect.DeepEqual(siteIDs, []string{"1"}) {
+		t.Errorf("Should only have applied the policy to site 1, got: %v", siteIDs)
+	}
+	if !reflect.DeepEqual(added, []string{"/policies/v2/assets/WEBSITE/1/policies/10?caid=7"}) {
+		t.Errorf("Unexpected associations, got: %v", added)
+	}
+	if len(deleted) != 0 {
+		t.Errorf("Should not have deleted any association, got: %v", deleted)
+	}
+}
+
+func TestClientApplyPolicyToAllSitesReplacesConflicting(t *testing.T) {
+	var added, deleted []string
+	server := bulkApplyServer(t, &added, &deleted)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteIDs, err := client.ApplyPolicyToAllSites("10", 7, true)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if !reflect.DeepEqual(siteIDs, []string{"1", "2"}) {
+		t.Errorf("Should have applied the policy to sites 1 and 2, got: %v", siteIDs)
+	}
+	if !reflect.DeepEqual(deleted, []string{"/policies/v2/assets/WEBSITE/2/policies/20?caid=7"}) {
+		t.Errorf("Should only have replaced the WAF Policy of site 2, got: %v", deleted)
+	}
+	if len(added) != 2 {
+		t.Errorf("Should have added 2 associations, got: %v", added)
+	}
+}
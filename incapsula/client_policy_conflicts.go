@@ -0,0 +1,53 @@
+package incapsula
+
+import (
+	"log"
+	"sort"
+	"time"
+)
+
+// WafPolicyConflict is a site with more than one WAF policy directly associated with it
+type WafPolicyConflict struct {
+	SiteID    int
+	Domain    string
+	PolicyIDs []int
+}
+
+// ReportWafPolicyConflicts scans the WAF policies associated with every site of an account and reports all the sites
+// with more than one, instead of failing on the first one. When accountID is 0 the sites of the account identified
+// by the authentication parameters are scanned.
+func (c *Client) ReportWafPolicyConflicts(accountID int) ([]WafPolicyConflict, error) {
+	log.Printf("[INFO] Reporting Incapsula WAF Policy conflicts of account: %d\n", accountID)
+
+	sites, err := c.ListSites(accountID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := make([]WafPolicyConflict, 0)
+	for _, site := range sites {
+		sitePolicies, err := c.GetSitePolicies(site.SiteID, &accountID)
+		if err != nil {
+			return nil, err
+		}
+		wafPolicyIDs := sitePolicyIDsOfType(*sitePolicies, wafRulesPolicyType)
+		if len(wafPolicyIDs) > 1 {
+			conflicts = append(conflicts, WafPolicyConflict{SiteID: site.SiteID, Domain: site.Domain, PolicyIDs: wafPolicyIDs})
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].SiteID < conflicts[j].SiteID })
+	return conflicts, nil
+}
+
+// sitePolicyIDsOfType returns the sorted identifiers of the policies of a given type, e.g. the WAF policies of a site
+func sitePolicyIDsOfType(policies []Policy, policyType string) []int {
+	policyIDs := make([]int, 0)
+	for _, policy := range policies {
+		if policy.PolicyType == policyType {
+			policyIDs = append(policyIDs, policy.ID)
+		}
+	}
+	sort.Ints(policyIDs)
+	return policyIDs
+}
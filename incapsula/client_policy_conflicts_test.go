@@ -0,0 +1,98 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// ReportWafPolicyConflicts Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientReportWafPolicyConflicts(t *testing.T) {
+	sitePolicies := map[int]string{
+		1: `{"value":[{"id":20,"policyType":"WAF_RULES"},{"id":10,"policyType":"WAF_RULES"},{"id":11,"policyType":"ACL"}],"isError":false}`,
+		2: `{"value":[{"id":10,"policyType":"WAF_RULES"},{"id":11,"policyType":"ACL"},{"id":12,"policyType":"WHITELIST"}],"isError":false}`,
+		3: `{"value":[{"id":30,"policyType":"WAF_RULES"},{"id":10,"policyType":"WAF_RULES"},{"id":20,"policyType":"WAF_RULES"}],"isError":false}`,
+		4: `{"value":[],"isError":false}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() == fmt.Sprintf("/%s", endpointSiteList) {
+			rw.Write([]byte(`{"res":0,"sites":[
+				{"site_id":3,"domain":"c.example.com"},
+				{"site_id":1,"domain":"a.example.com"},
+				{"site_id":2,"domain":"b.example.com"},
+				{"site_id":4,"domain":"d.example.com"}]}`))
+			return
+		}
+		for siteID, policies := range sitePolicies {
+			if req.URL.String() == fmt.Sprintf("/policies/v2/assets/WEBSITE/%d/policies?caid=7", siteID) {
+				rw.Write([]byte(policies))
+				return
+			}
+		}
+		t.Errorf("Unexpected request: %s", req.URL.String())
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	conflicts, err := client.ReportWafPolicyConflicts(7)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	expected := []WafPolicyConflict{
+		{SiteID: 1, Domain: "a.example.com", PolicyIDs: []int{10, 20}},
+		{SiteID: 3, Domain: "c.example.com", PolicyIDs: []int{10, 20, 30}},
+	}
+	if !reflect.DeepEqual(conflicts, expected) {
+		t.Errorf("Unexpected WAF policy conflicts, expected %+v, got: %+v", expected, conflicts)
+	}
+}
+
+func TestClientReportWafPolicyConflictsNoConflicts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.String() {
+		case fmt.Sprintf("/%s", endpointSiteList):
+			rw.Write([]byte(`{"res":0,"sites":[{"site_id":1,"domain":"a.example.com"}]}`))
+		case "/policies/v2/assets/WEBSITE/1/policies?caid=7":
+			rw.Write([]byte(`{"value":[{"id":10,"policyType":"WAF_RULES"}],"isError":false}`))
+		default:
+			t.Errorf("Unexpected request: %s", req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	conflicts, err := client.ReportWafPolicyConflicts(7)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(conflicts) != 0 {
+		t.Errorf("Should not have reported any conflict, got: %+v", conflicts)
+	}
+}
+
+func TestClientReportWafPolicyConflictsBadPoliciesResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() == fmt.Sprintf("/%s", endpointSiteList) {
+			rw.Write([]byte(`{"res":0,"sites":[{"site_id":1,"domain":"a.example.com"}]}`))
+			return
+		}
+		rw.WriteHeader(500)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.ReportWafPolicyConflicts(7)
+	if err == nil || !strings.Contains(err.Error(), "Error status code 500 from Incapsula service when reading Policies for site_id 1") {
+		t.Errorf("Should have received a bad status code error, got: %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+)
+
+// Rate limit rule action enumerations
+const (
+	RateLimitActionBlock     = "block"
+	RateLimitActionChallenge = "challenge"
+	RateLimitActionThrottle  = "throttle"
+)
+
+var rateLimitActions = []string{RateLimitActionBlock, RateLimitActionChallenge, RateLimitActionThrottle}
+
+// Limits of the request threshold and of the window (in seconds) of a rate limit rule
+const (
+	rateLimitMinThreshold = 1
+	rateLimitMaxThreshold = 100000
+	rateLimitMinWindow    = 10
+	rateLimitMaxWindow    = 3600
+)
+
+// RateLimitRule applies Action to a client once it sends more than Threshold requests to the paths matching
+// URLPattern within Window seconds, e.g. 100 requests per 60 seconds to /login
+type RateLimitRule struct {
+	Name       string `json:"name"`
+	URLPattern string `json:"url_pattern"`
+	Threshold  int    `json:"threshold"`
+	Window     int    `json:"window"`
+	Action     string `json:"action"`
+}
+
+// RateLimitRuleWithID contains the RateLimitRule as well as the rule identifier
+type RateLimitRuleWithID struct {
+	RateLimitRule
+	RuleID int `json:"rule_id"`
+}
+
+// RateLimitRulesResponse contains the rate limit rules of a site
+type RateLimitRulesResponse struct {
+	Rules []RateLimitRuleWithID `json:"rules"`
+}
+
+// AddRateLimitRule adds a rate limit rule to a site
+func (c *Client) AddRateLimitRule(siteID string, rule *RateLimitRule) (*RateLimitRuleWithID, error) {
+	log.Printf("[INFO] Adding Incapsula Rate Limit Rule (%s) for Site ID %s\n", rule.URLPattern, siteID)
+
+	err := validateRateLimitRule(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	ruleJSON, err := json.Marshal(rule)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to JSON marshal RateLimitRule: %s", err)
+	}
+
+	// Dump Request JSON
+	log.Printf("[DEBUG] Incapsula Add Rate Limit Rule JSON request body: %s\n", string(ruleJSON))
+
+	// Post to Incapsula
+	reqURL := fmt.Sprintf("%s/%s/settings/rate-limit/rules", c.endpointURL(endpointSiteSettingsBase), siteID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodPost, reqURL, ruleJSON, CreateRateLimitRule)
+	if err != nil {
+		return nil, fmt.Errorf("Error from Incapsula service when adding Rate Limit Rule for Site ID %s: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Add Rate Limit Rule JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from Incapsula service when adding Rate Limit Rule for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var ruleWithID RateLimitRuleWithID
+	err = json.Unmarshal([]byte(responseBody), &ruleWithID)
+	if err != nil || !strings.Contains(string(responseBody), "\"rule_id\":") {
+		return nil, fmt.Errorf("Error parsing Rate Limit Rule JSON response for Site ID %s: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	return &ruleWithID, nil
+}
+
+// ListRateLimitRules gets the rate limit rules of a site
+func (c *Client) ListRateLimitRules(siteID string) ([]RateLimitRuleWithID, int, error) {
+	log.Printf("[INFO] Getting Incapsula Rate Limit Rules for Site ID %s\n", siteID)
+
+	reqURL := fmt.Sprintf("%s/%s/settings/rate-limit/rules", c.endpointURL(endpointSiteSettingsBase), siteID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadRateLimitRule)
+	if err != nil {
+		return nil, 0, fmt.Errorf("Error from Incapsula service when reading Rate Limit Rules for Site ID %s: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Read Rate Limit Rules JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, resp.StatusCode, fmt.Errorf("Error status code %d from Incapsula service when reading Rate Limit Rules for Site ID %s: %s", resp.StatusCode, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var rulesResponse RateLimitRulesResponse
+	err = json.Unmarshal([]byte(responseBody), &rulesResponse)
+	if err != nil {
+		return nil, resp.StatusCode, fmt.Errorf("Error parsing Rate Limit Rules JSON response for Site ID %s: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	return rulesResponse.Rules, resp.StatusCode, nil
+}
+
+// DeleteRateLimitRule deletes a rate limit rule of a site
+func (c *Client) DeleteRateLimitRule(siteID string, ruleID int) error {
+	log.Printf("[INFO] Deleting Incapsula Rate Limit Rule %d for Site ID %s\n", ruleID, siteID)
+
+	reqURL := fmt.Sprintf("%s/%s/settings/rate-limit/rules/%d", c.endpointURL(endpointSiteSettingsBase), siteID, ruleID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodDelete, reqURL, nil, DeleteRateLimitRule)
+	if err != nil {
+		return fmt.Errorf("Error from Incapsula service when deleting Rate Limit Rule %d for Site ID %s: %s", ruleID, siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Delete Rate Limit Rule JSON response: %s\n", string(responseBody))
+
+	// Check the response code, a rule that is already gone is considered deleted
+	if resp.StatusCode != 200 && resp.StatusCode != 404 {
+		return fmt.Errorf("Error status code %d from Incapsula service when deleting Rate Limit Rule %d for Site ID %s: %s", resp.StatusCode, ruleID, siteID, string(responseBody))
+	}
+
+	return nil
+}
+
+// validateRateLimitRule checks the URL pattern, the threshold, the window and the action of a rule
+func validateRateLimitRule(rule *RateLimitRule) error {
+	if !pathAccelerationURLPatternRegex.MatchString(rule.URLPattern) {
+		return fmt.Errorf("Error - invalid URL pattern (%s), must be an absolute path optionally containing * wildcards, e.g. /login", rule.URLPattern)
+	}
+	if rule.Threshold < rateLimitMinThreshold || rule.Threshold > rateLimitMaxThreshold {
+		return fmt.Errorf("Error - invalid rate limit threshold (%d), must be between %d and %d requests", rule.Threshold, rateLimitMinThreshold, rateLimitMaxThreshold)
+	}
+	if rule.Window < rateLimitMinWindow || rule.Window > rateLimitMaxWindow {
+		return fmt.Errorf("Error - invalid rate limit window (%d), must be between %d and %d seconds", rule.Window, rateLimitMinWindow, rateLimitMaxWindow)
+	}
+	if !contains(rateLimitActions, rule.Action) {
+		return fmt.Errorf("Error - invalid rate limit action (%s), must be one of %v", rule.Action, rateLimitActions)
+	}
+	return nil
+}
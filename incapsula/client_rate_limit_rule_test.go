@@ -0,0 +1,102 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// AddRateLimitRule Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientAddRateLimitRuleActions(t *testing.T) {
+	for _, action := range rateLimitActions {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if req.Method != http.MethodPost || req.URL.String() != "/sites/42/settings/rate-limit/rules" {
+				t.Errorf("Should have have posted to /sites/42/settings/rate-limit/rules endpoint. Got: %s %s", req.Method, req.URL.String())
+			}
+			body, _ := ioutil.ReadAll(req.Body)
+			var rule RateLimitRule
+			err := json.Unmarshal(body, &rule)
+			if err != nil {
+				t.Fatalf("Failed to parse request body: %s", err)
+			}
+			expected := RateLimitRule{Name: "Login", URLPattern: "/login", Threshold: 100, Window: 60, Action: action}
+			if rule != expected {
+				t.Errorf("Unexpected request body, expected %+v, got: %s", expected, string(body))
+			}
+			rw.Write([]byte(`{"rule_id":7,"name":"Login","url_pattern":"/login","threshold":100,"window":60,"action":"` + action + `"}`))
+		}))
+
+		config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+		client := &Client{config: config, httpClient: &http.Client{}}
+		ruleWithID, err := client.AddRateLimitRule("42", &RateLimitRule{Name: "Login", URLPattern: "/login", Threshold: 100, Window: 60, Action: action})
+		if err != nil {
+			t.Errorf("Should not have received an error for action %s, got: %s", action, err)
+		} else if ruleWithID.RuleID != 7 || ruleWithID.Action != action {
+			t.Errorf("Unexpected rule for action %s, got: %+v", action, ruleWithID)
+		}
+		server.Close()
+	}
+}
+
+func TestClientAddRateLimitRuleInvalid(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	invalidRules := map[string]RateLimitRule{
+		"Error - invalid rate limit threshold (0)": {URLPattern: "/login", Threshold: 0, Window: 60, Action: RateLimitActionBlock},
+		"Error - invalid rate limit window (5)":    {URLPattern: "/login", Threshold: 100, Window: 5, Action: RateLimitActionBlock},
+		"Error - invalid rate limit action (drop)": {URLPattern: "/login", Threshold: 100, Window: 60, Action: "drop"},
+		"Error - invalid URL pattern (login)":      {URLPattern: "login", Threshold: 100, Window: 60, Action: RateLimitActionBlock},
+	}
+	for expectedError, rule := range invalidRules {
+		rule := rule
+		_, err := client.AddRateLimitRule("42", &rule)
+		if err == nil || !strings.HasPrefix(err.Error(), expectedError) {
+			t.Errorf("Should have received an error starting with %q, got: %v", expectedError, err)
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// ListRateLimitRules / DeleteRateLimitRule Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientListRateLimitRules(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"rules":[{"rule_id":7,"name":"Login","url_pattern":"/login","threshold":100,"window":60,"action":"challenge"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	rules, statusCode, err := client.ListRateLimitRules("42")
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if statusCode != 200 || len(rules) != 1 || rules[0].RuleID != 7 || rules[0].Action != RateLimitActionChallenge {
+		t.Errorf("Unexpected rules, got: %d %+v", statusCode, rules)
+	}
+}
+
+func TestClientDeleteRateLimitRuleNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete || req.URL.String() != "/sites/42/settings/rate-limit/rules/7" {
+			t.Errorf("Unexpected request: %s %s", req.Method, req.URL.String())
+		}
+		rw.WriteHeader(404)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev2: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.DeleteRateLimitRule("42", 7)
+	if err != nil {
+		t.Errorf("Should not have received an error for a rule that is already gone, got: %s", err)
+	}
+}
@@ -0,0 +1,218 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// Response header rule actions
+const (
+	ResponseHeaderActionAdd     = "add"
+	ResponseHeaderActionReplace = "replace"
+	ResponseHeaderActionRemove  = "remove"
+)
+
+var responseHeaderActions = []string{ResponseHeaderActionAdd, ResponseHeaderActionReplace, ResponseHeaderActionRemove}
+
+// Delivery rules category of the rules applied to the responses
+const rewriteResponseDeliveryRuleCategory = "REWRITE_RESPONSE"
+
+const (
+	responseRewriteHeaderRuleAction = "RULE_ACTION_RESPONSE_REWRITE_HEADER"
+	responseDeleteHeaderRuleAction  = "RULE_ACTION_RESPONSE_DELETE_HEADER"
+)
+
+// ResponseHeaderRule is a delivery rule that adds, replaces or removes a header of the responses of a site
+type ResponseHeaderRule struct {
+	Name        string
+	HeaderName  string
+	HeaderValue string
+	Action      string
+	Filter      string
+}
+
+// AddResponseHeaderRule adds a response header rule after the existing rules of the REWRITE_RESPONSE delivery rules
+// category, so the order of the rules is kept
+func (c *Client) AddResponseHeaderRule(siteID int, rule ResponseHeaderRule) error {
+	log.Printf("[INFO] Adding Incapsula response header rule %s (%s %s) for Site ID %d\n", rule.Name, rule.Action, rule.HeaderName, siteID)
+
+	err := validateResponseHeaderRule(rule)
+	if err != nil {
+		return err
+	}
+
+	siteIDStr := strconv.Itoa(siteID)
+	rulesList, diags := c.ReadDeliveryRuleConfiguration(siteIDStr, rewriteResponseDeliveryRuleCategory)
+	if diags != nil && diags.HasError() {
+		return fmt.Errorf("Error reading delivery rules before adding response header rule %s for Site ID %d: %s", rule.Name, siteID, diags[0].Detail)
+	}
+
+	for _, existingRule := range rulesList.RulesList {
+		if existingRule.RuleName == rule.Name {
+			return fmt.Errorf("Error - a delivery rule named %s already exists for Site ID %d", rule.Name, siteID)
+		}
+	}
+
+	rulesList.RulesList = append(rulesList.RulesList, responseHeaderRuleDto(rule))
+	_, diags = c.UpdateDeliveryRuleConfiguration(siteIDStr, rewriteResponseDeliveryRuleCategory, rulesList)
+	if diags != nil && diags.HasError() {
+		return fmt.Errorf("Error adding response header rule %s for Site ID %d: %s", rule.Name, siteID, diags[0].Detail)
+	}
+
+	return nil
+}
+
+// GetResponseHeaderRule returns the response header rule with the given name, or nil when there isn't one
+func (c *Client) GetResponseHeaderRule(siteID int, ruleName string) (*ResponseHeaderRule, error) {
+	log.Printf("[INFO] Getting Incapsula response header rule %s for Site ID %d\n", ruleName, siteID)
+
+	rulesList, diags := c.ReadDeliveryRuleConfiguration(strconv.Itoa(siteID), rewriteResponseDeliveryRuleCategory)
+	if diags != nil && diags.HasError() {
+		return nil, fmt.Errorf("Error reading response header rule %s for Site ID %d: %s", ruleName, siteID, diags[0].Detail)
+	}
+
+	for _, ruleDto := range rulesList.RulesList {
+		if ruleDto.RuleName == ruleName {
+			return responseHeaderRuleFromDto(ruleDto), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// UpdateResponseHeaderRule replaces the response header rule with the same name in place, so it keeps its position
+func (c *Client) UpdateResponseHeaderRule(siteID int, rule ResponseHeaderRule) error {
+	log.Printf("[INFO] Updating Incapsula response header rule %s (%s %s) for Site ID %d\n", rule.Name, rule.Action, rule.HeaderName, siteID)
+
+	err := validateResponseHeaderRule(rule)
+	if err != nil {
+		return err
+	}
+
+	siteIDStr := strconv.Itoa(siteID)
+	rulesList, diags := c.ReadDeliveryRuleConfiguration(siteIDStr, rewriteResponseDeliveryRuleCategory)
+	if diags != nil && diags.HasError() {
+		return fmt.Errorf("Error reading delivery rules before updating response header rule %s for Site ID %d: %s", rule.Name, siteID, diags[0].Detail)
+	}
+
+	found := false
+	for i, existingRule := range rulesList.RulesList {
+		if existingRule.RuleName == rule.Name {
+			rulesList.RulesList[i] = responseHeaderRuleDto(rule)
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("Error - response header rule %s not found for Site ID %d", rule.Name, siteID)
+	}
+
+	_, diags = c.UpdateDeliveryRuleConfiguration(siteIDStr, rewriteResponseDeliveryRuleCategory, rulesList)
+	if diags != nil && diags.HasError() {
+		return fmt.Errorf("Error updating response header rule %s for Site ID %d: %s", rule.Name, siteID, diags[0].Detail)
+	}
+
+	return nil
+}
+
+// DeleteResponseHeaderRule removes a response header rule, keeping the order of the other rules. A rule that doesn't
+// exist anymore isn't an error.
+func (c *Client) DeleteResponseHeaderRule(siteID int, ruleName string) error {
+	log.Printf("[INFO] Deleting Incapsula response header rule %s for Site ID %d\n", ruleName, siteID)
+
+	siteIDStr := strconv.Itoa(siteID)
+	rulesList, diags := c.ReadDeliveryRuleConfiguration(siteIDStr, rewriteResponseDeliveryRuleCategory)
+	if diags != nil && diags.HasError() {
+		return fmt.Errorf("Error reading delivery rules before deleting response header rule %s for Site ID %d: %s", ruleName, siteID, diags[0].Detail)
+	}
+
+	remainingRules := make([]DeliveryRuleDto, 0, len(rulesList.RulesList))
+	for _, ruleDto := range rulesList.RulesList {
+		if ruleDto.RuleName != ruleName {
+			remainingRules = append(remainingRules, ruleDto)
+		}
+	}
+	if len(remainingRules) == len(rulesList.RulesList) {
+		log.Printf("[INFO] Incapsula response header rule %s for Site ID %d has already been deleted\n", ruleName, siteID)
+		return nil
+	}
+
+	rulesList.RulesList = remainingRules
+	_, diags = c.UpdateDeliveryRuleConfiguration(siteIDStr, rewriteResponseDeliveryRuleCategory, rulesList)
+	if diags != nil && diags.HasError() {
+		return fmt.Errorf("Error deleting response header rule %s for Site ID %d: %s", ruleName, siteID, diags[0].Detail)
+	}
+
+	return nil
+}
+
+func validateResponseHeaderRule(rule ResponseHeaderRule) error {
+	if rule.Name == "" {
+		return fmt.Errorf("Error - a response header rule must have a name")
+	}
+	if !contains(responseHeaderActions, rule.Action) {
+		return fmt.Errorf("Error - invalid response header action (%s), must be one of %v", rule.Action, responseHeaderActions)
+	}
+	// Header names are HTTP tokens, the same as the cache vary rule names
+	if !cacheVaryNameRegex.MatchString(rule.HeaderName) {
+		return fmt.Errorf("Error - invalid header name (%s)", rule.HeaderName)
+	}
+	if rule.Action == ResponseHeaderActionRemove {
+		if rule.HeaderValue != "" {
+			return fmt.Errorf("Error - a header value can't be set with the %s response header action", ResponseHeaderActionRemove)
+		}
+		return nil
+	}
+	if rule.HeaderValue == "" {
+		return fmt.Errorf("Error - a header value is required with the %s response header action", rule.Action)
+	}
+	if strings.ContainsAny(rule.HeaderValue, "\r\n") {
+		return fmt.Errorf("Error - the value of header %s can't contain line breaks", rule.HeaderName)
+	}
+	return nil
+}
+
+func responseHeaderRuleDto(rule ResponseHeaderRule) DeliveryRuleDto {
+	ruleDto := DeliveryRuleDto{
+		RuleName:   rule.Name,
+		Filter:     rule.Filter,
+		HeaderName: rule.HeaderName,
+		Enabled:    true,
+	}
+
+	if rule.Action == ResponseHeaderActionRemove {
+		ruleDto.Action = responseDeleteHeaderRuleAction
+		return ruleDto
+	}
+
+	// add only sets a missing header, replace also rewrites the existing one
+	rewriteExisting := rule.Action == ResponseHeaderActionReplace
+	ruleDto.Action = responseRewriteHeaderRuleAction
+	ruleDto.To = rule.HeaderValue
+	ruleDto.AddMissing = true
+	ruleDto.RewriteExisting = &rewriteExisting
+	return ruleDto
+}
+
+func responseHeaderRuleFromDto(ruleDto DeliveryRuleDto) *ResponseHeaderRule {
+	rule := ResponseHeaderRule{
+		Name:       ruleDto.RuleName,
+		HeaderName: ruleDto.HeaderName,
+		Filter:     ruleDto.Filter,
+	}
+
+	switch {
+	case ruleDto.Action == responseDeleteHeaderRuleAction:
+		rule.Action = ResponseHeaderActionRemove
+	case ruleDto.RewriteExisting != nil && *ruleDto.RewriteExisting:
+		rule.Action = ResponseHeaderActionReplace
+		rule.HeaderValue = ruleDto.To
+	default:
+		rule.Action = ResponseHeaderActionAdd
+		rule.HeaderValue = ruleDto.To
+	}
+
+	return &rule
+}
@@ -0,0 +1,228 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const existingResponseRulesJSON = `{"data":[{"rule_name":"first","action":"RULE_ACTION_RESPONSE_REWRITE_RESPONSE_CODE","response_code":302,"enabled":true},{"rule_name":"second","action":"RULE_ACTION_RESPONSE_DELETE_HEADER","header_name":"Server","enabled":true}]}`
+
+// responseHeaderRuleServer serves the existing REWRITE_RESPONSE rules and returns the rules sent with the update
+func responseHeaderRuleServer(t *testing.T, updatedRules *[]DeliveryRuleDto) *httptest.Server {
+	endpoint := fmt.Sprintf("/sites/42/delivery-rules-configuration?category=%s", rewriteResponseDeliveryRuleCategory)
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		if req.Method == http.MethodPut {
+			body, _ := ioutil.ReadAll(req.Body)
+			var rulesList DeliveryRulesListDTO
+			if err := json.Unmarshal(body, &rulesList); err != nil {
+				t.Errorf("Failed to parse the update request: %s", err)
+			}
+			*updatedRules = rulesList.RulesList
+			rw.Write(body)
+			return
+		}
+		rw.Write([]byte(existingResponseRulesJSON))
+	}))
+}
+
+////////////////////////////////////////////////////////////////
+// AddResponseHeaderRule Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientAddResponseHeaderRuleAdd(t *testing.T) {
+	var updatedRules []DeliveryRuleDto
+	server := responseHeaderRuleServer(t, &updatedRules)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev3: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.AddResponseHeaderRule(42, ResponseHeaderRule{Name: "csp", HeaderName: "Content-Security-Policy", HeaderValue: "default-src 'self'", Action: ResponseHeaderActionAdd})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+
+	if len(updatedRules) != 3 || updatedRules[0].RuleName != "first" || updatedRules[1].RuleName != "second" {
+		t.Fatalf("Should have kept the existing rules in order and appended the new one, got: %+v", updatedRules)
+	}
+	rule := updatedRules[2]
+	if rule.RuleName != "csp" || rule.Action != responseRewriteHeaderRuleAction || rule.HeaderName != "Content-Security-Policy" || rule.To != "default-src 'self'" {
+		t.Errorf("Unexpected rule, got: %+v", rule)
+	}
+	if !rule.AddMissing || rule.RewriteExisting == nil || *rule.RewriteExisting {
+		t.Errorf("The add action should only add a missing header, got: %+v", rule)
+	}
+}
+
+func TestClientAddResponseHeaderRuleReplace(t *testing.T) {
+	var updatedRules []DeliveryRuleDto
+	server := responseHeaderRuleServer(t, &updatedRules)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev3: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.AddResponseHeaderRule(42, ResponseHeaderRule{Name: "frame", HeaderName: "X-Frame-Options", HeaderValue: "DENY", Action: ResponseHeaderActionReplace})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+
+	if len(updatedRules) != 3 {
+		t.Fatalf("Should have sent 3 rules, got: %+v", updatedRules)
+	}
+	rule := updatedRules[2]
+	if rule.Action != responseRewriteHeaderRuleAction || rule.To != "DENY" || !rule.AddMissing || rule.RewriteExisting == nil || !*rule.RewriteExisting {
+		t.Errorf("The replace action should rewrite the existing header, got: %+v", rule)
+	}
+}
+
+func TestClientAddResponseHeaderRuleRemove(t *testing.T) {
+	var updatedRules []DeliveryRuleDto
+	server := responseHeaderRuleServer(t, &updatedRules)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev3: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.AddResponseHeaderRule(42, ResponseHeaderRule{Name: "powered-by", HeaderName: "X-Powered-By", Action: ResponseHeaderActionRemove})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+
+	if len(updatedRules) != 3 {
+		t.Fatalf("Should have sent 3 rules, got: %+v", updatedRules)
+	}
+	rule := updatedRules[2]
+	if rule.Action != responseDeleteHeaderRuleAction || rule.HeaderName != "X-Powered-By" || rule.To != "" || rule.RewriteExisting != nil {
+		t.Errorf("Unexpected remove rule, got: %+v", rule)
+	}
+}
+
+func TestClientAddResponseHeaderRuleInvalid(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev3: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	cases := []struct {
+		rule     ResponseHeaderRule
+		expected string
+	}{
+		{ResponseHeaderRule{Name: "bad", HeaderName: "X Frame", HeaderValue: "DENY", Action: ResponseHeaderActionAdd}, "Error - invalid header name (X Frame)"},
+		{ResponseHeaderRule{Name: "bad", HeaderName: "X-Frame-Options:", HeaderValue: "DENY", Action: ResponseHeaderActionAdd}, "Error - invalid header name (X-Frame-Options:)"},
+		{ResponseHeaderRule{Name: "bad", HeaderName: "X-Frame-Options", HeaderValue: "DENY", Action: "set"}, "Error - invalid response header action (set)"},
+		{ResponseHeaderRule{Name: "bad", HeaderName: "X-Frame-Options", Action: ResponseHeaderActionReplace}, "Error - a header value is required with the replace response header action"},
+		{ResponseHeaderRule{Name: "bad", HeaderName: "Server", HeaderValue: "x", Action: ResponseHeaderActionRemove}, "Error - a header value can't be set with the remove response header action"},
+		{ResponseHeaderRule{Name: "bad", HeaderName: "X-Frame-Options", HeaderValue: "DENY\r\nSet-Cookie: a=b", Action: ResponseHeaderActionAdd}, "Error - the value of header X-Frame-Options can't contain line breaks"},
+	}
+	for _, c := range cases {
+		err := client.AddResponseHeaderRule(42, c.rule)
+		if err == nil {
+			t.Errorf("Should have received an error for rule %+v", c.rule)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), c.expected) {
+			t.Errorf("Expected error %s, got: %s", c.expected, err)
+		}
+	}
+}
+
+func TestClientAddResponseHeaderRuleDuplicateName(t *testing.T) {
+	var updatedRules []DeliveryRuleDto
+	server := responseHeaderRuleServer(t, &updatedRules)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev3: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.AddResponseHeaderRule(42, ResponseHeaderRule{Name: "second", HeaderName: "X-Frame-Options", HeaderValue: "DENY", Action: ResponseHeaderActionAdd})
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - a delivery rule named second already exists") {
+		t.Errorf("Should have received a duplicate name error, got: %v", err)
+	}
+	if updatedRules != nil {
+		t.Errorf("Should not have updated the rules")
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetResponseHeaderRule Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetResponseHeaderRule(t *testing.T) {
+	var updatedRules []DeliveryRuleDto
+	server := responseHeaderRuleServer(t, &updatedRules)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev3: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	rule, err := client.GetResponseHeaderRule(42, "second")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if rule == nil || rule.Action != ResponseHeaderActionRemove || rule.HeaderName != "Server" {
+		t.Errorf("Unexpected rule, got: %+v", rule)
+	}
+
+	rule, err = client.GetResponseHeaderRule(42, "missing")
+	if err != nil || rule != nil {
+		t.Errorf("Should have received a nil rule without an error, got: %+v, %v", rule, err)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// UpdateResponseHeaderRule Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientUpdateResponseHeaderRuleKeepsPosition(t *testing.T) {
+	var updatedRules []DeliveryRuleDto
+	server := responseHeaderRuleServer(t, &updatedRules)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev3: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.UpdateResponseHeaderRule(42, ResponseHeaderRule{Name: "second", HeaderName: "Server", HeaderValue: "edge", Action: ResponseHeaderActionReplace})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(updatedRules) != 2 || updatedRules[1].RuleName != "second" || updatedRules[1].Action != responseRewriteHeaderRuleAction || updatedRules[1].To != "edge" {
+		t.Errorf("Should have replaced the rule in place, got: %+v", updatedRules)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// DeleteResponseHeaderRule Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientDeleteResponseHeaderRule(t *testing.T) {
+	var updatedRules []DeliveryRuleDto
+	server := responseHeaderRuleServer(t, &updatedRules)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev3: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.DeleteResponseHeaderRule(42, "first")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(updatedRules) != 1 || updatedRules[0].RuleName != "second" {
+		t.Errorf("Should have kept only the other rule, got: %+v", updatedRules)
+	}
+}
+
+func TestClientDeleteResponseHeaderRuleAlreadyDeleted(t *testing.T) {
+	var updatedRules []DeliveryRuleDto
+	server := responseHeaderRuleServer(t, &updatedRules)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLRev3: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.DeleteResponseHeaderRule(42, "missing")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if updatedRules != nil {
+		t.Errorf("Should not have updated the rules")
+	}
+}
@@ -78,7 +78,7 @@ func (c *Client) AddSecurityRuleException(siteID int, ruleID, clientAppTypes, cl
 	}
 
 	// Post form to Incapsula
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointExceptionConfigure)
+	reqURL := c.endpointURL(endpointExceptionConfigure)
 	resp, err := c.PostFormWithHeaders(reqURL, values, CreateSecurityRuleException)
 	if err != nil {
 		return nil, fmt.Errorf("Error configuring security rule exception rule_id (%s) for site_id (%d)", ruleID, siteID)
@@ -147,7 +147,7 @@ func (c *Client) EditSecurityRuleException(siteID int, ruleID, clientAppTypes, c
 	}
 
 	// Post form to Incapsula
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointExceptionConfigure)
+	reqURL := c.endpointURL(endpointExceptionConfigure)
 	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateSecurityRuleException)
 	if err != nil {
 		return nil, fmt.Errorf("Error configuring security rule exception rule_id (%s) for site_id (%d)", ruleID, siteID)
@@ -191,7 +191,7 @@ func (c *Client) ListSecurityRuleExceptions(siteID, ruleID string) (*SiteStatusR
 
 	// Post form to Incapsula
 	values := url.Values{"site_id": {siteID}}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointExceptionList)
+	reqURL := c.endpointURL(endpointExceptionList)
 	resp, err := c.PostFormWithHeaders(reqURL, values, ReadSecurityRuleException)
 	if err != nil {
 		return nil, fmt.Errorf("Error getting security rule exceptions for rule_id (%s) on siteID (%s): %s", ruleID, siteID, err)
@@ -246,7 +246,7 @@ func (c *Client) DeleteSecurityRuleException(siteID int, ruleID, whitelistID str
 	}
 
 	// Post form to Incapsula
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointExceptionConfigure)
+	reqURL := c.endpointURL(endpointExceptionConfigure)
 	resp, err := c.PostFormWithHeaders(reqURL, values, DeleteSecurityRuleException)
 	if err != nil {
 		return fmt.Errorf("Error deleting security rule exception whitelist_id (%s) for rule_id (%s) for site_id (%d)", whitelistID, ruleID, siteID)
@@ -0,0 +1,116 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+)
+
+// ExceptionKey is the natural key of a security rule exception: its comma separated params, as accepted by
+// AddSecurityRuleException. Unlike the exception id, it doesn't change when the exception is recreated.
+type ExceptionKey struct {
+	ClientAppTypes string
+	ClientApps     string
+	Countries      string
+	Continents     string
+	IPs            string
+	URLPatterns    string
+	URLs           string
+	UserAgents     string
+	Parameters     string
+}
+
+// FindWafException finds the exception of a WAF or ACL rule of a site matching a natural key. The order of the
+// values in the key doesn't matter. It returns nil when no exception matches, and an error when several exceptions do.
+func (c *Client) FindWafException(siteID int, ruleID string, key ExceptionKey) (*SecurityRuleException, error) {
+	log.Printf("[INFO] Finding Incapsula security rule exception by natural key for rule_id (%s) on site_id (%d)\n", ruleID, siteID)
+
+	siteStatusResponse, err := c.ListSecurityRuleExceptions(strconv.Itoa(siteID), ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	return findSecurityRuleException(siteStatusResponse, ruleID, key)
+}
+
+// findSecurityRuleException finds the exception of a rule in a site status matching a natural key
+func findSecurityRuleException(siteStatusResponse *SiteStatusResponse, ruleID string, key ExceptionKey) (*SecurityRuleException, error) {
+	var exceptions []SecurityRuleException
+	for _, rule := range siteStatusResponse.Security.Acls.Rules {
+		if rule.ID == ruleID {
+			exceptions = append(exceptions, rule.Exceptions...)
+		}
+	}
+	for _, rule := range siteStatusResponse.Security.Waf.Rules {
+		if rule.ID == ruleID {
+			exceptions = append(exceptions, rule.Exceptions...)
+		}
+	}
+
+	canonicalKey := key.canonical()
+	var found *SecurityRuleException
+	for i, exception := range exceptions {
+		if exceptionKeyFromParams(exceptionParams(exception)).canonical() != canonicalKey {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("Error - several exceptions of rule_id (%s) match the natural key (ids %d and %d)", ruleID, found.ID, exception.ID)
+		}
+		found = &exceptions[i]
+	}
+
+	return found, nil
+}
+
+// exceptionKeyFromParams converts the params returned by exceptionParams to a natural key
+func exceptionKeyFromParams(params map[string]string) ExceptionKey {
+	return ExceptionKey{
+		ClientAppTypes: params["client_app_types"],
+		ClientApps:     params["client_apps"],
+		Countries:      params["countries"],
+		Continents:     params["continents"],
+		IPs:            params["ips"],
+		URLPatterns:    params["url_patterns"],
+		URLs:           params["urls"],
+		UserAgents:     params["user_agents"],
+		Parameters:     params["parameters"],
+	}
+}
+
+// canonical returns the key with the values of each param sorted, so that equivalent keys are equal. The URLs are
+// kept paired with their patterns.
+func (k ExceptionKey) canonical() string {
+	urls := splitExceptionKeyValues(k.URLs)
+	urlPatterns := splitExceptionKeyValues(k.URLPatterns)
+	patternedURLs := make([]string, 0, len(urls))
+	for i, u := range urls {
+		pattern := ""
+		if i < len(urlPatterns) {
+			pattern = urlPatterns[i]
+		}
+		patternedURLs = append(patternedURLs, pattern+" "+u)
+	}
+
+	params := []string{
+		"client_app_types=" + joinSorted(splitExceptionKeyValues(k.ClientAppTypes)),
+		"client_apps=" + joinSorted(splitExceptionKeyValues(k.ClientApps)),
+		"countries=" + joinSorted(splitExceptionKeyValues(k.Countries)),
+		"continents=" + joinSorted(splitExceptionKeyValues(k.Continents)),
+		"ips=" + joinSorted(splitExceptionKeyValues(k.IPs)),
+		"urls=" + joinSorted(patternedURLs),
+		"user_agents=" + joinSorted(splitExceptionKeyValues(k.UserAgents)),
+		"parameters=" + joinSorted(splitExceptionKeyValues(k.Parameters)),
+	}
+	return strings.Join(params, ";")
+}
+
+func splitExceptionKeyValues(values string) []string {
+	split := make([]string, 0)
+	for _, value := range strings.Split(values, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			split = append(split, value)
+		}
+	}
+	return split
+}
@@ -0,0 +1,79 @@
+package incapsula
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const securityRuleExceptionKeyTestSiteStatus = `{"site_id":42,"res":0,"security":{"waf":{"rules":[
+	{"id":"api.threats.sql_injection","exceptions":[
+		{"id":7,"values":[
+			{"id":"api.rule_exception_type.url","urls":[{"value":"/admin","pattern":"PREFIX"},{"value":"/login","pattern":"EQUALS"}]},
+			{"id":"api.rule_exception_type.client_ip","ips":["5.6.7.8","1.2.3.4"]}]},
+		{"id":8,"values":[
+			{"id":"api.rule_exception_type.client_ip","ips":["1.2.3.4"]}]}]}]}}}`
+
+////////////////////////////////////////////////////////////////
+// FindWafException Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientFindWafExceptionAfterIDChange(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(securityRuleExceptionKeyTestSiteStatus))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	// The exception was created with id 3 and recreated with id 7, with its values in another order
+	key := ExceptionKey{IPs: "1.2.3.4, 5.6.7.8", URLs: "/login,/admin", URLPatterns: "EQUALS,PREFIX"}
+	exception, err := client.FindWafException(42, sqlInjectionExceptionRuleID, key)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if exception == nil || exception.ID != 7 {
+		t.Errorf("Expected to find exception 7, got: %+v", exception)
+	}
+}
+
+func TestClientFindWafExceptionURLPatternMismatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(securityRuleExceptionKeyTestSiteStatus))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	// Same URLs and patterns, but paired the other way around
+	key := ExceptionKey{IPs: "1.2.3.4,5.6.7.8", URLs: "/login,/admin", URLPatterns: "PREFIX,EQUALS"}
+	exception, err := client.FindWafException(42, sqlInjectionExceptionRuleID, key)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if exception != nil {
+		t.Errorf("Should not have found an exception, got: %+v", exception)
+	}
+}
+
+func TestFindSecurityRuleExceptionAmbiguous(t *testing.T) {
+	siteStatusResponse := &SiteStatusResponse{}
+	siteStatusResponse.Security.Waf.Rules = []WAFRule{{
+		ID: ddosExceptionRuleID,
+		Exceptions: []SecurityRuleException{
+			{ID: 1, Values: []SecurityRuleExceptionValue{{ID: exceptionTypeIp, Ips: []string{"1.2.3.4"}}}},
+			{ID: 2, Values: []SecurityRuleExceptionValue{{ID: exceptionTypeIp, Ips: []string{"1.2.3.4"}}}},
+		},
+	}}
+
+	_, err := findSecurityRuleException(siteStatusResponse, ddosExceptionRuleID, ExceptionKey{IPs: "1.2.3.4"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error - several exceptions of rule_id (api.threats.ddos) match the natural key") {
+		t.Errorf("Should have received an ambiguous key error, got: %s", err)
+	}
+}
@@ -0,0 +1,220 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Endpoints (unexported consts)
+const endpointACLRuleConfigure = "sites/configure/acl"
+
+// SecurityRulesExport is the portable representation of the WAF and ACL rules of a site.
+// Exception ids are account specific, so they are omitted from the export and ignored on import.
+type SecurityRulesExport struct {
+	WAFRules []WAFRule `json:"waf_rules"`
+	ACLRules []ACLRule `json:"acl_rules"`
+}
+
+// ExportSecurityRules exports the WAF rule actions, ACL entries and their exceptions of a site as JSON
+func (c *Client) ExportSecurityRules(siteID int) ([]byte, error) {
+	log.Printf("[INFO] Exporting Incapsula security rules for site_id: %d\n", siteID)
+
+	siteStatusResponse, err := c.SiteStatusFields(siteID, []string{"security"})
+	if err != nil {
+		return nil, fmt.Errorf("Error exporting security rules for site_id %d: %s", siteID, err)
+	}
+
+	export := SecurityRulesExport{
+		WAFRules: make([]WAFRule, 0, len(siteStatusResponse.Security.Waf.Rules)),
+		ACLRules: make([]ACLRule, 0, len(siteStatusResponse.Security.Acls.Rules)),
+	}
+	for _, rule := range siteStatusResponse.Security.Waf.Rules {
+		rule.Exceptions = portableExceptions(rule.Exceptions)
+		export.WAFRules = append(export.WAFRules, rule)
+	}
+	for _, rule := range siteStatusResponse.Security.Acls.Rules {
+		rule.Exceptions = portableExceptions(rule.Exceptions)
+		export.ACLRules = append(export.ACLRules, rule)
+	}
+
+	return json.Marshal(export)
+}
+
+// ImportSecurityRules applies security rules exported by ExportSecurityRules to a site.
+// Exceptions are added as new exceptions, rules which can't be configured through the API are skipped.
+func (c *Client) ImportSecurityRules(siteID int, data []byte) error {
+	log.Printf("[INFO] Importing Incapsula security rules for site_id: %d\n", siteID)
+
+	var export SecurityRulesExport
+	err := json.Unmarshal(data, &export)
+	if err != nil {
+		return fmt.Errorf("Error parsing security rules to import for site_id %d: %s", siteID, err)
+	}
+
+	for _, rule := range export.WAFRules {
+		err = c.importWAFRule(siteID, rule)
+		if err != nil {
+			return err
+		}
+		err = c.importExceptions(siteID, rule.ID, rule.Exceptions)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, rule := range export.ACLRules {
+		err = c.configureACLRule(siteID, rule)
+		if err != nil {
+			return err
+		}
+		err = c.importExceptions(siteID, rule.ID, rule.Exceptions)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) importWAFRule(siteID int, rule WAFRule) error {
+	var err error
+	switch rule.ID {
+	case backdoorRuleID, crossSiteScriptingRuleID, illegalResourceAccessRuleID, remoteFileInclusionRuleID, sqlInjectionRuleID:
+		_, err = c.ConfigureWAFSecurityRule(siteID, rule.ID, rule.Action, "", "", "", "")
+	case ddosRuleID:
+		_, err = c.ConfigureWAFSecurityRule(siteID, rule.ID, "", rule.ActivationMode, strconv.Itoa(rule.DdosTrafficThreshold), "", "")
+	case botAccessControlRuleID:
+		_, err = c.ConfigureWAFSecurityRule(siteID, rule.ID, "", "", "", strconv.FormatBool(rule.BlockBadBots), strconv.FormatBool(rule.ChallengeSuspectedBots))
+	default:
+		log.Printf("[WARN] Skipping import of WAF rule_id (%s) for site_id %d, it can't be configured through the API\n", rule.ID, siteID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error importing WAF rule_id (%s) for site_id %d: %s", rule.ID, siteID, err)
+	}
+	return nil
+}
+
+func (c *Client) importExceptions(siteID int, ruleID string, exceptions []SecurityRuleException) error {
+	for _, exception := range exceptions {
+		params := exceptionParams(exception)
+		_, err := c.AddSecurityRuleException(siteID, ruleID, params["client_app_types"], params["client_apps"], params["countries"], params["continents"], params["ips"], params["url_patterns"], params["urls"], params["user_agents"], params["parameters"])
+		if err != nil {
+			return fmt.Errorf("Error importing exception of rule_id (%s) for site_id %d: %s", ruleID, siteID, err)
+		}
+	}
+	return nil
+}
+
+// configureACLRule sets the entries of an ACL rule
+func (c *Client) configureACLRule(siteID int, rule ACLRule) error {
+	log.Printf("[INFO] Configuring Incapsula ACL rule id (%s) for site id (%d)\n", rule.ID, siteID)
+
+	values := url.Values{
+		"site_id": {strconv.Itoa(siteID)},
+		"rule_id": {rule.ID},
+	}
+	urls, urlPatterns := splitSecurityRuleURLs(rule.Urls)
+	for param, value := range map[string][]string{
+		"ips":          rule.Ips,
+		"urls":         urls,
+		"url_patterns": urlPatterns,
+		"countries":    rule.Geo.Countries,
+		"continents":   rule.Geo.Continents,
+	} {
+		if len(value) > 0 {
+			values.Set(param, strings.Join(value, ","))
+		}
+	}
+
+	// Post form to Incapsula
+	reqURL := c.endpointURL(endpointACLRuleConfigure)
+	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateSecurityRule)
+	if err != nil {
+		return fmt.Errorf("Error configuring ACL rule rule_id (%s) for site_id (%d): %s", rule.ID, siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula configure ACL rule JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var siteStatusResponse SiteStatusResponse
+	err = json.Unmarshal([]byte(responseBody), &siteStatusResponse)
+	if err != nil {
+		return fmt.Errorf("Error parsing configure ACL rule JSON response for rule_id (%s) and site_id (%d)", rule.ID, siteID)
+	}
+
+	var resString string
+
+	if resNumber, ok := siteStatusResponse.Res.(float64); ok {
+		resString = fmt.Sprintf("%d", int(resNumber))
+	} else {
+		resString, _ = siteStatusResponse.Res.(string)
+	}
+
+	// Look at the response status code from Incapsula
+	if resString != "0" {
+		return newIncapsulaError(resString, siteStatusResponse.DebugInfo, "Error from Incapsula service when configuring ACL rule for rule_id (%s) and site_id (%d): %s", rule.ID, siteID, string(responseBody))
+	}
+
+	return nil
+}
+
+// portableExceptions returns the exceptions without their account specific ids
+func portableExceptions(exceptions []SecurityRuleException) []SecurityRuleException {
+	if len(exceptions) == 0 {
+		return nil
+	}
+	portable := make([]SecurityRuleException, 0, len(exceptions))
+	for _, exception := range exceptions {
+		exception.ID = 0
+		portable = append(portable, exception)
+	}
+	return portable
+}
+
+// exceptionParams converts the values of an exception to the comma separated params of AddSecurityRuleException
+func exceptionParams(exception SecurityRuleException) map[string]string {
+	params := make(map[string]string)
+	for _, value := range exception.Values {
+		switch value.ID {
+		case exceptionTypeUrl:
+			urls, urlPatterns := splitSecurityRuleURLs(value.Urls)
+			params["urls"] = strings.Join(urls, ",")
+			params["url_patterns"] = strings.Join(urlPatterns, ",")
+		case exceptionTypeCountry:
+			params["countries"] = strings.Join(value.Geo.Countries, ",")
+		case exceptionTypeContinent:
+			params["continents"] = strings.Join(value.Geo.Continents, ",")
+		case exceptionTypeClientAppId:
+			params["client_apps"] = strings.Join(value.ClientApps, ",")
+		case exceptionTypeClientAppType:
+			params["client_app_types"] = strings.Join(value.ClientAppTypes, ",")
+		case exceptionTypeHttpParameter:
+			params["parameters"] = strings.Join(value.Parameters, ",")
+		case exceptionTypeIp:
+			params["ips"] = strings.Join(value.Ips, ",")
+		case exceptionTypeUserAgent:
+			params["user_agents"] = strings.Join(value.UserAgents, ",")
+		}
+	}
+	return params
+}
+
+func splitSecurityRuleURLs(securityRuleURLs []SecurityRuleURL) ([]string, []string) {
+	urls := make([]string, 0, len(securityRuleURLs))
+	urlPatterns := make([]string, 0, len(securityRuleURLs))
+	for _, securityRuleURL := range securityRuleURLs {
+		urls = append(urls, securityRuleURL.Value)
+		urlPatterns = append(urlPatterns, securityRuleURL.Pattern)
+	}
+	return urls, urlPatterns
+}
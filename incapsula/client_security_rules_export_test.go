@@ -0,0 +1,95 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// ExportSecurityRules / ImportSecurityRules Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientExportImportSecurityRulesRoundTrip(t *testing.T) {
+	sourceServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteStatus) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteStatus, req.URL.String())
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0,"security":{
+			"waf":{"rules":[
+				{"id":"api.threats.sql_injection","action":"api.threats.action.block_request","exceptions":[
+					{"id":123456,"values":[{"id":"api.rule_exception_type.client_ip","ips":["1.2.3.4"]},{"id":"api.rule_exception_type.url","urls":[{"value":"/login","pattern":"EQUALS"}]}]}]},
+				{"id":"api.threats.ddos","activation_mode":"api.threats.ddos.activation_mode.auto","ddos_traffic_threshold":1000}]},
+			"acls":{"rules":[
+				{"id":"api.acl.blacklisted_countries","geo":{"countries":["AQ"]},"exceptions":[
+					{"id":654321,"values":[{"id":"api.rule_exception_type.client_ip","ips":["5.6.7.8"]}]}]}]}}}`))
+	}))
+	defer sourceServer.Close()
+
+	sourceClient := &Client{config: &Config{APIID: "foo", APIKey: "bar", BaseURL: sourceServer.URL}, httpClient: &http.Client{}}
+	data, err := sourceClient.ExportSecurityRules(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if strings.Contains(string(data), "123456") || strings.Contains(string(data), "654321") {
+		t.Errorf("Should not have exported the account specific exception ids, got: %s", string(data))
+	}
+
+	requests := make([]string, 0)
+	targetServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		if req.PostForm.Get("site_id") != "84" {
+			t.Errorf("Expected site_id to be 84, got: %s", req.PostForm.Get("site_id"))
+		}
+		if _, ok := req.PostForm["whitelist_id"]; ok {
+			t.Errorf("Should not have sent a whitelist_id on import")
+		}
+		params := make([]string, 0)
+		for param := range req.PostForm {
+			if param != "site_id" {
+				params = append(params, fmt.Sprintf("%s=%s", param, req.PostForm.Get(param)))
+			}
+		}
+		sort.Strings(params)
+		requests = append(requests, fmt.Sprintf("%s %s", req.URL.Path, strings.Join(params, "&")))
+		if req.URL.Path == fmt.Sprintf("/%s", endpointExceptionConfigure) {
+			rw.Write([]byte(`{"res":"0","exception_id":"1"}`))
+			return
+		}
+		rw.Write([]byte(`{"site_id":84,"res":0}`))
+	}))
+	defer targetServer.Close()
+
+	targetClient := &Client{config: &Config{APIID: "foo", APIKey: "bar", BaseURL: targetServer.URL}, httpClient: &http.Client{}}
+	err = targetClient.ImportSecurityRules(84, data)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	expected := []string{
+		"/sites/configure/security rule_id=api.threats.sql_injection&security_rule_action=api.threats.action.block_request",
+		"/sites/configure/whitelists exception_id_only=true&ips=1.2.3.4&rule_id=api.threats.sql_injection&url_patterns=EQUALS&urls=/login",
+		"/sites/configure/security activation_mode=api.threats.ddos.activation_mode.auto&ddos_traffic_threshold=1000&rule_id=api.threats.ddos",
+		"/sites/configure/acl countries=AQ&rule_id=api.acl.blacklisted_countries",
+		"/sites/configure/whitelists exception_id_only=true&ips=5.6.7.8&rule_id=api.acl.blacklisted_countries",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("Unexpected import requests\nexpected: %v\ngot:      %v", expected, requests)
+	}
+}
+
+func TestClientImportSecurityRulesBadJSON(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.ImportSecurityRules(42, []byte(`{`))
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing security rules to import for site_id 42") {
+		t.Errorf("Should have received a parse error, got: %s", err)
+	}
+}
@@ -97,12 +97,12 @@ func (c *Client) CreateSiemConnection(connection *SiemConnection) (*SiemConnecti
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to produce JSON from SiemConnection: %s", err)
 	}
-	reqURL := fmt.Sprintf("%s/%s/", c.config.BaseURLAPI, endpointSiemConnection)
+	reqURL := fmt.Sprintf("%s/", c.endpointURL(endpointSiemConnection))
 	return siemConnectionRequestWithResponse(c, CreateSiemConnection, http.MethodPost, reqURL, connectionJSON, connection.Data[0].AssetID, 201)
 }
 
 func (c *Client) ReadSiemConnection(ID string, accountId string) (*SiemConnection, *int, error) {
-	reqURL := fmt.Sprintf("%s/%s/%s", c.config.BaseURLAPI, endpointSiemConnection, ID)
+	reqURL := fmt.Sprintf("%s/%s", c.endpointURL(endpointSiemConnection), ID)
 	return siemConnectionRequestWithResponse(c, ReadSiemConnection, http.MethodGet, reqURL, nil, accountId, 200)
 }
 
@@ -111,12 +111,12 @@ func (c *Client) UpdateSiemConnection(siemConnection *SiemConnection) (*SiemConn
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to produce JSON from SiemConnectionWithID: %s", err)
 	}
-	reqURL := fmt.Sprintf("%s/%s/%s", c.config.BaseURLAPI, endpointSiemConnection, siemConnection.Data[0].ID)
+	reqURL := fmt.Sprintf("%s/%s", c.endpointURL(endpointSiemConnection), siemConnection.Data[0].ID)
 	return siemConnectionRequestWithResponse(c, UpdateSiemConnection, http.MethodPut, reqURL, siemConnectionJSON, siemConnection.Data[0].AssetID, 200)
 }
 
 func (c *Client) DeleteSiemConnection(ID string, accountId string) (*int, error) {
-	reqURL := fmt.Sprintf("%s/%s/%s", c.config.BaseURLAPI, endpointSiemConnection, ID)
+	reqURL := fmt.Sprintf("%s/%s", c.endpointURL(endpointSiemConnection), ID)
 	_, _, statusCode, err := siemConnectionRequest(c, DeleteSiemConnection, http.MethodDelete, reqURL, nil, accountId, 200)
 	return statusCode, err
 }
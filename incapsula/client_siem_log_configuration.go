@@ -30,12 +30,12 @@ func (c *Client) CreateSiemLogConfiguration(siemLogConfiguration *SiemLogConfigu
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to produce JSON from SiemLogConfiguration: %s", err)
 	}
-	reqURL := fmt.Sprintf("%s/%s/", c.config.BaseURLAPI, endpointSiemLogConfiguration)
+	reqURL := fmt.Sprintf("%s/", c.endpointURL(endpointSiemLogConfiguration))
 	return siemLogConfigurationRequestWithResponse(c, CreateSiemLogConfiguration, http.MethodPost, reqURL, logConfigurationJSON, siemLogConfiguration.Data[0].AssetID, 201)
 }
 
 func (c *Client) ReadSiemLogConfiguration(ID string, accountId string) (*SiemLogConfiguration, *int, error) {
-	reqURL := fmt.Sprintf("%s/%s/%s", c.config.BaseURLAPI, endpointSiemLogConfiguration, ID)
+	reqURL := fmt.Sprintf("%s/%s", c.endpointURL(endpointSiemLogConfiguration), ID)
 	return siemLogConfigurationRequestWithResponse(c, ReadSiemLogConfiguration, http.MethodGet, reqURL, nil, accountId, 200)
 }
 
@@ -44,12 +44,12 @@ func (c *Client) UpdateSiemLogConfiguration(siemLogConfiguration *SiemLogConfigu
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to produce JSON from SiemLogConfigurationWithID: %s", err)
 	}
-	reqURL := fmt.Sprintf("%s/%s/%s", c.config.BaseURLAPI, endpointSiemLogConfiguration, siemLogConfiguration.Data[0].ID)
+	reqURL := fmt.Sprintf("%s/%s", c.endpointURL(endpointSiemLogConfiguration), siemLogConfiguration.Data[0].ID)
 	return siemLogConfigurationRequestWithResponse(c, UpdateSiemLogConfiguration, http.MethodPut, reqURL, siemLogConfigurationJSON, siemLogConfiguration.Data[0].AssetID, 200)
 }
 
 func (c *Client) DeleteSiemLogConfiguration(ID string, accountId string) (*int, error) {
-	reqURL := fmt.Sprintf("%s/%s/%s", c.config.BaseURLAPI, endpointSiemLogConfiguration, ID)
+	reqURL := fmt.Sprintf("%s/%s", c.endpointURL(endpointSiemLogConfiguration), ID)
 	_, _, responseStatusCode, err := siemLogConfigurationRequest(c, DeleteSiemLogConfiguration, http.MethodDelete, reqURL, nil, accountId, 200)
 	return responseStatusCode, err
 }
@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 const endpointSiteAdd = "sites/add"
@@ -14,16 +15,21 @@ const endpointSiteStatus = "sites/status"
 const endpointSiteUpdate = "sites/configure"
 const endpointSiteDelete = "sites/delete"
 
+// Sections of the site status that can be requested with SiteStatusFields
+var siteStatusSections = []string{"dns", "original_dns", "security", "sealLocation", "ssl", "siteDualFactorSettings", "login_protect", "performance_configuration"}
+
 // SiteAddResponse contains the relevant site information when adding an Incapsula managed site
 type SiteAddResponse struct {
-	SiteID int `json:"site_id"`
-	Res    int `json:"res"`
+	SiteID    int       `json:"site_id"`
+	Res       int       `json:"res"`
+	DebugInfo DebugInfo `json:"debug_info"`
 }
 
 // SiteUpdateResponse contains the relevant site information when updating an Incapsula managed site
 type SiteUpdateResponse struct {
-	SiteID int `json:"site_id"`
-	Res    int `json:"res"`
+	SiteID    int       `json:"site_id"`
+	Res       int       `json:"res"`
+	DebugInfo DebugInfo `json:"debug_info"`
 }
 
 // SiteStatusDNSValidationData is DNS related validation data (HTML is a map[string][]string)
@@ -33,6 +39,62 @@ type SiteStatusDNSValidationData struct {
 	SetDataTo     []string `json:"set_data_to"`
 }
 
+// SecurityRuleURL is a URL and its match pattern, as used by ACL rules and security rule exceptions
+type SecurityRuleURL struct {
+	Value   string `json:"value,omitempty"`
+	Pattern string `json:"pattern,omitempty"`
+}
+
+// SecurityRuleGeo contains the countries and continents of ACL rules and security rule exceptions
+type SecurityRuleGeo struct {
+	Countries  []string `json:"countries,omitempty"`
+	Continents []string `json:"continents,omitempty"`
+}
+
+// SecurityRuleExceptionValue is a single condition of a security rule exception, its ID is the exception type
+type SecurityRuleExceptionValue struct {
+	ID             string            `json:"id,omitempty"`
+	Name           string            `json:"name,omitempty"`
+	Ips            []string          `json:"ips,omitempty"`
+	Urls           []SecurityRuleURL `json:"urls,omitempty"`
+	Geo            SecurityRuleGeo   `json:"geo,omitempty"`
+	ClientApps     []string          `json:"client_apps,omitempty"`
+	ClientAppTypes []string          `json:"client_app_types,omitempty"`
+	Parameters     []string          `json:"parameters,omitempty"`
+	UserAgents     []string          `json:"user_agents,omitempty"`
+}
+
+// SecurityRuleException is an exception (whitelist) of a WAF or ACL rule
+type SecurityRuleException struct {
+	Values []SecurityRuleExceptionValue `json:"values,omitempty"`
+	ID     int                          `json:"id,omitempty"`
+}
+
+// WAFRule contains the settings of a site WAF rule
+type WAFRule struct {
+	Action                 string                  `json:"action,omitempty"`
+	ActionText             string                  `json:"action_text,omitempty"`
+	ID                     string                  `json:"id"`
+	Name                   string                  `json:"name"`
+	BlockBadBots           bool                    `json:"block_bad_bots,omitempty"`
+	ChallengeSuspectedBots bool                    `json:"challenge_suspected_bots,omitempty"`
+	BadBotsAllowlist       []string                `json:"bad_bots_allowlist,omitempty"`
+	ActivationMode         string                  `json:"activation_mode,omitempty"`
+	ActivationModeText     string                  `json:"activation_mode_text,omitempty"`
+	DdosTrafficThreshold   int                     `json:"ddos_traffic_threshold,omitempty"`
+	Exceptions             []SecurityRuleException `json:"exceptions,omitempty"`
+}
+
+// ACLRule contains the settings of a site ACL rule
+type ACLRule struct {
+	Ips        []string                `json:"ips,omitempty"`
+	ID         string                  `json:"id"`
+	Name       string                  `json:"name"`
+	Geo        SecurityRuleGeo         `json:"geo,omitempty"`
+	Urls       []SecurityRuleURL       `json:"urls,omitempty"`
+	Exceptions []SecurityRuleException `json:"exceptions,omitempty"`
+}
+
 // SiteStatusResponse contains managed site information
 type SiteStatusResponse struct {
 	SiteID               int      `json:"site_id"`
@@ -58,83 +120,25 @@ type SiteStatusResponse struct {
 	Active                               string        `json:"active"`
 	RestrictedCnameReuse                 bool          `json:"restricted_cname_reuse,omitempty"`
 	SupportAllTLSVersions                bool          `json:"support_all_tls_versions"`
+	MinTLSVersion                        string        `json:"min_tls_version"`
+	MaxTLSVersion                        string        `json:"max_tls_version"`
 	UseWildcardSanInsteadOfFullDomainSan bool          `json:"use_wildcard_san_instead_of_full_domain_san"`
 	AddNakedDomainSan                    bool          `json:"add_naked_domain_san"`
-	AdditionalErrors                     []interface{} `json:"additionalErrors"`
+	AdditionalErrors                     []SiteError   `json:"additionalErrors"`
 	DisplayName                          string        `json:"display_name"`
 	Security                             struct {
 		Waf struct {
-			Rules []struct {
-				Action                 string `json:"action,omitempty"`
-				ActionText             string `json:"action_text,omitempty"`
-				ID                     string `json:"id"`
-				Name                   string `json:"name"`
-				BlockBadBots           bool   `json:"block_bad_bots,omitempty"`
-				ChallengeSuspectedBots bool   `json:"challenge_suspected_bots,omitempty"`
-				ActivationMode         string `json:"activation_mode,omitempty"`
-				ActivationModeText     string `json:"activation_mode_text,omitempty"`
-				DdosTrafficThreshold   int    `json:"ddos_traffic_threshold,omitempty"`
-				Exceptions             []struct {
-					Values []struct {
-						ID   string   `json:"id,omitempty"`
-						Name string   `json:"name,omitempty"`
-						Ips  []string `json:"ips,omitempty"`
-						Urls []struct {
-							Value   string `json:"value,omitempty"`
-							Pattern string `json:"pattern,omitempty"`
-						} `json:"urls,omitempty"`
-						Geo struct {
-							Countries  []string `json:"countries,omitempty"`
-							Continents []string `json:"continents,omitempty"`
-						} `json:"geo,omitempty"`
-						ClientApps     []string `json:"client_apps,omitempty"`
-						ClientAppTypes []string `json:"client_app_types,omitempty"`
-						Parameters     []string `json:"parameters,omitempty"`
-						UserAgents     []string `json:"user_agents,omitempty"`
-					} `json:"values,omitempty"`
-					ID int `json:"id,omitempty"`
-				} `json:"exceptions,omitempty"`
-			} `json:"rules"`
+			Rules []WAFRule `json:"rules"`
 		} `json:"waf"`
 		Acls struct {
-			Rules []struct {
-				Ips  []string `json:"ips,omitempty"`
-				ID   string   `json:"id"`
-				Name string   `json:"name"`
-				Geo  struct {
-					Countries  []string `json:"countries"`
-					Continents []string `json:"continents"`
-				} `json:"geo,omitempty"`
-				Urls []struct {
-					Value   string `json:"value"`
-					Pattern string `json:"pattern"`
-				} `json:"urls,omitempty"`
-				Exceptions []struct {
-					Values []struct {
-						ID   string   `json:"id"`
-						Name string   `json:"name"`
-						Ips  []string `json:"ips,omitempty"`
-						Urls []struct {
-							Value   string `json:"value"`
-							Pattern string `json:"pattern"`
-						} `json:"urls,omitempty"`
-						Geo struct {
-							Countries  []string `json:"countries"`
-							Continents []string `json:"continents"`
-						} `json:"geo,omitempty"`
-						ClientApps     []string `json:"client_apps,omitempty"`
-						ClientAppTypes []string `json:"client_app_types,omitempty"`
-						Parameters     []string `json:"parameters,omitempty"`
-						UserAgents     []string `json:"user_agents,omitempty"`
-					} `json:"values"`
-					ID int `json:"id"`
-				} `json:"exceptions"`
-			} `json:"rules"`
+			Rules []ACLRule `json:"rules"`
 		} `json:"acls"`
 	} `json:"security"`
 	SealLocation struct {
-		ID   string `json:"id"`
-		Name string `json:"name"`
+		ID       string `json:"id"`
+		Name     string `json:"name"`
+		Type     string `json:"type,omitempty"`
+		Position string `json:"position,omitempty"`
 	} `json:"sealLocation"`
 	Ssl struct {
 		OriginServer struct {
@@ -142,7 +146,9 @@ type SiteStatusResponse struct {
 			DetectionStatus string `json:"detectionStatus"`
 		} `json:"origin_server"`
 		CustomCertificate struct {
-			Active bool `json:"active"`
+			Active         bool   `json:"active"`
+			ExpirationDate int64  `json:"expirationDate"`
+			Issuer         string `json:"issuer"`
 		} `json:"custom_certificate"`
 		GeneratedCertificate struct {
 			Ca               string      `json:"ca"`
@@ -161,6 +167,7 @@ type SiteStatusResponse struct {
 		ShouldSuggestApplicatons     bool          `json:"shouldSuggestApplicatons"`
 		AllowedMedia                 []string      `json:"allowedMedia"`
 		ShouldSendLoginNotifications bool          `json:"shouldSendLoginNotifications"`
+		LoginNotificationRecipients  []string      `json:"loginNotificationRecipients"`
 		Version                      int           `json:"version"`
 	} `json:"siteDualFactorSettings"`
 	LoginProtect struct {
@@ -198,18 +205,23 @@ type SiteStatusResponse struct {
 		Cache300X                 bool          `json:"cache300x"`
 		CacheHeaders              []interface{} `json:"cache_headers"`
 	} `json:"performance_configuration"`
-	ExtendedDdos int         `json:"extended_ddos"`
-	ExceptionID  string      `json:"exception_id,omitempty"`
-	LogLevel     string      `json:"log_level,omitempty"`
-	Res          interface{} `json:"res"`
-	ResMessage   string      `json:"res_message"`
-	DebugInfo    struct {
-		IDInfo string `json:"id-info"`
-	} `json:"debug_info"`
+	ExtendedDdos int `json:"extended_ddos"`
+	// ExceptionID is only returned by the security rule exceptions API (see SecurityRuleExceptionCreateResponse), it's
+	// the id of the security rule exception that was added and isn't related to the site validation. Exceptions are
+	// removed with DeleteSecurityRuleException.
+	ExceptionID string      `json:"exception_id,omitempty"`
+	LogLevel    string      `json:"log_level,omitempty"`
+	LogFormat   string      `json:"log_format,omitempty"`
+	Res         interface{} `json:"res"`
+	ResMessage  string      `json:"res_message"`
+	DebugInfo   DebugInfo   `json:"debug_info"`
 }
 
 // AddSite adds a site to be managed by Incapsula
 func (c *Client) AddSite(domain, refID, sendSiteSetupEmails, siteIP, forceSSL string, accountID int, nakedDomainSan bool, wildcarSan bool, logsAccountId string) (*SiteAddResponse, error) {
+	if accountID == 0 {
+		accountID = c.accountID
+	}
 	log.Printf("[INFO] Adding Incapsula site for domain: %s (account ID %d)\n", domain, accountID)
 
 	values := url.Values{
@@ -227,7 +239,7 @@ func (c *Client) AddSite(domain, refID, sendSiteSetupEmails, siteIP, forceSSL st
 		values["account_id"][0] = fmt.Sprint(accountID)
 	}
 
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointSiteAdd)
+	reqURL := c.endpointURL(endpointSiteAdd)
 	resp, err := c.PostFormWithHeaders(reqURL, values, CreateSite)
 	if err != nil {
 		return nil, fmt.Errorf("Error adding site for domain %s: %s", domain, err)
@@ -249,7 +261,7 @@ func (c *Client) AddSite(domain, refID, sendSiteSetupEmails, siteIP, forceSSL st
 
 	// Look at the response status code from Incapsula
 	if siteAddResponse.Res != 0 {
-		return nil, fmt.Errorf("Error from Incapsula service when adding site for domain %s: %s", domain, string(responseBody))
+		return nil, newIncapsulaError(strconv.Itoa(siteAddResponse.Res), siteAddResponse.DebugInfo, "Error from Incapsula service when adding site for domain %s: %s", domain, string(responseBody))
 	}
 
 	return &siteAddResponse, nil
@@ -259,27 +271,43 @@ func (c *Client) AddSite(domain, refID, sendSiteSetupEmails, siteIP, forceSSL st
 func (c *Client) SiteStatus(domain string, siteID int) (*SiteStatusResponse, error) {
 	log.Printf("[INFO] Getting Incapsula site status for domain: %s (site id: %d)\n", domain, siteID)
 
-	// Post form to Incapsula
+	return c.siteStatus(domain, siteID, url.Values{"site_id": {strconv.Itoa(siteID)}})
+}
+
+// SiteStatusFields gets the Incapsula managed site's status with only the requested sections (e.g. security, ssl) on
+// top of the basic site fields, which is much lighter than the full status. Sections that aren't requested are empty.
+func (c *Client) SiteStatusFields(siteID int, fields []string) (*SiteStatusResponse, error) {
+	log.Printf("[INFO] Getting Incapsula site status fields %v for site id: %d\n", fields, siteID)
+
+	for _, field := range fields {
+		if !contains(siteStatusSections, field) {
+			return nil, fmt.Errorf("Error - invalid site status field (%s), must be one of %v", field, siteStatusSections)
+		}
+	}
+
 	values := url.Values{"site_id": {strconv.Itoa(siteID)}}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointSiteStatus)
-	resp, err := c.PostFormWithHeaders(reqURL, values, ReadSite)
-	if err != nil {
-		return nil, fmt.Errorf("Error getting site status for domain %s (site id: %d): %s", domain, siteID, err)
+	if len(fields) > 0 {
+		values.Set("fields", strings.Join(fields, ","))
 	}
 
-	// Read the body
-	defer resp.Body.Close()
-	responseBody, err := ioutil.ReadAll(resp.Body)
+	return c.siteStatus("", siteID, values)
+}
+
+func (c *Client) siteStatus(domain string, siteID int, values url.Values) (*SiteStatusResponse, error) {
+	// Post form to Incapsula
+	reqURL := c.endpointURL(endpointSiteStatus)
+	var siteStatusResponse SiteStatusResponse
+	responseBody, err := c.postFormAndDecode(reqURL, values, ReadSite, &siteStatusResponse)
 
 	// Dump JSON
 	log.Printf("[DEBUG] Incapsula site status JSON response: %s\n", string(responseBody))
 
-	// Parse the JSON
-	var siteStatusResponse SiteStatusResponse
-	err = json.Unmarshal([]byte(responseBody), &siteStatusResponse)
-	if err != nil {
+	if _, invalidJSON := err.(*jsonDecodeError); invalidJSON {
 		return nil, fmt.Errorf("Error parsing site status JSON response for domain %s (site id: %d): %s", domain, siteID, err)
 	}
+	if err != nil {
+		return nil, fmt.Errorf("Error getting site status for domain %s (site id: %d): %s", domain, siteID, err)
+	}
 
 	var resString string
 
@@ -291,14 +319,20 @@ func (c *Client) SiteStatus(domain string, siteID int) (*SiteStatusResponse, err
 
 	// Look at the response status code from Incapsula
 	if resString != "0" {
-		return &siteStatusResponse, fmt.Errorf("Error from Incapsula service when getting site status for domain %s (site id: %d): %s", domain, siteID, string(responseBody))
+		return &siteStatusResponse, newIncapsulaError(resString, siteStatusResponse.DebugInfo, "Error from Incapsula service when getting site status for domain %s (site id: %d): %s", domain, siteID, string(responseBody))
 	}
 
 	return &siteStatusResponse, nil
 }
 
-// UpdateSite will update the specific param/value on the site resource
+// UpdateSite will update the specific param/value on the site resource. The param and value aren't validated, see
+// ValidateSiteConfigParam to check them against ListSiteConfigParams first.
 func (c *Client) UpdateSite(siteID, param, value string) (*SiteUpdateResponse, error) {
+	return c.updateSite(siteID, param, value, nil)
+}
+
+// updateSite will update the specific param/value on the site resource, sending along any additional values the param requires
+func (c *Client) updateSite(siteID, param, value string, additionalValues url.Values) (*SiteUpdateResponse, error) {
 	log.Printf("[INFO] Updating Incapsula site for siteID: %s\n", siteID)
 
 	// Post form to Incapsula
@@ -307,7 +341,10 @@ func (c *Client) UpdateSite(siteID, param, value string) (*SiteUpdateResponse, e
 		"param":   {param},
 		"value":   {value},
 	}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointSiteUpdate)
+	for key, additionalValue := range additionalValues {
+		values[key] = additionalValue
+	}
+	reqURL := c.endpointURL(endpointSiteUpdate)
 	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateSite)
 	if err != nil {
 		return nil, fmt.Errorf("Error updating param (%s) with value (%s) on site_id: %s: %s", param, value, siteID, err)
@@ -329,7 +366,7 @@ func (c *Client) UpdateSite(siteID, param, value string) (*SiteUpdateResponse, e
 
 	// Look at the response status code from Incapsula
 	if siteUpdateResponse.Res != 0 {
-		return nil, fmt.Errorf("Error from Incapsula service when updating site for siteID %s: %s", siteID, string(responseBody))
+		return nil, newIncapsulaError(strconv.Itoa(siteUpdateResponse.Res), siteUpdateResponse.DebugInfo, "Error from Incapsula service when updating site for siteID %s: %s", siteID, string(responseBody))
 	}
 
 	return &siteUpdateResponse, nil
@@ -340,15 +377,16 @@ func (c *Client) DeleteSite(domain string, siteID int) error {
 	// Specifically shaded this struct, no need to share across funcs or export
 	// We only care about the response code and possibly the message
 	type SiteDeleteResponse struct {
-		Res        int    `json:"res"`
-		ResMessage string `json:"res_message"`
+		Res        int       `json:"res"`
+		ResMessage string    `json:"res_message"`
+		DebugInfo  DebugInfo `json:"debug_info"`
 	}
 
 	log.Printf("[INFO] Deleting Incapsula site for domain: %s (site id: %d)\n", domain, siteID)
 
 	// Post form to Incapsula
 	values := url.Values{"site_id": {strconv.Itoa(siteID)}}
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointSiteDelete)
+	reqURL := c.endpointURL(endpointSiteDelete)
 	resp, err := c.PostFormWithHeaders(reqURL, values, DeleteSite)
 	if err != nil {
 		return fmt.Errorf("Error deleting site for domain %s (site id: %d): %s", domain, siteID, err)
@@ -370,7 +408,7 @@ func (c *Client) DeleteSite(domain string, siteID int) error {
 
 	// Look at the response status code from Incapsula
 	if siteDeleteResponse.Res != 0 {
-		return fmt.Errorf("Error from Incapsula service when deleting site for domain %s (site id: %d): %s", domain, siteID, string(responseBody))
+		return newIncapsulaError(strconv.Itoa(siteDeleteResponse.Res), siteDeleteResponse.DebugInfo, "Error from Incapsula service when deleting site for domain %s (site id: %d): %s", domain, siteID, string(responseBody))
 	}
 
 	return nil
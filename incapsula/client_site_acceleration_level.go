@@ -0,0 +1,80 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+)
+
+// accelerationLevelRawByLevel maps the acceleration_level returned by the site status to the acceleration_level_raw
+// accepted by the site update. Only the advanced level is named differently, the API sets it as aggressive.
+var accelerationLevelRawByLevel = map[string]string{
+	"none":     "none",
+	"standard": "standard",
+	"advanced": "aggressive",
+}
+
+// AccelerationLevelToRaw returns the acceleration_level_raw of an acceleration_level, e.g. aggressive for advanced
+func AccelerationLevelToRaw(level string) (string, error) {
+	raw, ok := accelerationLevelRawByLevel[level]
+	if !ok {
+		return "", fmt.Errorf("Error - unknown acceleration level (%s), possible values: %v", level, accelerationLevels())
+	}
+	return raw, nil
+}
+
+// AccelerationLevelFromRaw returns the acceleration_level of an acceleration_level_raw, e.g. advanced for aggressive
+func AccelerationLevelFromRaw(raw string) (string, error) {
+	for level, levelRaw := range accelerationLevelRawByLevel {
+		if levelRaw == raw {
+			return level, nil
+		}
+	}
+	return "", fmt.Errorf("Error - unknown raw acceleration level (%s), possible values: %v", raw, accelerationLevelRaws())
+}
+
+// normalizeAccelerationLevelRaw returns the acceleration_level_raw of either an acceleration_level or an
+// acceleration_level_raw, so both forms can be configured
+func normalizeAccelerationLevelRaw(value string) (string, error) {
+	if _, err := AccelerationLevelFromRaw(value); err == nil {
+		return value, nil
+	}
+	return AccelerationLevelToRaw(value)
+}
+
+// SetAccelerationLevel sets the acceleration level of a site. The site status returns the acceleration_level (e.g.
+// advanced) while the update only accepts the raw level (e.g. aggressive), either form can be set.
+func (c *Client) SetAccelerationLevel(siteID int, level string) error {
+	raw, err := normalizeAccelerationLevelRaw(level)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Setting Incapsula acceleration level (%s) for site_id: %d\n", raw, siteID)
+
+	_, err = c.updateSite(strconv.Itoa(siteID), "acceleration_level", raw, nil)
+	if err != nil {
+		return fmt.Errorf("Error setting acceleration level (%s) for site_id %d: %s", raw, siteID, err)
+	}
+
+	return nil
+}
+
+func accelerationLevels() []string {
+	levels := make([]string, 0, len(accelerationLevelRawByLevel))
+	for level := range accelerationLevelRawByLevel {
+		levels = append(levels, level)
+	}
+	sort.Strings(levels)
+	return levels
+}
+
+func accelerationLevelRaws() []string {
+	raws := make([]string, 0, len(accelerationLevelRawByLevel))
+	for _, raw := range accelerationLevelRawByLevel {
+		raws = append(raws, raw)
+	}
+	sort.Strings(raws)
+	return raws
+}
@@ -0,0 +1,114 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// AccelerationLevelToRaw / AccelerationLevelFromRaw Tests
+////////////////////////////////////////////////////////////////
+
+func TestAccelerationLevelToRaw(t *testing.T) {
+	cases := map[string]string{
+		"none":     "none",
+		"standard": "standard",
+		"advanced": "aggressive",
+	}
+	for level, expectedRaw := range cases {
+		raw, err := AccelerationLevelToRaw(level)
+		if err != nil {
+			t.Errorf("Should not have received an error for %s, got: %s", level, err)
+		}
+		if raw != expectedRaw {
+			t.Errorf("Expected raw level %s for %s, got: %s", expectedRaw, level, raw)
+		}
+	}
+}
+
+func TestAccelerationLevelFromRaw(t *testing.T) {
+	cases := map[string]string{
+		"none":       "none",
+		"standard":   "standard",
+		"aggressive": "advanced",
+	}
+	for raw, expectedLevel := range cases {
+		level, err := AccelerationLevelFromRaw(raw)
+		if err != nil {
+			t.Errorf("Should not have received an error for %s, got: %s", raw, err)
+		}
+		if level != expectedLevel {
+			t.Errorf("Expected level %s for %s, got: %s", expectedLevel, raw, level)
+		}
+	}
+}
+
+func TestAccelerationLevelRoundTrip(t *testing.T) {
+	for _, level := range accelerationLevels() {
+		raw, err := AccelerationLevelToRaw(level)
+		if err != nil {
+			t.Fatalf("Should not have received an error for %s, got: %s", level, err)
+		}
+		roundTripLevel, err := AccelerationLevelFromRaw(raw)
+		if err != nil {
+			t.Fatalf("Should not have received an error for %s, got: %s", raw, err)
+		}
+		if roundTripLevel != level {
+			t.Errorf("Expected %s to map back to itself, got: %s", level, roundTripLevel)
+		}
+	}
+}
+
+func TestAccelerationLevelUnknownValues(t *testing.T) {
+	_, err := AccelerationLevelToRaw("aggressive")
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - unknown acceleration level (aggressive)") {
+		t.Errorf("Should have received an unknown acceleration level error, got: %v", err)
+	}
+	_, err = AccelerationLevelFromRaw("advanced")
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - unknown raw acceleration level (advanced)") {
+		t.Errorf("Should have received an unknown raw acceleration level error, got: %v", err)
+	}
+	_, err = normalizeAccelerationLevelRaw("fast")
+	if err == nil {
+		t.Errorf("Should have received an error for an unknown level")
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// SetAccelerationLevel Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetAccelerationLevelTranslatesToRaw(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteUpdate) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteUpdate, req.URL.String())
+		}
+		req.ParseForm()
+		if value := req.PostForm.Get("value"); value != "aggressive" {
+			t.Errorf("Expected the raw acceleration level aggressive to be sent, got: %s", value)
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	for _, value := range []string{"advanced", "aggressive"} {
+		err := client.SetAccelerationLevel(42, value)
+		if err != nil {
+			t.Errorf("Should not have received an error for %s, got: %s", value, err)
+		}
+	}
+}
+
+func TestClientSetAccelerationLevelUnknownLevel(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetAccelerationLevel(42, "turbo")
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - unknown acceleration level (turbo)") {
+		t.Errorf("Should have received an unknown acceleration level error, got: %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+)
+
+const siteActiveParam = "active"
+
+// Site active state enumerations
+const (
+	SiteActiveStateActive = "active"
+	SiteActiveStateBypass = "bypass"
+)
+
+var siteActiveStates = []string{SiteActiveStateActive, SiteActiveStateBypass}
+
+// SetSiteActive sets whether the site traffic goes through Incapsula (active) or directly to the origin (bypass)
+func (c *Client) SetSiteActive(siteID int, state string) error {
+	log.Printf("[INFO] Setting Incapsula site active state (%s) for site_id: %d\n", state, siteID)
+
+	if !contains(siteActiveStates, state) {
+		return fmt.Errorf("Error - invalid site active state (%s), must be one of %v", state, siteActiveStates)
+	}
+
+	_, err := c.updateSite(strconv.Itoa(siteID), siteActiveParam, state, nil)
+	if err != nil {
+		return fmt.Errorf("Error setting active state (%s) for site_id %d: %s", state, siteID, err)
+	}
+
+	return nil
+}
+
+// validateScheduledSiteState checks that a scheduled site state change is valid and still in the future. The API has
+// no scheduling, so the change is applied by the provider on the first apply after the scheduled time.
+func validateScheduledSiteState(state string, at, now time.Time) error {
+	if !contains(siteActiveStates, state) {
+		return fmt.Errorf("Error - invalid site active state (%s), must be one of %v", state, siteActiveStates)
+	}
+	if !at.After(now) {
+		return fmt.Errorf("Error - scheduled time (%s) must be in the future", at.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// scheduledSiteStateDue returns true when a scheduled site state change should be applied
+func scheduledSiteStateDue(at, now time.Time) bool {
+	return !now.Before(at)
+}
@@ -0,0 +1,80 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// SetSiteActive Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetSiteActiveBypass(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteUpdate) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteUpdate, req.URL.String())
+		}
+		req.ParseForm()
+		if req.PostForm.Get("site_id") != "42" || req.PostForm.Get("param") != siteActiveParam || req.PostForm.Get("value") != SiteActiveStateBypass {
+			t.Errorf("Unexpected site_id/param/value, got: %s/%s/%s", req.PostForm.Get("site_id"), req.PostForm.Get("param"), req.PostForm.Get("value"))
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSiteActive(42, SiteActiveStateBypass)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetSiteActiveInvalidState(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSiteActive(42, "paused")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error - invalid site active state (paused)") {
+		t.Errorf("Should have received an invalid state error, got: %s", err)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// Scheduled Site State Tests
+////////////////////////////////////////////////////////////////
+
+func TestValidateScheduledSiteState(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	err := validateScheduledSiteState(SiteActiveStateBypass, now.Add(time.Hour), now)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+
+	err = validateScheduledSiteState(SiteActiveStateActive, now, now)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - scheduled time (2024-03-01T12:00:00Z) must be in the future") {
+		t.Errorf("Should have received a past scheduled time error, got: %v", err)
+	}
+
+	err = validateScheduledSiteState("paused", now.Add(time.Hour), now)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid site active state (paused)") {
+		t.Errorf("Should have received an invalid state error, got: %v", err)
+	}
+}
+
+func TestScheduledSiteStateDue(t *testing.T) {
+	at := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	if scheduledSiteStateDue(at, at.Add(-time.Second)) {
+		t.Errorf("Should not be due before the scheduled time")
+	}
+	if !scheduledSiteStateDue(at, at) {
+		t.Errorf("Should be due at the scheduled time")
+	}
+}
@@ -0,0 +1,38 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+)
+
+// Certificate type enumerations, the certificate a site serves
+const (
+	CertificateTypeGenerated = "generated"
+	CertificateTypeCustom    = "custom"
+	CertificateTypeNone      = "none"
+)
+
+// GetCertificateType gets the type of certificate a site serves: custom when a custom certificate is active, otherwise
+// generated once the certificate managed by Imperva is validated, otherwise none
+func (c *Client) GetCertificateType(siteID int) (string, error) {
+	log.Printf("[INFO] Getting Incapsula certificate type for site_id: %d\n", siteID)
+
+	siteStatusResponse, err := c.SiteStatusFields(siteID, []string{"ssl"})
+	if err != nil {
+		return "", fmt.Errorf("Error getting certificate type for site_id %d: %s", siteID, err)
+	}
+
+	return certificateType(siteStatusResponse), nil
+}
+
+// certificateType derives the certificate type from the ssl section of the site status. An active custom certificate
+// is served instead of the generated one, so it takes precedence.
+func certificateType(siteStatusResponse *SiteStatusResponse) string {
+	if siteStatusResponse.Ssl.CustomCertificate.Active {
+		return CertificateTypeCustom
+	}
+	if siteStatusResponse.Ssl.GeneratedCertificate.ValidationStatus == "done" {
+		return CertificateTypeGenerated
+	}
+	return CertificateTypeNone
+}
@@ -0,0 +1,73 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// certificateType Tests
+////////////////////////////////////////////////////////////////
+
+func parseCertificateType(t *testing.T, ssl string) string {
+	var siteStatusResponse SiteStatusResponse
+	err := json.Unmarshal([]byte(fmt.Sprintf(`{"site_id":42,"res":0,"ssl":%s}`, ssl)), &siteStatusResponse)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	return certificateType(&siteStatusResponse)
+}
+
+func TestCertificateTypeGenerated(t *testing.T) {
+	certificateType := parseCertificateType(t, `{"custom_certificate":{"active":false},"generated_certificate":{"ca":"GS","validation_status":"done","san":["www.example.com"]}}`)
+	if certificateType != CertificateTypeGenerated {
+		t.Errorf("Expected certificate type %s, got: %s", CertificateTypeGenerated, certificateType)
+	}
+}
+
+func TestCertificateTypeCustom(t *testing.T) {
+	certificateType := parseCertificateType(t, `{"custom_certificate":{"active":true,"issuer":"Example CA"},"generated_certificate":{"ca":"GS","validation_status":"done"}}`)
+	if certificateType != CertificateTypeCustom {
+		t.Errorf("Expected certificate type %s, got: %s", CertificateTypeCustom, certificateType)
+	}
+}
+
+func TestCertificateTypeNone(t *testing.T) {
+	for _, ssl := range []string{
+		`{}`,
+		`{"custom_certificate":{"active":false},"generated_certificate":{"ca":"GS","validation_status":"pending_user_action"}}`,
+	} {
+		certificateType := parseCertificateType(t, ssl)
+		if certificateType != CertificateTypeNone {
+			t.Errorf("Expected certificate type %s for %s, got: %s", CertificateTypeNone, ssl, certificateType)
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetCertificateType Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetCertificateType(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteStatus) || req.PostForm.Get("fields") != "ssl" {
+			t.Errorf("Should have requested the ssl section of the site status, got: %s %v", req.URL.String(), req.PostForm)
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0,"ssl":{"custom_certificate":{"active":true}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	certificateType, err := client.GetCertificateType(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if certificateType != CertificateTypeCustom {
+		t.Errorf("Expected certificate type %s, got: %s", CertificateTypeCustom, certificateType)
+	}
+}
@@ -0,0 +1,74 @@
+package incapsula
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Site config param types
+const (
+	ConfigParamTypeString = "string"
+	ConfigParamTypeBool   = "bool"
+	ConfigParamTypeEnum   = "enum"
+	ConfigParamTypeInt    = "int"
+)
+
+// ConfigParam describes a site param that can be set with UpdateSite
+type ConfigParam struct {
+	Name          string
+	Type          string
+	AllowedValues []string
+	Description   string
+}
+
+var boolConfigParamValues = []string{"true", "false"}
+
+// The site params known to ValidateSiteConfigParam. Params with dedicated setters (e.g. SetSealLocation) validate their
+// additional values there.
+var siteConfigParams = []ConfigParam{
+	{Name: "acceleration_level", Type: ConfigParamTypeEnum, AllowedValues: []string{"none", "standard", "aggressive"}, Description: "Acceleration level of the site."},
+	{Name: "active", Type: ConfigParamTypeEnum, AllowedValues: []string{"active", "bypass"}, Description: "Whether the site traffic goes through Incapsula."},
+	{Name: "approver", Type: ConfigParamTypeString, Description: "Email address of the SSL certificate approver."},
+	{Name: "domain_redirect_to_full", Type: ConfigParamTypeBool, AllowedValues: boolConfigParamValues, Description: "Redirect the naked domain to the full domain."},
+	{Name: "domain_validation", Type: ConfigParamTypeEnum, AllowedValues: []string{"email", "html", "dns", "cname"}, Description: "Domain validation method of the SSL certificate."},
+	{Name: "ignore_ssl", Type: ConfigParamTypeBool, AllowedValues: boolConfigParamValues, Description: "Don't configure SSL for the site."},
+	{Name: "remove_ssl", Type: ConfigParamTypeBool, AllowedValues: boolConfigParamValues, Description: "Remove SSL support from the site."},
+	{Name: "ref_id", Type: ConfigParamTypeString, Description: "Customer specific identifier of the site, also used to store the site tags."},
+	{Name: "seal_location", Type: ConfigParamTypeEnum, AllowedValues: []string{"api.seal_location.bottom_left", "api.seal_location.none", "api.seal_location.right_bottom", "api.seal_location.right", "api.seal_location.left", "api.seal_location.bottom_right", "api.seal_location.bottom"}, Description: "Location of the trust seal."},
+	{Name: "restricted_cname_reuse", Type: ConfigParamTypeBool, AllowedValues: boolConfigParamValues, Description: "Restrict the reuse of the site CNAME."},
+	{Name: "wildcard_san", Type: ConfigParamTypeBool, AllowedValues: boolConfigParamValues, Description: "Use a wildcard SAN instead of the full domain SAN."},
+	{Name: "naked_domain_san", Type: ConfigParamTypeBool, AllowedValues: boolConfigParamValues, Description: "Add the naked domain as a SAN."},
+	{Name: supportAllTLSVersionsParam, Type: ConfigParamTypeBool, AllowedValues: boolConfigParamValues, Description: "Support all the TLS versions, including the deprecated TLS 1.0 and 1.1."},
+	{Name: extendedDDoSParam, Type: ConfigParamTypeInt, Description: "Extended DDoS window in seconds, 0 to disable it."},
+}
+
+// ListSiteConfigParams returns the known site params, with their types and allowed values
+func ListSiteConfigParams() []ConfigParam {
+	configParams := make([]ConfigParam, len(siteConfigParams))
+	for i, configParam := range siteConfigParams {
+		configParams[i] = configParam
+		configParams[i].AllowedValues = append([]string(nil), configParam.AllowedValues...)
+	}
+	return configParams
+}
+
+// ValidateSiteConfigParam checks that the param is one of ListSiteConfigParams and that the value is allowed for it.
+// UpdateSite doesn't validate its params, so that params which aren't listed yet can still be set.
+func ValidateSiteConfigParam(param, value string) error {
+	for _, configParam := range siteConfigParams {
+		if configParam.Name != param {
+			continue
+		}
+		if configParam.Type == ConfigParamTypeInt {
+			if intValue, err := strconv.Atoi(value); err != nil || intValue < 0 {
+				return fmt.Errorf("Error - invalid value (%s) for site config param %s, must be a non-negative integer", value, param)
+			}
+			return nil
+		}
+		if configParam.Type != ConfigParamTypeString && !contains(configParam.AllowedValues, value) {
+			return fmt.Errorf("Error - invalid value (%s) for site config param %s, must be one of %v", value, param, configParam.AllowedValues)
+		}
+		return nil
+	}
+	return fmt.Errorf("Error - unknown site config param (%s), see ListSiteConfigParams for the supported params", param)
+}
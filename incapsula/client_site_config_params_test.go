@@ -0,0 +1,83 @@
+package incapsula
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// ListSiteConfigParams Tests
+////////////////////////////////////////////////////////////////
+
+func TestListSiteConfigParams(t *testing.T) {
+	configParams := ListSiteConfigParams()
+	names := make([]string, len(configParams))
+	for i, configParam := range configParams {
+		names[i] = configParam.Name
+		if (configParam.Type == ConfigParamTypeEnum || configParam.Type == ConfigParamTypeBool) && len(configParam.AllowedValues) == 0 {
+			t.Errorf("%s: Should have allowed values for a %s param", configParam.Name, configParam.Type)
+		}
+	}
+	for _, name := range []string{"acceleration_level", "active", "ref_id", "seal_location"} {
+		if !contains(names, name) {
+			t.Errorf("Should have listed the %s param, got: %v", name, names)
+		}
+	}
+
+	// Changing the returned params should not change the registry
+	configParams[0].AllowedValues[0] = "changed"
+	if ListSiteConfigParams()[0].AllowedValues[0] == "changed" {
+		t.Errorf("Should have returned a copy of the registry")
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// ValidateSiteConfigParam Tests
+////////////////////////////////////////////////////////////////
+
+func TestValidateSiteConfigParamUnknownParam(t *testing.T) {
+	err := ValidateSiteConfigParam("acceleraton_level", "standard")
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - unknown site config param (acceleraton_level)") {
+		t.Errorf("Should have received an unknown param error, got: %v", err)
+	}
+}
+
+func TestValidateSiteConfigParamInvalidValue(t *testing.T) {
+	err := ValidateSiteConfigParam("remove_ssl", "yes")
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid value (yes) for site config param remove_ssl") {
+		t.Errorf("Should have received an invalid value error, got: %v", err)
+	}
+}
+
+func TestValidateSiteConfigParamValidValue(t *testing.T) {
+	for param, value := range map[string]string{"remove_ssl": "true", "ref_id": "anything", extendedDDoSParam: "60"} {
+		err := ValidateSiteConfigParam(param, value)
+		if err != nil {
+			t.Errorf("Should not have received an error for %s=%s, got: %s", param, value, err)
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// UpdateSite unlisted param Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientUpdateSiteUnlistedParam(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		if req.PostForm.Get("param") != "unlisted_param" || req.PostForm.Get("value") != "some_value" {
+			t.Errorf("Should have sent the unlisted param, got: %s=%s", req.PostForm.Get("param"), req.PostForm.Get("value"))
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.UpdateSite("42", "unlisted_param", "some_value")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
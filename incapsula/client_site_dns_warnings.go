@@ -0,0 +1,113 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+// Warning type of the DNS warnings, e.g. when the site DNS still points to the origin servers
+const dnsWarningType = "dns"
+
+// DNSActionItem is a DNS record to change so a site DNS warning is resolved
+type DNSActionItem struct {
+	RecordName    string
+	RecordType    string
+	Values        []string
+	CurrentValues []string
+	Reason        string
+}
+
+// GetActionableDNSWarnings converts the DNS warnings of a site (e.g. "the DNS still points to the origin") into the
+// records to change: the CNAME/A records pointing the site to Imperva, followed by the records still required to
+// validate the generated certificate. Records already set to their expected values aren't returned. It's empty when
+// the site has no DNS warning.
+func (c *Client) GetActionableDNSWarnings(siteID int) ([]DNSActionItem, error) {
+	log.Printf("[INFO] Getting Incapsula actionable DNS warnings for site_id: %d\n", siteID)
+
+	siteStatusResponse, err := c.SiteStatus("", siteID)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading DNS warnings for site_id %d: %s", siteID, err)
+	}
+
+	return actionableDNSWarnings(siteStatusResponse), nil
+}
+
+func actionableDNSWarnings(siteStatusResponse *SiteStatusResponse) []DNSActionItem {
+	items := make([]DNSActionItem, 0)
+	warnings := dnsWarningMessages(siteStatusResponse)
+	if len(warnings) == 0 {
+		return items
+	}
+	reason := strings.Join(warnings, "; ")
+
+	// The records currently set, as detected by Imperva
+	currentRecords := make(map[string]map[string][]string)
+	for _, record := range siteStatusResponse.OriginalDNS {
+		if currentRecords[record.DNSRecordName] == nil {
+			currentRecords[record.DNSRecordName] = make(map[string][]string)
+		}
+		currentRecords[record.DNSRecordName][record.SetTypeTo] = record.SetDataTo
+	}
+
+	for _, record := range siteStatusResponse.DNS {
+		currentValues := currentRecords[record.DNSRecordName][record.SetTypeTo]
+		if len(currentValues) > 0 && joinSorted(currentValues) == joinSorted(record.SetDataTo) {
+			continue
+		}
+		// Whatever the record name currently resolves to, e.g. the A records of the origin replaced by a CNAME
+		if len(currentValues) == 0 {
+			currentValues = currentRecordValues(currentRecords[record.DNSRecordName])
+		}
+		items = append(items, DNSActionItem{
+			RecordName:    record.DNSRecordName,
+			RecordType:    record.SetTypeTo,
+			Values:        record.SetDataTo,
+			CurrentValues: currentValues,
+			Reason:        reason,
+		})
+	}
+
+	for _, record := range pendingSANValidationRecords(siteStatusResponse) {
+		items = append(items, DNSActionItem{
+			RecordName: record.RecordName,
+			RecordType: record.RecordType,
+			Values:     record.Values,
+			Reason:     "certificate validation is pending",
+		})
+	}
+
+	return items
+}
+
+// dnsWarningMessages returns the messages of the DNS warnings of the site status. Object warnings are DNS warnings by
+// their type, plain string warnings when they mention the DNS.
+func dnsWarningMessages(siteStatusResponse *SiteStatusResponse) []string {
+	messages := make([]string, 0)
+	for _, warning := range siteStatusResponse.Warnings {
+		switch w := warning.(type) {
+		case string:
+			if strings.Contains(strings.ToLower(w), dnsWarningType) {
+				messages = append(messages, w)
+			}
+		case map[string]interface{}:
+			warningType, _ := w["type"].(string)
+			message, _ := w["message"].(string)
+			if strings.EqualFold(warningType, dnsWarningType) && message != "" {
+				messages = append(messages, message)
+			}
+		}
+	}
+	return messages
+}
+
+// currentRecordValues returns the values of all the records of a name, sorted
+func currentRecordValues(records map[string][]string) []string {
+	values := make([]string, 0)
+	for _, recordValues := range records {
+		values = append(values, recordValues...)
+	}
+	sort.Strings(values)
+	return values
+}
@@ -0,0 +1,39 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestActionableDNSWarnings(t *testing.T) {
+	var siteStatusResponse SiteStatusResponse
+	err := json.Unmarshal([]byte(`{"site_id":42,"status":"pending-dns-changes","res":0,
+		"warnings":["The site's DNS still points to the origin server",{"type":"ssl","message":"The certificate expires in 7 days"}],
+		"dns":[{"dns_record_name":"www.example.com","set_type_to":"CNAME","set_data_to":["x7k2v.x.incapdns.net"]},
+			{"dns_record_name":"example.com","set_type_to":"A","set_data_to":["107.154.1.2","107.154.3.4"]}],
+		"original_dns":[{"dns_record_name":"www.example.com","set_type_to":"A","set_data_to":["203.0.113.10"]},
+			{"dns_record_name":"example.com","set_type_to":"A","set_data_to":["107.154.3.4","107.154.1.2"]}]}`), &siteStatusResponse)
+	if err != nil {
+		t.Fatalf("Failed to parse site status: %s", err)
+	}
+
+	items := actionableDNSWarnings(&siteStatusResponse)
+	expected := []DNSActionItem{
+		{
+			RecordName:    "www.example.com",
+			RecordType:    "CNAME",
+			Values:        []string{"x7k2v.x.incapdns.net"},
+			CurrentValues: []string{"203.0.113.10"},
+			Reason:        "The site's DNS still points to the origin server",
+		},
+	}
+	if !reflect.DeepEqual(items, expected) {
+		t.Errorf("Unexpected action items, expected %+v, got: %+v", expected, items)
+	}
+
+	siteStatusResponse.Warnings = []interface{}{map[string]interface{}{"type": "ssl", "message": "The certificate expires in 7 days"}}
+	if items := actionableDNSWarnings(&siteStatusResponse); len(items) != 0 {
+		t.Errorf("Should not have produced action items without DNS warnings, got: %+v", items)
+	}
+}
@@ -76,7 +76,7 @@ type ApiErrorResponse struct {
 }
 
 func (c *Client) GetWebsiteDomains(siteId string) (*SiteDomainDetailsDto, error) {
-	reqURL := fmt.Sprintf("%s%s%s%s", c.config.BaseURLAPI, endpointDomainManagement, siteId, "/domains")
+	reqURL := fmt.Sprintf("%s%s%s", c.endpointURL(endpointDomainManagement), siteId, "/domains")
 	if siteId == "" {
 		fmt.Errorf("[ERROR] site ID was not provided")
 	}
@@ -141,7 +141,7 @@ func (c *Client) BulkUpdateDomainsToSite(siteID string, siteDomainDetails []Site
 }
 
 func checkForAsyncRequestStatus(c *Client, siteId string, requestUuid string) (*AsyncResponseDetailsDto, error) {
-	reqURL := fmt.Sprintf("%s%s%s%s%s", c.config.BaseURLAPI, endpointDomainManagement, siteId, "/domains/status/", requestUuid)
+	reqURL := fmt.Sprintf("%s%s%s%s", c.endpointURL(endpointDomainManagement), siteId, "/domains/status/", requestUuid)
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, UpdateDomain)
 	if err != nil {
 		return nil, fmt.Errorf("[ERROR] Error from Incapsula service when update domains for siteId %s: %s", siteId, err)
@@ -164,7 +164,7 @@ func checkForAsyncRequestStatus(c *Client, siteId string, requestUuid string) (*
 }
 
 func handleAddBulkRequest(c *Client, bulkAddDomainsDto BulkAddDomainsDto, siteId string) (*AsyncResponseDetailsDto, error) {
-	reqURL := fmt.Sprintf("%s%s%s%s", c.config.BaseURLAPI, endpointDomainManagement, siteId, "/domains")
+	reqURL := fmt.Sprintf("%s%s%s", c.endpointURL(endpointDomainManagement), siteId, "/domains")
 	body, err := json.Marshal(bulkAddDomainsDto)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to parse bulkAddDomainsDto: %s ", err)
@@ -209,7 +209,7 @@ func verifyDomainsAmountBelowMaxAllowed(c *Client, siteId string, siteDomainDeta
 }
 
 func GetSiteExtraDetails(c *Client, siteID string) (*SiteDomainsExtraDetailsDto, error) {
-	reqURL := fmt.Sprintf("%s%s%s%s", c.config.BaseURLAPI, endpointDomainManagement, siteID, "/domains/extraDetails")
+	reqURL := fmt.Sprintf("%s%s%s", c.endpointURL(endpointDomainManagement), siteID, "/domains/extraDetails")
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadDomainExtraDetails)
 	if err != nil {
 		return nil, fmt.Errorf("[ERROR] Error from Incapsula service when geting site domains extra details %s: %s", siteID, err)
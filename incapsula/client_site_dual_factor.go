@@ -0,0 +1,154 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Endpoints (unexported consts)
+const endpointSiteDualFactorConfigure = "sites/lp/configure"
+
+// Two factor authentication media enumerations
+const (
+	DualFactorMediaSMS   = "sms"
+	DualFactorMediaEmail = "email"
+	DualFactorMediaApp   = "app"
+)
+
+var dualFactorMedia = []string{DualFactorMediaSMS, DualFactorMediaEmail, DualFactorMediaApp}
+
+// SiteDualFactorConfig contains the two factor authentication settings of a site
+type SiteDualFactorConfig struct {
+	Enabled                bool
+	AllowAllUsers          bool
+	SendLoginNotifications bool
+	AllowedMedia           []string
+	// NotificationRecipients are the email addresses notified of logins, along with the users themselves
+	NotificationRecipients []string
+}
+
+// ConfigureSiteDualFactor sets the two factor authentication settings of a site
+func (c *Client) ConfigureSiteDualFactor(siteID int, cfg SiteDualFactorConfig) error {
+	log.Printf("[INFO] Configuring Incapsula two factor authentication (enabled: %t, allowed media: %v) for site_id: %d\n", cfg.Enabled, cfg.AllowedMedia, siteID)
+
+	values, err := siteDualFactorValues(siteID, cfg)
+	if err != nil {
+		return err
+	}
+
+	var siteUpdateResponse SiteUpdateResponse
+	responseBody, err := c.postFormAndDecode(c.endpointURL(endpointSiteDualFactorConfigure), values, UpdateSiteDualFactor, &siteUpdateResponse)
+	if err != nil {
+		return fmt.Errorf("Error configuring two factor authentication for site_id %d: %s", siteID, err)
+	}
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula configure two factor authentication JSON response: %s\n", string(responseBody))
+
+	if siteUpdateResponse.Res != 0 {
+		return newIncapsulaError(strconv.Itoa(siteUpdateResponse.Res), siteUpdateResponse.DebugInfo, "Error from Incapsula service when configuring two factor authentication for site_id %d: %s", siteID, string(responseBody))
+	}
+
+	return nil
+}
+
+// BulkConfigureLoginProtect applies the same login protect (two factor authentication) settings to many sites, with at
+// most concurrency sites configured at the same time. The returned map holds the error of each site that couldn't be
+// configured, it's empty when all the sites were configured. The error is only returned for invalid settings, in which
+// case no site is configured.
+func (c *Client) BulkConfigureLoginProtect(siteIDs []int, cfg SiteDualFactorConfig, concurrency int) (map[int]error, error) {
+	log.Printf("[INFO] Configuring Incapsula two factor authentication (enabled: %t) for %d sites\n", cfg.Enabled, len(siteIDs))
+
+	if concurrency < 1 {
+		return nil, fmt.Errorf("Error - invalid concurrency (%d), must be at least 1", concurrency)
+	}
+	// The settings are the same for all the sites, validate them once rather than failing each site
+	_, err := siteDualFactorValues(0, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	siteErrors := make(map[int]error)
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	seenSiteIDs := make(map[int]bool)
+	for _, siteID := range siteIDs {
+		if seenSiteIDs[siteID] {
+			continue
+		}
+		seenSiteIDs[siteID] = true
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(siteID int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			err := c.ConfigureSiteDualFactor(siteID, cfg)
+			if err != nil {
+				mutex.Lock()
+				siteErrors[siteID] = err
+				mutex.Unlock()
+			}
+		}(siteID)
+	}
+	wg.Wait()
+
+	return siteErrors, nil
+}
+
+// siteDualFactorValues validates the settings and returns the values sent to configure them. At least one media is
+// required when two factor authentication is enabled.
+func siteDualFactorValues(siteID int, cfg SiteDualFactorConfig) (url.Values, error) {
+	for _, media := range cfg.AllowedMedia {
+		if !contains(dualFactorMedia, media) {
+			return nil, fmt.Errorf("Error - invalid two factor authentication media (%s), must be one of %v", media, dualFactorMedia)
+		}
+	}
+	if cfg.Enabled && len(cfg.AllowedMedia) == 0 {
+		return nil, fmt.Errorf("Error - at least one two factor authentication media must be allowed when it's enabled")
+	}
+
+	values := url.Values{
+		"site_id":               {strconv.Itoa(siteID)},
+		"enabled":               {strconv.FormatBool(cfg.Enabled)},
+		"allow_all_users":       {strconv.FormatBool(cfg.AllowAllUsers)},
+		"send_lp_notifications": {strconv.FormatBool(cfg.SendLoginNotifications)},
+	}
+	if len(cfg.AllowedMedia) > 0 {
+		values.Set("allowed_media", strings.Join(cfg.AllowedMedia, ","))
+	}
+
+	if len(cfg.NotificationRecipients) > 0 {
+		if !cfg.SendLoginNotifications {
+			return nil, fmt.Errorf("Error - login notification recipients require login notifications to be sent")
+		}
+		for _, recipient := range cfg.NotificationRecipients {
+			// Only bare addresses, e.g. "Security <security@example.com>" isn't accepted
+			address, err := mail.ParseAddress(recipient)
+			if err != nil || address.Address != recipient {
+				return nil, fmt.Errorf("Error - invalid login notification recipient (%s), must be an email address", recipient)
+			}
+		}
+		values.Set("lp_notification_recipients", strings.Join(cfg.NotificationRecipients, ","))
+	}
+
+	return values, nil
+}
+
+// getSiteDualFactorConfig returns the two factor authentication settings from the site status
+func getSiteDualFactorConfig(siteStatusResponse *SiteStatusResponse) SiteDualFactorConfig {
+	return SiteDualFactorConfig{
+		Enabled:                siteStatusResponse.SiteDualFactorSettings.Enabled,
+		AllowAllUsers:          siteStatusResponse.SiteDualFactorSettings.AllowAllUsers,
+		SendLoginNotifications: siteStatusResponse.SiteDualFactorSettings.ShouldSendLoginNotifications,
+		AllowedMedia:           siteStatusResponse.SiteDualFactorSettings.AllowedMedia,
+		NotificationRecipients: siteStatusResponse.SiteDualFactorSettings.LoginNotificationRecipients,
+	}
+}
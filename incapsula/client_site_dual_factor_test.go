@@ -0,0 +1,214 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// ConfigureSiteDualFactor Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientConfigureSiteDualFactorValidMedia(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteDualFactorConfigure) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteDualFactorConfigure, req.URL.String())
+		}
+		req.ParseForm()
+		if req.PostForm.Get("site_id") != "42" || req.PostForm.Get("enabled") != "true" {
+			t.Errorf("Unexpected site_id/enabled, got: %s/%s", req.PostForm.Get("site_id"), req.PostForm.Get("enabled"))
+		}
+		if req.PostForm.Get("allowed_media") != "sms,app" {
+			t.Errorf("Expected allowed_media to be sms,app, got: %s", req.PostForm.Get("allowed_media"))
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.ConfigureSiteDualFactor(42, SiteDualFactorConfig{Enabled: true, AllowAllUsers: true, AllowedMedia: []string{DualFactorMediaSMS, DualFactorMediaApp}})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientConfigureSiteDualFactorEnabledWithoutMedia(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.ConfigureSiteDualFactor(42, SiteDualFactorConfig{Enabled: true})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error - at least one two factor authentication media must be allowed") {
+		t.Errorf("Should have received a missing media error, got: %s", err)
+	}
+}
+
+func TestClientConfigureSiteDualFactorInvalidMedia(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.ConfigureSiteDualFactor(42, SiteDualFactorConfig{Enabled: true, AllowedMedia: []string{"fax"}})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error - invalid two factor authentication media (fax)") {
+		t.Errorf("Should have received an invalid media error, got: %s", err)
+	}
+}
+
+func TestClientConfigureSiteDualFactorNotificationRecipients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		expected := map[string]string{
+			"site_id":                    "42",
+			"enabled":                    "true",
+			"allow_all_users":            "true",
+			"send_lp_notifications":      "true",
+			"allowed_media":              "email",
+			"lp_notification_recipients": "security@example.com,soc@example.com",
+		}
+		for key, value := range expected {
+			if req.PostForm.Get(key) != value {
+				t.Errorf("Expected %s to be %s, got: %s", key, value, req.PostForm.Get(key))
+			}
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.ConfigureSiteDualFactor(42, SiteDualFactorConfig{
+		Enabled:                true,
+		AllowAllUsers:          true,
+		SendLoginNotifications: true,
+		AllowedMedia:           []string{DualFactorMediaEmail},
+		NotificationRecipients: []string{"security@example.com", "soc@example.com"},
+	})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientConfigureSiteDualFactorInvalidNotificationRecipients(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	cases := []struct {
+		cfg      SiteDualFactorConfig
+		expected string
+	}{
+		{SiteDualFactorConfig{SendLoginNotifications: true, NotificationRecipients: []string{"security"}}, "Error - invalid login notification recipient (security)"},
+		{SiteDualFactorConfig{SendLoginNotifications: true, NotificationRecipients: []string{"Security <security@example.com>"}}, "Error - invalid login notification recipient (Security <security@example.com>)"},
+		{SiteDualFactorConfig{NotificationRecipients: []string{"security@example.com"}}, "Error - login notification recipients require login notifications to be sent"},
+	}
+	for _, c := range cases {
+		err := client.ConfigureSiteDualFactor(42, c.cfg)
+		if err == nil {
+			t.Errorf("Should have received an error for %+v", c.cfg)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), c.expected) {
+			t.Errorf("Expected error %s, got: %s", c.expected, err)
+		}
+	}
+}
+
+func TestGetSiteDualFactorConfigNotificationRecipients(t *testing.T) {
+	var siteStatusResponse SiteStatusResponse
+	err := json.Unmarshal([]byte(`{"siteDualFactorSettings":{"enabled":true,"shouldSendLoginNotifications":true,"loginNotificationRecipients":["security@example.com"]},"res":0}`), &siteStatusResponse)
+	if err != nil {
+		t.Fatalf("Failed to parse site status: %s", err)
+	}
+	cfg := getSiteDualFactorConfig(&siteStatusResponse)
+	if len(cfg.NotificationRecipients) != 1 || cfg.NotificationRecipients[0] != "security@example.com" {
+		t.Errorf("Should have read the notification recipients, got: %v", cfg.NotificationRecipients)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// BulkConfigureLoginProtect Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientBulkConfigureLoginProtect(t *testing.T) {
+	var inFlight, maxInFlight int32
+	var mutex sync.Mutex
+	configuredSiteIDs := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		req.ParseForm()
+		siteID := req.PostForm.Get("site_id")
+		mutex.Lock()
+		configuredSiteIDs[siteID]++
+		mutex.Unlock()
+		if siteID == "3" {
+			rw.Write([]byte(`{"res":9413,"res_message":"Unknown/unauthorized site_id","debug_info":{"id-info":"13007"}}`))
+			return
+		}
+		rw.Write([]byte(fmt.Sprintf(`{"site_id":%s,"res":0}`, siteID)))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	cfg := SiteDualFactorConfig{Enabled: true, AllowedMedia: []string{DualFactorMediaEmail}}
+	siteErrors, err := client.BulkConfigureLoginProtect([]int{1, 2, 3, 4, 5, 6, 2}, cfg, 2)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(siteErrors) != 1 || siteErrors[3] == nil {
+		t.Errorf("Should only have received an error for site 3, got: %v", siteErrors)
+	}
+	if len(configuredSiteIDs) != 6 {
+		t.Errorf("Expected 6 sites to be configured, got: %v", configuredSiteIDs)
+	}
+	for siteID, count := range configuredSiteIDs {
+		if count != 1 {
+			t.Errorf("Site %s should have been configured once, got: %d", siteID, count)
+		}
+	}
+	if maxInFlight > 2 {
+		t.Errorf("Should not have configured more than 2 sites at the same time, got: %d", maxInFlight)
+	}
+}
+
+func TestClientBulkConfigureLoginProtectInvalidSettings(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteErrors, err := client.BulkConfigureLoginProtect([]int{1, 2}, SiteDualFactorConfig{Enabled: true}, 2)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if siteErrors != nil {
+		t.Errorf("Should not have configured any site, got: %v", siteErrors)
+	}
+	if !strings.HasPrefix(err.Error(), "Error - at least one two factor authentication media must be allowed") {
+		t.Errorf("Should have received a missing media error, got: %s", err)
+	}
+}
+
+func TestClientBulkConfigureLoginProtectInvalidConcurrency(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.BulkConfigureLoginProtect([]int{1}, SiteDualFactorConfig{}, 0)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid concurrency (0)") {
+		t.Errorf("Should have received an invalid concurrency error, got: %v", err)
+	}
+}
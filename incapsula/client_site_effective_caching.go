@@ -0,0 +1,92 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+)
+
+// Sources of the values of the effective caching configuration
+const (
+	cachingConfigSourceSite    = "site"
+	cachingConfigSourceAccount = "account"
+)
+
+// CachingConfigValue is a setting of the effective caching configuration of a site and where its value comes from:
+// the site itself or the defaults of its account
+type CachingConfigValue struct {
+	Name   string
+	Value  string
+	Source string
+}
+
+// CachingConfig is the effective caching configuration of a site, sorted by setting name
+type CachingConfig struct {
+	SiteID    int
+	AccountID int
+	Settings  []CachingConfigValue
+}
+
+// GetEffectiveCachingConfig gets the caching configuration of a site merged with the defaults of its account. The
+// account of the site is used when accountID is nil.
+// The API doesn't tell whether a site overrides an account default, so a site value equal to the account default is
+// reported as coming from the account. Settings without an account default always come from the site.
+func (c *Client) GetEffectiveCachingConfig(siteID int, accountID *int) (*CachingConfig, error) {
+	log.Printf("[INFO] Getting Incapsula effective caching configuration for site_id: %d\n", siteID)
+
+	siteStatusResponse, err := c.SiteStatusFields(siteID, []string{"performance_configuration"})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting effective caching configuration for site_id %d: %s", siteID, err)
+	}
+
+	effectiveAccountID := siteStatusResponse.AccountID
+	if accountID != nil {
+		effectiveAccountID = *accountID
+	}
+
+	accountDefaults, err := c.GetAccountDefaults(effectiveAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting effective caching configuration for site_id %d: %s", siteID, err)
+	}
+
+	return &CachingConfig{
+		SiteID:    siteID,
+		AccountID: effectiveAccountID,
+		Settings:  mergeCachingConfig(siteStatusResponse, accountDefaults),
+	}, nil
+}
+
+// mergeCachingConfig merges the caching settings of a site status with the account defaults, sorted by setting name
+func mergeCachingConfig(siteStatusResponse *SiteStatusResponse, accountDefaults *AccountDefaults) []CachingConfigValue {
+	performance := siteStatusResponse.PerformanceConfiguration
+
+	accelerationLevel := performance.AccelerationLevel
+	if accelerationLevel == "" {
+		accelerationLevel = siteStatusResponse.AccelerationLevel
+	}
+
+	settings := []CachingConfigValue{
+		mergeCachingConfigValue("acceleration_level", accelerationLevel, accountDefaults.AccelerationLevel),
+		{Name: "cache_300x", Value: strconv.FormatBool(performance.Cache300X), Source: cachingConfigSourceSite},
+		{Name: "comply_no_cache", Value: strconv.FormatBool(performance.ComplyNoCache), Source: cachingConfigSourceSite},
+		{Name: "comply_vary", Value: strconv.FormatBool(performance.ComplyVary), Source: cachingConfigSourceSite},
+		{Name: "disable_client_side_caching", Value: strconv.FormatBool(performance.DisableClientSideCaching), Source: cachingConfigSourceSite},
+		{Name: "prefer_last_modified", Value: strconv.FormatBool(performance.PreferLastModified), Source: cachingConfigSourceSite},
+		{Name: "use_shortest_caching", Value: strconv.FormatBool(performance.UseShortestCaching), Source: cachingConfigSourceSite},
+	}
+
+	sort.Slice(settings, func(i, j int) bool {
+		return settings[i].Name < settings[j].Name
+	})
+	return settings
+}
+
+// mergeCachingConfigValue returns the site value of a setting, or the account default when the site has no value or
+// the same value as the default
+func mergeCachingConfigValue(name, siteValue, accountDefault string) CachingConfigValue {
+	if accountDefault != "" && (siteValue == "" || siteValue == accountDefault) {
+		return CachingConfigValue{Name: name, Value: accountDefault, Source: cachingConfigSourceAccount}
+	}
+	return CachingConfigValue{Name: name, Value: siteValue, Source: cachingConfigSourceSite}
+}
@@ -0,0 +1,116 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// GetEffectiveCachingConfig Tests
+////////////////////////////////////////////////////////////////
+
+func effectiveCachingConfigTestServer(t *testing.T, siteAccelerationLevel, accountAccelerationLevel string, accountID *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		switch req.URL.Path {
+		case fmt.Sprintf("/%s", endpointSiteStatus):
+			if req.PostForm.Get("fields") != "performance_configuration" {
+				t.Errorf("Expected fields to be performance_configuration, got: %s", req.PostForm.Get("fields"))
+			}
+			rw.Write([]byte(fmt.Sprintf(`{"site_id":42,"account_id":7,"res":0,"performance_configuration":{"acceleration_level":"%s","comply_vary":true}}`, siteAccelerationLevel)))
+		case fmt.Sprintf("/%s", endpointAccountStatus):
+			expectedAccountID := "7"
+			if accountID != nil {
+				expectedAccountID = fmt.Sprint(*accountID)
+			}
+			if req.PostForm.Get("account_id") != expectedAccountID {
+				t.Errorf("Expected account_id to be %s, got: %s", expectedAccountID, req.PostForm.Get("account_id"))
+			}
+			rw.Write([]byte(fmt.Sprintf(`{"res":0,"account":{"account_id":%s,"default_acceleration_level":"%s"}}`, expectedAccountID, accountAccelerationLevel)))
+		default:
+			t.Errorf("Unexpected request: %s", req.URL.String())
+		}
+	}))
+}
+
+func effectiveCachingConfigSetting(cachingConfig *CachingConfig, name string) CachingConfigValue {
+	for _, setting := range cachingConfig.Settings {
+		if setting.Name == name {
+			return setting
+		}
+	}
+	return CachingConfigValue{}
+}
+
+func TestClientGetEffectiveCachingConfigDefaultOnly(t *testing.T) {
+	server := effectiveCachingConfigTestServer(t, "", "aggressive", nil)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	cachingConfig, err := client.GetEffectiveCachingConfig(42, nil)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if cachingConfig.AccountID != 7 {
+		t.Errorf("Expected account id to be 7, got: %d", cachingConfig.AccountID)
+	}
+	accelerationLevel := effectiveCachingConfigSetting(cachingConfig, "acceleration_level")
+	if accelerationLevel.Value != "aggressive" || accelerationLevel.Source != cachingConfigSourceAccount {
+		t.Errorf("Expected acceleration level aggressive from the account, got: %+v", accelerationLevel)
+	}
+}
+
+func TestClientGetEffectiveCachingConfigOverrideOnly(t *testing.T) {
+	server := effectiveCachingConfigTestServer(t, "standard", "", nil)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	cachingConfig, err := client.GetEffectiveCachingConfig(42, nil)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	for _, setting := range cachingConfig.Settings {
+		if setting.Source != cachingConfigSourceSite {
+			t.Errorf("Expected %s to come from the site, got: %+v", setting.Name, setting)
+		}
+	}
+	accelerationLevel := effectiveCachingConfigSetting(cachingConfig, "acceleration_level")
+	if accelerationLevel.Value != "standard" {
+		t.Errorf("Expected acceleration level standard, got: %+v", accelerationLevel)
+	}
+}
+
+func TestClientGetEffectiveCachingConfigMixed(t *testing.T) {
+	accountID := 9
+	server := effectiveCachingConfigTestServer(t, "standard", "standard", &accountID)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	cachingConfig, err := client.GetEffectiveCachingConfig(42, &accountID)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if cachingConfig.AccountID != 9 {
+		t.Errorf("Expected account id to be 9, got: %d", cachingConfig.AccountID)
+	}
+	accelerationLevel := effectiveCachingConfigSetting(cachingConfig, "acceleration_level")
+	if accelerationLevel.Value != "standard" || accelerationLevel.Source != cachingConfigSourceAccount {
+		t.Errorf("Expected acceleration level standard from the account, got: %+v", accelerationLevel)
+	}
+	complyVary := effectiveCachingConfigSetting(cachingConfig, "comply_vary")
+	if complyVary.Value != "true" || complyVary.Source != cachingConfigSourceSite {
+		t.Errorf("Expected comply_vary true from the site, got: %+v", complyVary)
+	}
+}
+
+func TestMergeCachingConfigValueSiteOverride(t *testing.T) {
+	value := mergeCachingConfigValue("acceleration_level", "none", "aggressive")
+	if value.Value != "none" || value.Source != cachingConfigSourceSite {
+		t.Errorf("Expected acceleration level none from the site, got: %+v", value)
+	}
+}
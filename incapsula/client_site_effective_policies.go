@@ -0,0 +1,183 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+)
+
+// Endpoints (unexported consts)
+// The policies of an asset, e.g. /policies/v2/assets/WEBSITE/{siteId}/policies
+const endpointPolicyAssetsBase = "/policies/v2/assets"
+
+// Effective policy source enumerations
+const (
+	EffectivePolicySourceDirect    = "direct"
+	EffectivePolicySourceInherited = "inherited"
+)
+
+const wafRulesPolicyType = "WAF_RULES"
+
+// EffectivePolicy is a policy applied to a site, along with where it comes from
+type EffectivePolicy struct {
+	PolicyID   int
+	Name       string
+	PolicyType string
+	Source     string
+}
+
+// GetSitePolicies gets the policies directly associated with a site
+func (c *Client) GetSitePolicies(siteID int, currentAccountId *int) (*[]Policy, error) {
+	log.Printf("[INFO] Getting Incapsula Policies associated with site_id: %d\n", siteID)
+
+	reqURL := fmt.Sprintf("%s/WEBSITE/%d/policies", c.endpointURL(endpointPolicyAssetsBase), siteID)
+	if currentAccountId != nil && *currentAccountId != 0 {
+		reqURL = fmt.Sprintf("%s?caid=%d", reqURL, *currentAccountId)
+	}
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadPolicyAssetAssociation)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error from Incapsula service when reading Policies for site_id %d: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Read Site Policies JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("[ERROR] Error status code %d from Incapsula service when reading Policies for site_id %d: %s", resp.StatusCode, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var policyExtendedAll PolicyExtendedAll
+	err = json.Unmarshal([]byte(responseBody), &policyExtendedAll)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing Site Policies JSON response for site_id %d: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	return &policyExtendedAll.Value, nil
+}
+
+// GetEffectiveSitePolicies returns the policies applied to a site: its direct associations plus the account default
+// policies it inherits. When accountID is nil the account is taken from the site status.
+func (c *Client) GetEffectiveSitePolicies(siteID int, accountID *int) ([]EffectivePolicy, error) {
+	log.Printf("[INFO] Getting Incapsula effective Policies for site_id: %d\n", siteID)
+
+	directPolicies, err := c.GetSitePolicies(siteID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountIDStr, err := c.siteAccountID(siteID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	accountPolicyAssociation, err := c.GetAccountPolicyAssociation(accountIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	accountPolicies, err := c.GetAllPoliciesForAccount(accountIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return resolveEffectivePolicies(*directPolicies, accountPolicyAssociation, *accountPolicies), nil
+}
+
+// SiteHasWafPolicy returns true when a WAF policy applies to a site, either directly associated or inherited from the
+// account default WAF policy. The account policies aren't listed, so it's cheaper than GetEffectiveSitePolicies.
+func (c *Client) SiteHasWafPolicy(siteID int, accountID *int) (bool, error) {
+	log.Printf("[INFO] Checking Incapsula WAF Policy of site_id: %d\n", siteID)
+
+	directPolicies, err := c.GetSitePolicies(siteID, accountID)
+	if err != nil {
+		return false, err
+	}
+	if len(sitePolicyIDsOfType(*directPolicies, wafRulesPolicyType)) > 0 {
+		return true, nil
+	}
+
+	accountIDStr, err := c.siteAccountID(siteID, accountID)
+	if err != nil {
+		return false, err
+	}
+
+	accountPolicyAssociation, err := c.GetAccountPolicyAssociation(accountIDStr)
+	if err != nil {
+		return false, err
+	}
+
+	return accountPolicyAssociation.DefaultWafPolicyId != 0, nil
+}
+
+// siteAccountID returns the account of a site, taken from the site status when accountID is nil
+func (c *Client) siteAccountID(siteID int, accountID *int) (string, error) {
+	if accountID != nil && *accountID != 0 {
+		return strconv.Itoa(*accountID), nil
+	}
+
+	siteStatusResponse, err := c.SiteStatus("", siteID)
+	if err != nil {
+		return "", fmt.Errorf("Error getting account of site_id %d: %s", siteID, err)
+	}
+	return strconv.Itoa(siteStatusResponse.AccountID), nil
+}
+
+// resolveEffectivePolicies merges the direct policies of a site with the account defaults. A default policy isn't
+// inherited when it's already directly associated, and the default WAF policy isn't inherited when the site has its own.
+func resolveEffectivePolicies(directPolicies []Policy, accountPolicyAssociation *AccountPolicyAssociationV3, accountPolicies []Policy) []EffectivePolicy {
+	effectivePolicies := make([]EffectivePolicy, 0)
+	seenPolicyIDs := make(map[int]bool)
+	hasDirectWAFPolicy := false
+	for _, policy := range directPolicies {
+		effectivePolicies = append(effectivePolicies, EffectivePolicy{
+			PolicyID:   policy.ID,
+			Name:       policy.Name,
+			PolicyType: policy.PolicyType,
+			Source:     EffectivePolicySourceDirect,
+		})
+		seenPolicyIDs[policy.ID] = true
+		if policy.PolicyType == wafRulesPolicyType {
+			hasDirectWAFPolicy = true
+		}
+	}
+
+	policiesByID := make(map[int]Policy)
+	for _, policy := range accountPolicies {
+		policiesByID[policy.ID] = policy
+	}
+
+	defaultPolicyIDs := append([]int(nil), accountPolicyAssociation.DefaultNonMandatoryNonDistinctPolicyIds...)
+	if accountPolicyAssociation.DefaultWafPolicyId != 0 {
+		defaultPolicyIDs = append(defaultPolicyIDs, accountPolicyAssociation.DefaultWafPolicyId)
+	}
+	for _, policyID := range defaultPolicyIDs {
+		if seenPolicyIDs[policyID] {
+			continue
+		}
+		policy := policiesByID[policyID]
+		if policyID == accountPolicyAssociation.DefaultWafPolicyId {
+			if hasDirectWAFPolicy {
+				continue
+			}
+			policy.PolicyType = wafRulesPolicyType
+		}
+		effectivePolicies = append(effectivePolicies, EffectivePolicy{
+			PolicyID:   policyID,
+			Name:       policy.Name,
+			PolicyType: policy.PolicyType,
+			Source:     EffectivePolicySourceInherited,
+		})
+		seenPolicyIDs[policyID] = true
+	}
+
+	return effectivePolicies
+}
@@ -0,0 +1,157 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// GetEffectiveSitePolicies Tests
+////////////////////////////////////////////////////////////////
+
+const effectivePoliciesAccountPolicies = `{"value":[
+	{"id":11,"name":"Site ACL","policyType":"ACL"},
+	{"id":12,"name":"Site WAF","policyType":"WAF_RULES"},
+	{"id":21,"name":"Default Whitelist","policyType":"WHITELIST"},
+	{"id":22,"name":"Default WAF","policyType":"WAF_RULES"}],"isError":false}`
+
+func effectivePoliciesServer(t *testing.T, sitePolicies, accountAssociation string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.String() {
+		case "/policies/v2/assets/WEBSITE/42/policies?caid=7":
+			rw.Write([]byte(sitePolicies))
+		case "/policies/v3/accounts/associated-policies?caid=7":
+			rw.Write([]byte(accountAssociation))
+		case "/policies/v2/policies?caid=7&extended=true":
+			rw.Write([]byte(effectivePoliciesAccountPolicies))
+		default:
+			t.Errorf("Unexpected request: %s", req.URL.String())
+		}
+	}))
+}
+
+func getEffectiveSitePolicies(t *testing.T, sitePolicies, accountAssociation string) []EffectivePolicy {
+	server := effectivePoliciesServer(t, sitePolicies, accountAssociation)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	accountID := 7
+	effectivePolicies, err := client.GetEffectiveSitePolicies(42, &accountID)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	return effectivePolicies
+}
+
+func TestClientGetEffectiveSitePoliciesDirectOnly(t *testing.T) {
+	effectivePolicies := getEffectiveSitePolicies(t,
+		`{"value":[{"id":11,"name":"Site ACL","policyType":"ACL"},{"id":12,"name":"Site WAF","policyType":"WAF_RULES"}],"isError":false}`,
+		`{"data":[{"accountId":7,"availablePolicyIds":[11,12,21,22],"defaultNonMandatoryNonDistinctPolicyIds":[]}]}`)
+
+	expected := []EffectivePolicy{
+		{PolicyID: 11, Name: "Site ACL", PolicyType: "ACL", Source: EffectivePolicySourceDirect},
+		{PolicyID: 12, Name: "Site WAF", PolicyType: "WAF_RULES", Source: EffectivePolicySourceDirect},
+	}
+	if !reflect.DeepEqual(effectivePolicies, expected) {
+		t.Errorf("Unexpected effective policies, expected %+v, got: %+v", expected, effectivePolicies)
+	}
+}
+
+func TestClientGetEffectiveSitePoliciesInheritedOnly(t *testing.T) {
+	effectivePolicies := getEffectiveSitePolicies(t,
+		`{"value":[],"isError":false}`,
+		`{"data":[{"accountId":7,"availablePolicyIds":[11,12,21,22],"defaultNonMandatoryNonDistinctPolicyIds":[21],"defaultWafPolicyId":22}]}`)
+
+	expected := []EffectivePolicy{
+		{PolicyID: 21, Name: "Default Whitelist", PolicyType: "WHITELIST", Source: EffectivePolicySourceInherited},
+		{PolicyID: 22, Name: "Default WAF", PolicyType: "WAF_RULES", Source: EffectivePolicySourceInherited},
+	}
+	if !reflect.DeepEqual(effectivePolicies, expected) {
+		t.Errorf("Unexpected effective policies, expected %+v, got: %+v", expected, effectivePolicies)
+	}
+}
+
+func TestClientGetEffectiveSitePoliciesDirectAndInherited(t *testing.T) {
+	// The site's own WAF policy overrides the account default one, and a default that's also directly associated is
+	// only reported once
+	effectivePolicies := getEffectiveSitePolicies(t,
+		`{"value":[{"id":12,"name":"Site WAF","policyType":"WAF_RULES"},{"id":21,"name":"Default Whitelist","policyType":"WHITELIST"}],"isError":false}`,
+		`{"data":[{"accountId":7,"availablePolicyIds":[11,12,21,22],"defaultNonMandatoryNonDistinctPolicyIds":[11,21],"defaultWafPolicyId":22}]}`)
+
+	expected := []EffectivePolicy{
+		{PolicyID: 12, Name: "Site WAF", PolicyType: "WAF_RULES", Source: EffectivePolicySourceDirect},
+		{PolicyID: 21, Name: "Default Whitelist", PolicyType: "WHITELIST", Source: EffectivePolicySourceDirect},
+		{PolicyID: 11, Name: "Site ACL", PolicyType: "ACL", Source: EffectivePolicySourceInherited},
+	}
+	if !reflect.DeepEqual(effectivePolicies, expected) {
+		t.Errorf("Unexpected effective policies, expected %+v, got: %+v", expected, effectivePolicies)
+	}
+}
+
+func TestClientGetEffectiveSitePoliciesBadStatusCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(500)
+		rw.Write([]byte(`{"isError":true}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	accountID := 7
+	_, err := client.GetEffectiveSitePolicies(42, &accountID)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if err.Error() != fmt.Sprintf("[ERROR] Error status code 500 from Incapsula service when reading Policies for site_id 42: %s", `{"isError":true}`) {
+		t.Errorf("Unexpected error, got: %s", err)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// SiteHasWafPolicy Tests
+////////////////////////////////////////////////////////////////
+
+func siteHasWafPolicy(t *testing.T, sitePolicies, accountAssociation string) bool {
+	server := effectivePoliciesServer(t, sitePolicies, accountAssociation)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	accountID := 7
+	hasWafPolicy, err := client.SiteHasWafPolicy(42, &accountID)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	return hasWafPolicy
+}
+
+func TestClientSiteHasWafPolicyDirect(t *testing.T) {
+	hasWafPolicy := siteHasWafPolicy(t,
+		`{"value":[{"id":12,"name":"Site WAF","policyType":"WAF_RULES"}],"isError":false}`,
+		`{"data":[{"accountId":7,"defaultNonMandatoryNonDistinctPolicyIds":[]}]}`)
+	if !hasWafPolicy {
+		t.Errorf("Should have found the directly associated WAF policy")
+	}
+}
+
+func TestClientSiteHasWafPolicyInherited(t *testing.T) {
+	hasWafPolicy := siteHasWafPolicy(t,
+		`{"value":[{"id":11,"name":"Site ACL","policyType":"ACL"}],"isError":false}`,
+		`{"data":[{"accountId":7,"defaultNonMandatoryNonDistinctPolicyIds":[],"defaultWafPolicyId":22}]}`)
+	if !hasWafPolicy {
+		t.Errorf("Should have found the account default WAF policy")
+	}
+}
+
+func TestClientSiteHasWafPolicyNone(t *testing.T) {
+	hasWafPolicy := siteHasWafPolicy(t,
+		`{"value":[{"id":11,"name":"Site ACL","policyType":"ACL"}],"isError":false}`,
+		`{"data":[{"accountId":7,"defaultNonMandatoryNonDistinctPolicyIds":[21]}]}`)
+	if hasWafPolicy {
+		t.Errorf("Should not have found a WAF policy")
+	}
+}
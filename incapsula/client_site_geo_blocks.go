@@ -0,0 +1,36 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"sort"
+)
+
+// GetSiteGeoBlocks gets the countries and continents blocked by the blacklisted countries ACL rule of a site, sorted.
+// Both are empty when the site doesn't block any geo location.
+func (c *Client) GetSiteGeoBlocks(siteID int) (*SecurityRuleGeo, error) {
+	log.Printf("[INFO] Getting Incapsula geo blocks for site_id: %d\n", siteID)
+
+	siteStatusResponse, err := c.SiteStatusFields(siteID, []string{"security"})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting geo blocks for site_id %d: %s", siteID, err)
+	}
+
+	geoBlocks := siteGeoBlocks(siteStatusResponse)
+	return &geoBlocks, nil
+}
+
+// siteGeoBlocks returns the countries and continents of the blacklisted countries ACL rule of a site status, sorted
+func siteGeoBlocks(siteStatusResponse *SiteStatusResponse) SecurityRuleGeo {
+	geoBlocks := SecurityRuleGeo{Countries: []string{}, Continents: []string{}}
+	for _, rule := range siteStatusResponse.Security.Acls.Rules {
+		if rule.ID != blacklistedCountriesExceptionRuleID {
+			continue
+		}
+		geoBlocks.Countries = append(geoBlocks.Countries, rule.Geo.Countries...)
+		geoBlocks.Continents = append(geoBlocks.Continents, rule.Geo.Continents...)
+	}
+	sort.Strings(geoBlocks.Countries)
+	sort.Strings(geoBlocks.Continents)
+	return geoBlocks
+}
@@ -0,0 +1,54 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// siteGeoBlocks Tests
+////////////////////////////////////////////////////////////////
+
+func TestSiteGeoBlocks(t *testing.T) {
+	var siteStatusResponse SiteStatusResponse
+	err := json.Unmarshal([]byte(`{"res":0,"security":{"acls":{"rules":[
+		{"id":"api.acl.blacklisted_ips","ips":["1.2.3.4"]},
+		{"id":"api.acl.blacklisted_countries","geo":{"countries":["RU","KP","IR"],"continents":["AN","AF"]},"exceptions":[{"values":[{"id":"api.rule_exception_type.client_ip","ips":["5.6.7.8"]}],"id":1}]}]}}}`), &siteStatusResponse)
+	if err != nil {
+		t.Fatalf("Failed to parse site status: %s", err)
+	}
+
+	geoBlocks := siteGeoBlocks(&siteStatusResponse)
+	expected := SecurityRuleGeo{Countries: []string{"IR", "KP", "RU"}, Continents: []string{"AF", "AN"}}
+	if !reflect.DeepEqual(geoBlocks, expected) {
+		t.Errorf("Unexpected geo blocks, expected %+v, got: %+v", expected, geoBlocks)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetSiteGeoBlocks Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetSiteGeoBlocksNoGeoBlocks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		if req.PostForm.Get("fields") != "security" {
+			t.Errorf("Expected fields to be security, got: %s", req.PostForm.Get("fields"))
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0,"security":{"acls":{"rules":[]}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	geoBlocks, err := client.GetSiteGeoBlocks(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(geoBlocks.Countries) != 0 || len(geoBlocks.Continents) != 0 {
+		t.Errorf("Should not have received geo blocks, got: %+v", geoBlocks)
+	}
+}
@@ -0,0 +1,164 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const endpointSiteList = "sites/list"
+
+const siteListPageSize = 100
+
+// SiteListResponse contains a page of the sites of an account
+type SiteListResponse struct {
+	Sites      []SiteStatusResponse `json:"sites"`
+	Res        interface{}          `json:"res"`
+	ResMessage string               `json:"res_message"`
+	DebugInfo  DebugInfo            `json:"debug_info"`
+}
+
+// ListSites gets all the sites of an account, going through all the pages of the list. When accountID is 0 the sites
+// of the account identified by the authentication parameters are listed. When createdAfter isn't zero, only the sites
+// created after it are returned; the list API can't filter on the creation date, so it's done here.
+func (c *Client) ListSites(accountID int, createdAfter time.Time) ([]SiteStatusResponse, error) {
+	log.Printf("[INFO] Listing Incapsula sites of account: %d\n", accountID)
+
+	sites := make([]SiteStatusResponse, 0)
+	for pageNum := 0; ; pageNum++ {
+		values := url.Values{
+			"page_size": {strconv.Itoa(siteListPageSize)},
+			"page_num":  {strconv.Itoa(pageNum)},
+		}
+		if accountID != 0 {
+			values.Set("account_id", strconv.Itoa(accountID))
+		}
+		var siteListResponse SiteListResponse
+		responseBody, err := c.postFormAndDecode(c.endpointURL(endpointSiteList), values, ReadSiteList, &siteListResponse)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing sites of account %d: %s", accountID, err)
+		}
+
+		var resString string
+		if resNumber, ok := siteListResponse.Res.(float64); ok {
+			resString = fmt.Sprintf("%d", int(resNumber))
+		} else {
+			resString, _ = siteListResponse.Res.(string)
+		}
+		if resString != "0" {
+			return nil, newIncapsulaError(resString, siteListResponse.DebugInfo, "Error from Incapsula service when listing sites of account %d: %s", accountID, string(responseBody))
+		}
+
+		for _, site := range siteListResponse.Sites {
+			if createdAfter.IsZero() || siteCreationTime(&site).After(createdAfter) {
+				sites = append(sites, site)
+			}
+		}
+		if len(siteListResponse.Sites) < siteListPageSize {
+			return sites, nil
+		}
+	}
+}
+
+// FindSiteByRefID returns the site whose ref_id matches refID. It's an error when no site or more than one site matches.
+func (c *Client) FindSiteByRefID(refID string, accountID *int) (*SiteStatusResponse, error) {
+	log.Printf("[INFO] Finding Incapsula site with ref_id: %s\n", refID)
+
+	listAccountID := 0
+	if accountID != nil {
+		listAccountID = *accountID
+	}
+	sites, err := c.ListSites(listAccountID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]SiteStatusResponse, 0, 1)
+	for _, site := range sites {
+		if site.RefID == refID {
+			matches = append(matches, site)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("Error - no site found with ref_id %s", refID)
+	case 1:
+		return &matches[0], nil
+	default:
+		candidates := make([]string, 0, len(matches))
+		for _, site := range matches {
+			candidates = append(candidates, fmt.Sprintf("%d (%s)", site.SiteID, site.Domain))
+		}
+		return nil, fmt.Errorf("Error - %d sites found with ref_id %s: %s", len(matches), refID, strings.Join(candidates, ", "))
+	}
+}
+
+// FindSiteByDomain returns the site of an account with the given domain, compared case-insensitively. It's an error
+// when no site or more than one site matches. When accountID is 0 the sites of the account identified by the
+// authentication parameters are searched.
+func (c *Client) FindSiteByDomain(domain string, accountID int) (*SiteStatusResponse, error) {
+	log.Printf("[INFO] Finding Incapsula site with domain: %s\n", domain)
+
+	sites, err := c.ListSites(accountID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]SiteStatusResponse, 0, 1)
+	for _, site := range sites {
+		if strings.EqualFold(site.Domain, domain) {
+			matches = append(matches, site)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("Error - no site found with domain %s", domain)
+	case 1:
+		return &matches[0], nil
+	default:
+		siteIDs := make([]string, 0, len(matches))
+		for _, site := range matches {
+			siteIDs = append(siteIDs, strconv.Itoa(site.SiteID))
+		}
+		return nil, fmt.Errorf("Error - %d sites found with domain %s: %s", len(matches), domain, strings.Join(siteIDs, ", "))
+	}
+}
+
+// FindDuplicateSites returns the domains of an account that have more than one site, with their site ids in ascending
+// order. Domains are compared case-insensitively and returned in lower case. Duplicates are typically left behind by
+// failed create attempts that still added the site.
+func (c *Client) FindDuplicateSites(accountID int) (map[string][]int, error) {
+	log.Printf("[INFO] Finding Incapsula duplicate sites of account: %d\n", accountID)
+
+	sites, err := c.ListSites(accountID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	siteIDsByDomain := make(map[string][]int)
+	for _, site := range sites {
+		domain := strings.ToLower(site.Domain)
+		siteIDsByDomain[domain] = append(siteIDsByDomain[domain], site.SiteID)
+	}
+
+	duplicates := make(map[string][]int)
+	for domain, siteIDs := range siteIDsByDomain {
+		if len(siteIDs) > 1 {
+			sort.Ints(siteIDs)
+			duplicates[domain] = siteIDs
+		}
+	}
+
+	return duplicates, nil
+}
+
+// siteCreationTime returns the creation time of a site. site_creation_date is in milliseconds since the epoch.
+func siteCreationTime(site *SiteStatusResponse) time.Time {
+	return time.Unix(0, site.SiteCreationDate*int64(time.Millisecond)).UTC()
+}
@@ -0,0 +1,130 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// ListSites Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientListSitesCreatedAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		// 2022-04-30T23:00:00Z, 2022-05-01T01:00:00Z and 2022-05-02T00:00:00Z, in milliseconds
+		rw.Write([]byte(`{"sites":[{"site_id":1,"domain":"www.a.com","site_creation_date":1651359600000},{"site_id":2,"domain":"www.b.com","site_creation_date":1651366800000},{"site_id":3,"domain":"www.c.com","site_creation_date":1651449600000}],"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	sites, err := client.ListSites(0, time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(sites) != 2 || sites[0].SiteID != 2 || sites[1].SiteID != 3 {
+		t.Errorf("Should only have listed the sites created after the cutoff, got: %+v", sites)
+	}
+
+	sites, err = client.ListSites(0, time.Time{})
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(sites) != 3 {
+		t.Errorf("Should have listed all the sites without a cutoff, got: %d", len(sites))
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// FindSiteByRefID Tests
+////////////////////////////////////////////////////////////////
+
+func findSiteByRefIDClient(t *testing.T) (*Client, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteList) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteList, req.URL.String())
+		}
+		req.ParseForm()
+		if req.PostForm.Get("account_id") != "7" {
+			t.Errorf("Expected account_id to be 7, got: %s", req.PostForm.Get("account_id"))
+		}
+		rw.Write([]byte(`{"sites":[{"site_id":1,"domain":"www.a.com","ref_id":"ASSET-1"},{"site_id":2,"domain":"www.b.com","ref_id":"ASSET-2"},{"site_id":3,"domain":"www.c.com","ref_id":"ASSET-2"}],"res":0}`))
+	}))
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	return &Client{config: config, httpClient: &http.Client{}}, server
+}
+
+func TestClientFindSiteByRefIDHit(t *testing.T) {
+	client, server := findSiteByRefIDClient(t)
+	defer server.Close()
+
+	accountID := 7
+	site, err := client.FindSiteByRefID("ASSET-1", &accountID)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if site.SiteID != 1 || site.Domain != "www.a.com" {
+		t.Errorf("Should have found site 1, got: %d (%s)", site.SiteID, site.Domain)
+	}
+}
+
+func TestClientFindSiteByRefIDMiss(t *testing.T) {
+	client, server := findSiteByRefIDClient(t)
+	defer server.Close()
+
+	accountID := 7
+	_, err := client.FindSiteByRefID("ASSET-9", &accountID)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if err.Error() != "Error - no site found with ref_id ASSET-9" {
+		t.Errorf("Should have received a not found error, got: %s", err)
+	}
+}
+
+func TestClientFindSiteByRefIDAmbiguous(t *testing.T) {
+	client, server := findSiteByRefIDClient(t)
+	defer server.Close()
+
+	accountID := 7
+	_, err := client.FindSiteByRefID("ASSET-2", &accountID)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasSuffix(err.Error(), "2 sites found with ref_id ASSET-2: 2 (www.b.com), 3 (www.c.com)") {
+		t.Errorf("Should have received an error listing the candidates, got: %s", err)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// FindDuplicateSites Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientFindDuplicateSites(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		if req.PostForm.Get("account_id") != "7" {
+			t.Errorf("Expected account_id to be 7, got: %s", req.PostForm.Get("account_id"))
+		}
+		rw.Write([]byte(`{"sites":[{"site_id":5,"domain":"www.a.com"},{"site_id":2,"domain":"www.b.com"},{"site_id":3,"domain":"WWW.A.COM"}],"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	duplicates, err := client.FindDuplicateSites(7)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	expected := map[string][]int{"www.a.com": {3, 5}}
+	if !reflect.DeepEqual(duplicates, expected) {
+		t.Errorf("Should only have reported www.a.com, expected %v, got: %v", expected, duplicates)
+	}
+}
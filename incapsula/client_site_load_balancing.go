@@ -0,0 +1,79 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// Load balancing algorithms between the data centers of a site
+const (
+	SiteLbAlgorithmBestConnectionTime = "BEST_CONNECTION_TIME"
+	SiteLbAlgorithmGeoPreferred       = "GEO_PREFERRED"
+	SiteLbAlgorithmGeoRequired        = "GEO_REQUIRED"
+	SiteLbAlgorithmWeighted           = "WEIGHTED_LB"
+)
+
+var siteLbAlgorithms = []string{SiteLbAlgorithmBestConnectionTime, SiteLbAlgorithmGeoPreferred, SiteLbAlgorithmGeoRequired, SiteLbAlgorithmWeighted}
+
+// SetLoadBalancingAlgorithm sets how the traffic of a site is balanced between its data centers. The rest of the data
+// centers configuration is kept, so the data centers must already be set up for the algorithm: weights for WEIGHTED_LB
+// and geo locations for GEO_PREFERRED and GEO_REQUIRED.
+func (c *Client) SetLoadBalancingAlgorithm(siteID int, algorithm string) error {
+	log.Printf("[INFO] Setting Incapsula load balancing algorithm (%s) for site_id: %d\n", algorithm, siteID)
+
+	if !contains(siteLbAlgorithms, algorithm) {
+		return fmt.Errorf("Error - invalid load balancing algorithm (%s), must be one of %v", algorithm, siteLbAlgorithms)
+	}
+
+	siteIDStr := strconv.Itoa(siteID)
+	dataCentersConfiguration, err := c.getDataCentersConfiguration(siteIDStr)
+	if err != nil {
+		return fmt.Errorf("Error reading data centers configuration before setting load balancing algorithm for site_id %d: %s", siteID, err)
+	}
+	if dataCentersConfiguration.SiteLbAlgorithm == algorithm {
+		return nil
+	}
+
+	dataCentersConfiguration.SiteLbAlgorithm = algorithm
+	requestDTO := DataCentersConfigurationDTO{Data: []DataCentersStruct{*dataCentersConfiguration}}
+	responseDTO, err := c.PutDataCentersConfiguration(siteIDStr, requestDTO)
+	if err != nil {
+		return fmt.Errorf("Error setting load balancing algorithm (%s) for site_id %d: %s", algorithm, siteID, err)
+	}
+	if len(responseDTO.Errors) > 0 {
+		out, _ := json.Marshal(responseDTO.Errors)
+		return fmt.Errorf("Error from Incapsula service when setting load balancing algorithm (%s) for site_id %d: %s", algorithm, siteID, string(out))
+	}
+
+	return nil
+}
+
+// GetLoadBalancingAlgorithm gets how the traffic of a site is balanced between its data centers
+func (c *Client) GetLoadBalancingAlgorithm(siteID int) (string, error) {
+	log.Printf("[INFO] Getting Incapsula load balancing algorithm for site_id: %d\n", siteID)
+
+	dataCentersConfiguration, err := c.getDataCentersConfiguration(strconv.Itoa(siteID))
+	if err != nil {
+		return "", fmt.Errorf("Error getting load balancing algorithm for site_id %d: %s", siteID, err)
+	}
+
+	return dataCentersConfiguration.SiteLbAlgorithm, nil
+}
+
+// getDataCentersConfiguration gets the data centers configuration of a site, turning the errors of the response into an error
+func (c *Client) getDataCentersConfiguration(siteID string) (*DataCentersStruct, error) {
+	responseDTO, err := c.GetDataCentersConfiguration(siteID)
+	if err != nil {
+		return nil, err
+	}
+	if len(responseDTO.Errors) > 0 {
+		out, _ := json.Marshal(responseDTO.Errors)
+		return nil, fmt.Errorf("%s", string(out))
+	}
+	if len(responseDTO.Data) == 0 {
+		return nil, fmt.Errorf("no data centers configuration")
+	}
+	return &responseDTO.Data[0], nil
+}
@@ -0,0 +1,124 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetLoadBalancingAlgorithm Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetLoadBalancingAlgorithm(t *testing.T) {
+	for _, algorithm := range siteLbAlgorithms[1:] {
+		var putBody []byte
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if req.URL.String() != "/api/prov/v3/sites/42/data-centers-configuration" {
+				t.Errorf("Should have have hit /api/prov/v3/sites/42/data-centers-configuration endpoint. Got: %s", req.URL.String())
+			}
+			if req.Method == http.MethodPut {
+				putBody, _ = ioutil.ReadAll(req.Body)
+			}
+			rw.Write([]byte(`{"data":[{"lbAlgorithm":"BEST_CONNECTION_TIME","dataCenterMode":"MULTIPLE_DC","failOverRequiredMonitors":"MOST","dataCenters":[{"name":"dc1","lbAlgorithm":"WEIGHTED","servers":[{"address":"1.2.3.4","isEnabled":true}]}]}]}`))
+		}))
+
+		config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL + "/api/prov/v1"}
+		client := &Client{config: config, httpClient: &http.Client{}}
+		err := client.SetLoadBalancingAlgorithm(42, algorithm)
+		server.Close()
+		if err != nil {
+			t.Errorf("Should not have received an error for %s, got: %s", algorithm, err)
+			continue
+		}
+
+		var requestDTO DataCentersConfigurationDTO
+		err = json.Unmarshal(putBody, &requestDTO)
+		if err != nil || len(requestDTO.Data) != 1 {
+			t.Errorf("Should have sent the data centers configuration for %s, got: %s", algorithm, string(putBody))
+			continue
+		}
+		sent := requestDTO.Data[0]
+		if sent.SiteLbAlgorithm != algorithm {
+			t.Errorf("Expected lbAlgorithm to be %s, got: %s", algorithm, sent.SiteLbAlgorithm)
+		}
+		if sent.DataCenterMode != "MULTIPLE_DC" || len(sent.DataCenters) != 1 || sent.DataCenters[0].DcLbAlgorithm != "WEIGHTED" {
+			t.Errorf("Should have kept the rest of the data centers configuration for %s, got: %s", algorithm, string(putBody))
+		}
+	}
+}
+
+func TestClientSetLoadBalancingAlgorithmUnchanged(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			t.Errorf("Should not have updated an unchanged load balancing algorithm, got: %s", req.Method)
+		}
+		rw.Write([]byte(`{"data":[{"lbAlgorithm":"BEST_CONNECTION_TIME"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL + "/api/prov/v1"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetLoadBalancingAlgorithm(42, SiteLbAlgorithmBestConnectionTime)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetLoadBalancingAlgorithmInvalid(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetLoadBalancingAlgorithm(42, "ROUND_ROBIN")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error - invalid load balancing algorithm (ROUND_ROBIN)") {
+		t.Errorf("Should have received an invalid algorithm error, got: %s", err)
+	}
+}
+
+func TestClientSetLoadBalancingAlgorithmErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodPut {
+			rw.Write([]byte(`{"errors":[{"status":"400","message":"Weights must sum up to 100"}]}`))
+			return
+		}
+		rw.Write([]byte(`{"data":[{"lbAlgorithm":"BEST_CONNECTION_TIME"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL + "/api/prov/v1"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetLoadBalancingAlgorithm(42, SiteLbAlgorithmWeighted)
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error from Incapsula service when setting load balancing algorithm (%s) for site_id 42", SiteLbAlgorithmWeighted)) {
+		t.Errorf("Should have received an error response error, got: %s", err)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetLoadBalancingAlgorithm Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetLoadBalancingAlgorithm(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"lbAlgorithm":"GEO_REQUIRED"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL + "/api/prov/v1"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	algorithm, err := client.GetLoadBalancingAlgorithm(42)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if algorithm != SiteLbAlgorithmGeoRequired {
+		t.Errorf("Expected algorithm to be %s, got: %s", SiteLbAlgorithmGeoRequired, algorithm)
+	}
+}
@@ -0,0 +1,52 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+)
+
+const endpointSiteMove = "sites/moveSite"
+
+// WithAccount returns a client adding sites to accountID when AddSite isn't given an account, so the account of a site
+// can be chosen where the client is set up rather than at every call. The original client is left unchanged.
+func (c *Client) WithAccount(accountID int) *Client {
+	accountClient := *c
+	accountClient.accountID = accountID
+	return &accountClient
+}
+
+// MoveSite moves a site to another account. The site keeps its id and its settings. Both accounts must be under the
+// same parent account.
+func (c *Client) MoveSite(siteID, destinationAccountID int) error {
+	type SiteMoveResponse struct {
+		Res        interface{} `json:"res"`
+		ResMessage string      `json:"res_message"`
+		DebugInfo  DebugInfo   `json:"debug_info"`
+	}
+
+	log.Printf("[INFO] Moving Incapsula site_id %d to account %d\n", siteID, destinationAccountID)
+
+	values := url.Values{
+		"site_id":                {strconv.Itoa(siteID)},
+		"destination_account_id": {strconv.Itoa(destinationAccountID)},
+	}
+	var siteMoveResponse SiteMoveResponse
+	responseBody, err := c.postFormAndDecode(c.endpointURL(endpointSiteMove), values, MoveSite, &siteMoveResponse)
+	if err != nil {
+		return fmt.Errorf("Error moving site_id %d to account %d: %s", siteID, destinationAccountID, err)
+	}
+
+	var resString string
+	if resNumber, ok := siteMoveResponse.Res.(float64); ok {
+		resString = fmt.Sprintf("%d", int(resNumber))
+	} else {
+		resString, _ = siteMoveResponse.Res.(string)
+	}
+	if resString != "0" {
+		return newIncapsulaError(resString, siteMoveResponse.DebugInfo, "Error from Incapsula service when moving site_id %d to account %d: %s", siteID, destinationAccountID, string(responseBody))
+	}
+
+	return nil
+}
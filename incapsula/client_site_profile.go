@@ -0,0 +1,149 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+)
+
+const extendedDDoSParam = "extended_ddos"
+
+// Site profile names
+const (
+	SiteProfileStrict      = "strict"
+	SiteProfileBalanced    = "balanced"
+	SiteProfilePerformance = "performance"
+	SiteProfileCustom      = "custom"
+)
+
+// SiteProfile bundles the DDoS and acceleration settings applied to a site during onboarding. DDoSThreshold is only
+// used when DDoSAuto is false, see SetDDoSMode.
+type SiteProfile struct {
+	Name              string
+	ExtendedDDoS      int
+	DDoSAuto          bool
+	DDoSThreshold     int
+	AccelerationLevel string
+}
+
+// Built-in site profiles
+var siteProfiles = map[string]SiteProfile{
+	SiteProfileStrict:      {Name: SiteProfileStrict, ExtendedDDoS: 3600, DDoSAuto: false, DDoSThreshold: 200, AccelerationLevel: "standard"},
+	SiteProfileBalanced:    {Name: SiteProfileBalanced, ExtendedDDoS: 1800, DDoSAuto: true, AccelerationLevel: "standard"},
+	SiteProfilePerformance: {Name: SiteProfilePerformance, ExtendedDDoS: 0, DDoSAuto: true, AccelerationLevel: "aggressive"},
+}
+
+// GetSiteProfile returns a built-in site profile. Custom profiles are built by setting the fields of a SiteProfile
+// named SiteProfileCustom.
+func GetSiteProfile(name string) (SiteProfile, error) {
+	profile, ok := siteProfiles[name]
+	if !ok {
+		return SiteProfile{}, fmt.Errorf("Error - unknown site profile (%s), must be one of %s, %s or %s", name, SiteProfileStrict, SiteProfileBalanced, SiteProfilePerformance)
+	}
+	return profile, nil
+}
+
+// siteProfileStep is a single setting of a site profile, along with how to restore its previous value
+type siteProfileStep struct {
+	name     string
+	apply    func() error
+	rollback func() error
+}
+
+// ApplySiteProfile applies the settings of a profile to a site one after the other. When a setting fails, the
+// settings already applied are restored to their previous values, in reverse order.
+func (c *Client) ApplySiteProfile(siteID int, profile SiteProfile) error {
+	log.Printf("[INFO] Applying Incapsula site profile (%s) to site_id: %d\n", profile.Name, siteID)
+
+	err := validateSiteProfile(siteID, profile)
+	if err != nil {
+		return err
+	}
+
+	siteStatusResponse, err := c.SiteStatus("site-profile-apply", siteID)
+	if err != nil {
+		return fmt.Errorf("Error reading site_id %d before applying site profile (%s): %s", siteID, profile.Name, err)
+	}
+
+	siteIDStr := strconv.Itoa(siteID)
+	previousAccelerationLevel := siteStatusResponse.AccelerationLevelRaw
+	previousExtendedDDoS := strconv.Itoa(siteStatusResponse.ExtendedDdos)
+	previousActivationMode, previousThreshold := ddosRuleIDDefaultActivationMode, ddosRuleIDDefaultDDOSTrafficThreshold
+	for _, rule := range siteStatusResponse.Security.Waf.Rules {
+		if rule.ID == ddosRuleID {
+			previousActivationMode, previousThreshold = rule.ActivationMode, strconv.Itoa(rule.DdosTrafficThreshold)
+		}
+	}
+
+	steps := []siteProfileStep{
+		{
+			name: "acceleration_level",
+			apply: func() error {
+				_, err := c.UpdateSite(siteIDStr, "acceleration_level", profile.AccelerationLevel)
+				return err
+			},
+			rollback: func() error {
+				_, err := c.UpdateSite(siteIDStr, "acceleration_level", previousAccelerationLevel)
+				return err
+			},
+		},
+		{
+			name: extendedDDoSParam,
+			apply: func() error {
+				_, err := c.UpdateSite(siteIDStr, extendedDDoSParam, strconv.Itoa(profile.ExtendedDDoS))
+				return err
+			},
+			rollback: func() error {
+				_, err := c.UpdateSite(siteIDStr, extendedDDoSParam, previousExtendedDDoS)
+				return err
+			},
+		},
+		{
+			name: "ddos",
+			apply: func() error {
+				return c.SetDDoSMode(siteID, profile.DDoSAuto, profile.DDoSThreshold)
+			},
+			rollback: func() error {
+				_, err := c.ConfigureWAFSecurityRule(siteID, ddosRuleID, "", previousActivationMode, previousThreshold, "", "")
+				return err
+			},
+		},
+	}
+
+	for i, step := range steps {
+		err := step.apply()
+		if err == nil {
+			continue
+		}
+
+		log.Printf("[ERROR] Could not apply %s of site profile (%s) to site_id %d, rolling back: %s\n", step.name, profile.Name, siteID, err)
+		rollbackErrors := make([]string, 0)
+		for j := i - 1; j >= 0; j-- {
+			rollbackErr := steps[j].rollback()
+			if rollbackErr != nil {
+				rollbackErrors = append(rollbackErrors, fmt.Sprintf("%s: %s", steps[j].name, rollbackErr))
+			}
+		}
+		if len(rollbackErrors) > 0 {
+			return fmt.Errorf("Error applying %s of site profile (%s) to site_id %d: %s\nrollback failed, the site is partially updated: %s", step.name, profile.Name, siteID, err, strings.Join(rollbackErrors, "; "))
+		}
+		return fmt.Errorf("Error applying %s of site profile (%s) to site_id %d, the previous settings were restored: %s", step.name, profile.Name, siteID, err)
+	}
+
+	return nil
+}
+
+// validateSiteProfile checks all the settings of a profile before any of them is applied
+func validateSiteProfile(siteID int, profile SiteProfile) error {
+	err := ValidateSiteConfigParam("acceleration_level", profile.AccelerationLevel)
+	if err != nil {
+		return err
+	}
+	err = ValidateSiteConfigParam(extendedDDoSParam, strconv.Itoa(profile.ExtendedDDoS))
+	if err != nil {
+		return err
+	}
+	_, err = ddosModeValues(siteID, profile.DDoSAuto, profile.DDoSThreshold)
+	return err
+}
@@ -0,0 +1,97 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const siteProfileTestStatus = `{"site_id":42,"res":0,"acceleration_level_raw":"none","extended_ddos":0,
+	"security":{"waf":{"rules":[{"id":"api.threats.ddos","activation_mode":"api.threats.ddos.activation_mode.auto","ddos_traffic_threshold":1000}]}}}`
+
+// newSiteProfileTestServer records the site updates and fails the update of failParam
+func newSiteProfileTestServer(t *testing.T, updates *[]string, failParam string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		switch req.URL.String() {
+		case fmt.Sprintf("/%s", endpointSiteStatus):
+			rw.Write([]byte(siteProfileTestStatus))
+		case fmt.Sprintf("/%s", endpointSiteUpdate):
+			*updates = append(*updates, fmt.Sprintf("%s=%s", req.PostForm.Get("param"), req.PostForm.Get("value")))
+			if req.PostForm.Get("param") == failParam {
+				rw.Write([]byte(`{"res":1,"res_message":"Unexpected error"}`))
+				return
+			}
+			rw.Write([]byte(`{"site_id":42,"res":0}`))
+		case fmt.Sprintf("/%s", endpointWAFRuleConfigure):
+			*updates = append(*updates, fmt.Sprintf("ddos=%s/%s", req.PostForm.Get("activation_mode"), req.PostForm.Get("ddos_traffic_threshold")))
+			rw.Write([]byte(`{"res":0}`))
+		default:
+			t.Errorf("Unexpected request to %s", req.URL.String())
+		}
+	}))
+}
+
+////////////////////////////////////////////////////////////////
+// ApplySiteProfile Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientApplySiteProfile(t *testing.T) {
+	updates := make([]string, 0)
+	server := newSiteProfileTestServer(t, &updates, "")
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	profile, err := GetSiteProfile(SiteProfileStrict)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	err = client.ApplySiteProfile(42, profile)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	expected := []string{"acceleration_level=standard", "extended_ddos=3600", "ddos=api.threats.ddos.activation_mode.on/200"}
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("Unexpected updates, expected %v, got: %v", expected, updates)
+	}
+}
+
+func TestClientApplySiteProfileRollback(t *testing.T) {
+	updates := make([]string, 0)
+	server := newSiteProfileTestServer(t, &updates, extendedDDoSParam)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	profile := SiteProfile{Name: SiteProfileCustom, ExtendedDDoS: 600, DDoSAuto: false, DDoSThreshold: 500, AccelerationLevel: "aggressive"}
+	err := client.ApplySiteProfile(42, profile)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error applying extended_ddos of site profile (custom) to site_id 42, the previous settings were restored") {
+		t.Errorf("Should have received a rolled back error, got: %v", err)
+	}
+
+	// The DDoS rule is never reached and the acceleration level is restored
+	expected := []string{"acceleration_level=aggressive", "extended_ddos=600", "acceleration_level=none"}
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("Unexpected updates, expected %v, got: %v", expected, updates)
+	}
+}
+
+func TestClientApplySiteProfileInvalidProfile(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	profile := SiteProfile{Name: SiteProfileCustom, DDoSAuto: true, DDoSThreshold: 500, AccelerationLevel: "standard"}
+	err := client.ApplySiteProfile(42, profile)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - a DDoS traffic threshold (500) can't be set") {
+		t.Errorf("Should have received an invalid profile error, got: %v", err)
+	}
+
+	_, err = GetSiteProfile("paranoid")
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - unknown site profile (paranoid)") {
+		t.Errorf("Should have received an unknown profile error, got: %v", err)
+	}
+}
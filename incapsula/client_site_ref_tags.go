@@ -0,0 +1,83 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Ref tags are plain tags stored in the site's ref_id separated by ','. They can't contain the separators of the
+// key/value site tags, so a ref_id never decodes as both.
+const (
+	siteRefTagsSeparator = ","
+	siteRefIDMaxLength   = 255
+)
+
+// SetSiteRefTags replaces the ref tags of a site. Duplicate tags are removed, and an empty list clears the ref_id.
+func (c *Client) SetSiteRefTags(siteID int, tags []string) error {
+	log.Printf("[INFO] Setting Incapsula ref tags %v for site_id: %d\n", tags, siteID)
+
+	refID, err := encodeSiteRefTags(tags)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.UpdateSite(strconv.Itoa(siteID), siteTagsParam, refID)
+	if err != nil {
+		return fmt.Errorf("Error setting ref tags for site_id %d: %s", siteID, err)
+	}
+
+	return nil
+}
+
+// GetSiteRefTags returns the ref tags of a site, sorted
+func (c *Client) GetSiteRefTags(siteID int) ([]string, error) {
+	log.Printf("[INFO] Getting Incapsula ref tags for site_id: %d\n", siteID)
+
+	siteStatusResponse, err := c.SiteStatus("", siteID)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting ref tags for site_id %d: %s", siteID, err)
+	}
+
+	return decodeSiteRefTags(siteStatusResponse.RefID), nil
+}
+
+// encodeSiteRefTags serializes the tags sorted and without duplicates, so the same tags always produce the same ref_id
+func encodeSiteRefTags(tags []string) (string, error) {
+	unique := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if strings.TrimSpace(tag) != tag || tag == "" {
+			return "", fmt.Errorf("Error - ref tags can't be empty or start or end with spaces, got: %q", tag)
+		}
+		if strings.ContainsAny(tag, siteRefTagsSeparator+siteTagsPairSeparator+siteTagsValueSeparator) {
+			return "", fmt.Errorf("Error - ref tag %s can't contain '%s', '%s' or '%s'", tag, siteRefTagsSeparator, siteTagsPairSeparator, siteTagsValueSeparator)
+		}
+		if !contains(unique, tag) {
+			unique = append(unique, tag)
+		}
+	}
+	sort.Strings(unique)
+
+	refID := strings.Join(unique, siteRefTagsSeparator)
+	if len(refID) > siteRefIDMaxLength {
+		return "", fmt.Errorf("Error - the ref tags are %d characters long once joined with '%s', the ref_id is limited to %d", len(refID), siteRefTagsSeparator, siteRefIDMaxLength)
+	}
+
+	return refID, nil
+}
+
+// decodeSiteRefTags parses the tags out of a ref_id, sorted, ignoring the empty ones and key/value site tags
+func decodeSiteRefTags(refID string) []string {
+	tags := make([]string, 0)
+	for _, tag := range strings.Split(refID, siteRefTagsSeparator) {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || strings.ContainsAny(tag, siteTagsPairSeparator+siteTagsValueSeparator) || contains(tags, tag) {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
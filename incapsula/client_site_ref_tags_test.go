@@ -0,0 +1,94 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetSiteRefTags Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetSiteRefTagsRoundTrip(t *testing.T) {
+	refID := ""
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		switch req.URL.String() {
+		case fmt.Sprintf("/%s", endpointSiteUpdate):
+			if req.PostForm.Get("param") != siteTagsParam {
+				t.Errorf("Expected param to be %s, got: %s", siteTagsParam, req.PostForm.Get("param"))
+			}
+			refID = req.PostForm.Get("value")
+			rw.Write([]byte(`{"site_id":42,"res":0}`))
+		case fmt.Sprintf("/%s", endpointSiteStatus):
+			rw.Write([]byte(fmt.Sprintf(`{"site_id":42,"res":0,"ref_id":%q}`, refID)))
+		default:
+			t.Errorf("Unexpected request to %s", req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	steps := []struct {
+		name          string
+		tags          []string
+		expectedRefID string
+		expectedTags  []string
+	}{
+		{"add", []string{"web", "prod", "web"}, "prod,web", []string{"prod", "web"}},
+		{"update", []string{"api", "prod"}, "api,prod", []string{"api", "prod"}},
+		{"remove all", []string{}, "", []string{}},
+	}
+	for _, step := range steps {
+		err := client.SetSiteRefTags(42, step.tags)
+		if err != nil {
+			t.Fatalf("%s: Should not have received an error, got: %s", step.name, err)
+		}
+		if refID != step.expectedRefID {
+			t.Errorf("%s: Expected ref_id to be %q, got: %q", step.name, step.expectedRefID, refID)
+		}
+		tags, err := client.GetSiteRefTags(42)
+		if err != nil {
+			t.Fatalf("%s: Should not have received an error, got: %s", step.name, err)
+		}
+		if !reflect.DeepEqual(tags, step.expectedTags) {
+			t.Errorf("%s: Expected ref tags %v, got: %v", step.name, step.expectedTags, tags)
+		}
+	}
+}
+
+func TestClientSetSiteRefTagsInvalidTag(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	for _, tag := range []string{"", " web", "web,api", "team=web", "web;api"} {
+		err := client.SetSiteRefTags(42, []string{tag})
+		if err == nil {
+			t.Errorf("Should have received an error for ref tag %q", tag)
+		}
+	}
+}
+
+func TestClientSetSiteRefTagsTooLong(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSiteRefTags(42, []string{strings.Repeat("a", 200), strings.Repeat("b", 55)})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error - the ref tags are 256 characters long") {
+		t.Errorf("Should have received a length error, got: %s", err)
+	}
+}
+
+func TestDecodeSiteRefTagsIgnoresSiteTags(t *testing.T) {
+	tags := decodeSiteRefTags("cost_center=1234;team=web")
+	if len(tags) != 0 {
+		t.Errorf("Should not have decoded ref tags from key/value site tags, got: %v", tags)
+	}
+}
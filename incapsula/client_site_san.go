@@ -0,0 +1,262 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Endpoints (unexported consts)
+const endpointSiteSANs = "/certificates-ui/v3/sans"
+
+// SANConfig contains the SAN settings of the site's Imperva generated certificate
+type SANConfig struct {
+	AddNakedDomainSan bool
+	UseWildcardSan    bool
+}
+
+// SetSANConfiguration applies the SAN settings of a site. The wildcard SAN is set before the naked domain SAN since
+// the naked domain SAN is added on top of the domain SAN. Settings which are already applied are skipped, and the
+// wildcard SAN is rolled back if the naked domain SAN can't be set, so a site is never left half configured.
+func (c *Client) SetSANConfiguration(siteID int, cfg SANConfig) error {
+	log.Printf("[INFO] Setting Incapsula SAN configuration (naked domain SAN: %t, wildcard SAN: %t) for site_id: %d\n", cfg.AddNakedDomainSan, cfg.UseWildcardSan, siteID)
+
+	siteStatusResponse, err := c.SiteStatus("", siteID)
+	if err != nil {
+		return fmt.Errorf("Error reading SAN configuration for site_id %d: %s", siteID, err)
+	}
+
+	wildcardSanChanged := siteStatusResponse.UseWildcardSanInsteadOfFullDomainSan != cfg.UseWildcardSan
+	if wildcardSanChanged {
+		_, err = c.UpdateSite(strconv.Itoa(siteID), "wildcard_san", strconv.FormatBool(cfg.UseWildcardSan))
+		if err != nil {
+			return fmt.Errorf("Error setting wildcard SAN for site_id %d: %s", siteID, err)
+		}
+	}
+
+	if siteStatusResponse.AddNakedDomainSan != cfg.AddNakedDomainSan {
+		_, err = c.UpdateSite(strconv.Itoa(siteID), "naked_domain_san", strconv.FormatBool(cfg.AddNakedDomainSan))
+		if err != nil {
+			if wildcardSanChanged {
+				_, rollbackErr := c.UpdateSite(strconv.Itoa(siteID), "wildcard_san", strconv.FormatBool(siteStatusResponse.UseWildcardSanInsteadOfFullDomainSan))
+				if rollbackErr != nil {
+					log.Printf("[ERROR] Could not roll back wildcard SAN for site_id %d: %s\n", siteID, rollbackErr)
+				}
+			}
+			return fmt.Errorf("Error setting naked domain SAN for site_id %d: %s", siteID, err)
+		}
+	}
+
+	return nil
+}
+
+// validateSANConfig checks the SAN settings against the site domain. Explicitly asking for both the naked domain SAN
+// and wildcard-only mode is rejected, the defaults of both are left alone. The naked domain SAN only applies to www
+// sites, explicitly asking for it on any other site returns a warning since the API ignores it.
+func validateSANConfig(domain string, cfg SANConfig, nakedDomainSanSet, wildcardSanSet bool) ([]string, error) {
+	if nakedDomainSanSet && wildcardSanSet && cfg.AddNakedDomainSan && cfg.UseWildcardSan {
+		return nil, fmt.Errorf("naked_domain_san and wildcard_san can't both be set to true, got domain: %s", domain)
+	}
+	if nakedDomainSanSet && cfg.AddNakedDomainSan && !strings.HasPrefix(domain, "www.") {
+		return []string{fmt.Sprintf("naked_domain_san only applies to www sites and is ignored for domain: %s", domain)}, nil
+	}
+	return nil, nil
+}
+
+// CertCoversApex returns true when the site's Imperva generated certificate covers the apex (naked) domain of the site,
+// e.g. example.com for www.example.com
+func (c *Client) CertCoversApex(siteID int) (bool, error) {
+	siteStatusResponse, err := c.SiteStatus("", siteID)
+	if err != nil {
+		return false, fmt.Errorf("Error reading SANs for site_id %d: %s", siteID, err)
+	}
+
+	coversApex, reason := certApexCoverage(siteStatusResponse)
+	log.Printf("[INFO] Incapsula generated certificate of site_id %d covers the apex domain: %t (%s)\n", siteID, coversApex, reason)
+	return coversApex, nil
+}
+
+// certApexCoverage returns whether the generated certificate covers the apex domain of a site, along with the reason.
+// Only www sites and apex sites have an apex domain to cover: the apex of any other subdomain isn't part of the site.
+// A wildcard SAN doesn't cover the apex domain.
+func certApexCoverage(siteStatusResponse *SiteStatusResponse) (bool, string) {
+	domain := siteStatusResponse.Domain
+	apexDomain := domain
+	if strings.HasPrefix(domain, "www.") {
+		apexDomain = strings.TrimPrefix(domain, "www.")
+	} else if strings.Count(domain, ".") > 1 {
+		return false, fmt.Sprintf("%s is a subdomain site, its apex domain isn't part of the site", domain)
+	}
+
+	if contains(siteStatusResponse.Ssl.GeneratedCertificate.San, apexDomain) {
+		return true, fmt.Sprintf("%s is a SAN of the generated certificate", apexDomain)
+	}
+	if siteStatusResponse.AddNakedDomainSan {
+		return false, fmt.Sprintf("the naked domain SAN is enabled but %s isn't a SAN of the generated certificate yet", apexDomain)
+	}
+	return false, fmt.Sprintf("%s isn't a SAN of the generated certificate and the naked domain SAN is disabled", apexDomain)
+}
+
+// SANValidationRecord is a DNS record the customer must set so a SAN of the site's Imperva generated certificate can be validated
+type SANValidationRecord struct {
+	RecordName string
+	RecordType string
+	Values     []string
+}
+
+// SiteSANs contains the SANs of the site's Imperva generated certificate and the DNS records pending validation
+type SiteSANs struct {
+	SANs              []string
+	ValidationStatus  string
+	PendingValidation []SANValidationRecord
+}
+
+// GetSiteSANs gets the SANs of the site's Imperva generated certificate, along with the DNS records still required to
+// validate them
+func (c *Client) GetSiteSANs(siteID int) (*SiteSANs, error) {
+	siteStatusResponse, err := c.SiteStatus("", siteID)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading SANs for site_id %d: %s", siteID, err)
+	}
+
+	generatedCertificate := siteStatusResponse.Ssl.GeneratedCertificate
+	sans := append([]string(nil), generatedCertificate.San...)
+	sort.Strings(sans)
+	return &SiteSANs{
+		SANs:              sans,
+		ValidationStatus:  generatedCertificate.ValidationStatus,
+		PendingValidation: pendingSANValidationRecords(siteStatusResponse),
+	}, nil
+}
+
+// SetSiteSANs reconciles the SANs of the site's Imperva generated certificate with sans: SANs which aren't in the list
+// are removed and new ones are added. The site domain and the naked domain and wildcard SANs are managed by the site
+// settings, so they're left out of the reconciliation. When SANs are added, domain validation is triggered again with
+// the site's validation method, so GetSiteSANs then returns the DNS records to set.
+func (c *Client) SetSiteSANs(siteID int, sans []string) error {
+	log.Printf("[INFO] Setting Incapsula SANs %v for site_id: %d\n", sans, siteID)
+
+	siteStatusResponse, err := c.SiteStatus("", siteID)
+	if err != nil {
+		return fmt.Errorf("Error reading SANs for site_id %d: %s", siteID, err)
+	}
+
+	sans = withoutSiteManagedSANs(siteStatusResponse.Domain, sans)
+	currentSANs := withoutSiteManagedSANs(siteStatusResponse.Domain, siteStatusResponse.Ssl.GeneratedCertificate.San)
+	for _, san := range currentSANs {
+		if !contains(sans, san) {
+			err = c.editSiteSAN(http.MethodDelete, siteID, san)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	added := false
+	for _, san := range sans {
+		if !contains(currentSANs, san) {
+			err = c.editSiteSAN(http.MethodPost, siteID, san)
+			if err != nil {
+				return err
+			}
+			added = true
+		}
+	}
+
+	validationMethod := siteStatusResponse.Ssl.GeneratedCertificate.ValidationMethod
+	if added && validationMethod != "" {
+		_, err = c.UpdateSite(strconv.Itoa(siteID), "domain_validation", validationMethod)
+		if err != nil {
+			return fmt.Errorf("Error triggering domain validation for the SANs of site_id %d: %s", siteID, err)
+		}
+	}
+
+	return nil
+}
+
+// siteManagedSANs returns the SANs managed by the site settings rather than the sans of the site: the site domain, the
+// wildcard SAN and, for www sites, the naked domain SAN
+func siteManagedSANs(domain string) []string {
+	parentDomain := domain
+	if strings.Count(domain, ".") > 1 {
+		parentDomain = domain[strings.Index(domain, ".")+1:]
+	}
+	managedSANs := []string{domain, "*." + parentDomain}
+	if strings.HasPrefix(domain, "www.") {
+		managedSANs = append(managedSANs, parentDomain)
+	}
+	return managedSANs
+}
+
+// withoutSiteManagedSANs returns the SANs which aren't managed by the site settings
+func withoutSiteManagedSANs(domain string, sans []string) []string {
+	managedSANs := siteManagedSANs(domain)
+	result := make([]string, 0, len(sans))
+	for _, san := range sans {
+		if !contains(managedSANs, san) {
+			result = append(result, san)
+		}
+	}
+	return result
+}
+
+// editSiteSAN adds (POST) or removes (DELETE) a single SAN of the site's Imperva generated certificate
+func (c *Client) editSiteSAN(method string, siteID int, san string) error {
+	log.Printf("[INFO] %s Incapsula SAN %s for site_id: %d\n", method, san, siteID)
+
+	params := map[string]string{"extSiteId": strconv.Itoa(siteID), "sanValue": san}
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(method, c.endpointURL(endpointSiteSANs), nil, params, UpdateSite)
+	if err != nil {
+		return fmt.Errorf("Error from Incapsula service when editing SAN %s for site_id %d: %s", san, siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula edit SAN JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("Error status code %d from Incapsula service when editing SAN %s for site_id %d: %s", resp.StatusCode, san, siteID, string(responseBody))
+	}
+
+	return nil
+}
+
+// pendingSANValidationRecords returns the DNS records from the validation data of the generated certificate while it
+// isn't validated. The validation data only holds DNS records for the dns and cname validation methods.
+func pendingSANValidationRecords(siteStatusResponse *SiteStatusResponse) []SANValidationRecord {
+	records := make([]SANValidationRecord, 0)
+	generatedCertificate := siteStatusResponse.Ssl.GeneratedCertificate
+	if generatedCertificate.ValidationStatus == "done" || generatedCertificate.ValidationData == nil {
+		return records
+	}
+
+	validationDataJSON, err := json.Marshal(generatedCertificate.ValidationData)
+	if err != nil {
+		return records
+	}
+	var validationData []struct {
+		DNSRecordName string   `json:"dns_record_name"`
+		SetTypeTo     string   `json:"set_type_to"`
+		SetDataTo     []string `json:"set_data_to"`
+	}
+	if json.Unmarshal(validationDataJSON, &validationData) != nil {
+		// e.g. the approver emails of the email validation method
+		return records
+	}
+	for _, record := range validationData {
+		if record.DNSRecordName == "" {
+			continue
+		}
+		records = append(records, SANValidationRecord{RecordName: record.DNSRecordName, RecordType: record.SetTypeTo, Values: record.SetDataTo})
+	}
+	return records
+}
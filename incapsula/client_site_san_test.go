@@ -0,0 +1,296 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetSANConfiguration Tests
+////////////////////////////////////////////////////////////////
+
+func newSANConfigurationServer(t *testing.T, currentStatus string, failParam string, updates *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		switch req.URL.String() {
+		case fmt.Sprintf("/%s", endpointSiteStatus):
+			rw.Write([]byte(currentStatus))
+		case fmt.Sprintf("/%s", endpointSiteUpdate):
+			param := req.PostForm.Get("param")
+			*updates = append(*updates, fmt.Sprintf("%s=%s", param, req.PostForm.Get("value")))
+			if param == failParam {
+				rw.Write([]byte(`{"res":1,"res_message":"Unexpected error"}`))
+				return
+			}
+			rw.Write([]byte(`{"site_id":42,"res":0}`))
+		default:
+			t.Errorf("Unexpected request to %s", req.URL.String())
+		}
+	}))
+}
+
+func TestClientSetSANConfigurationOrder(t *testing.T) {
+	updates := make([]string, 0)
+	server := newSANConfigurationServer(t, `{"site_id":42,"res":0,"add_naked_domain_san":false,"use_wildcard_san_instead_of_full_domain_san":false}`, "", &updates)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSANConfiguration(42, SANConfig{AddNakedDomainSan: true, UseWildcardSan: true})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	expected := []string{"wildcard_san=true", "naked_domain_san=true"}
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("Expected updates %v, got: %v", expected, updates)
+	}
+}
+
+func TestClientSetSANConfigurationSkipsUnchanged(t *testing.T) {
+	updates := make([]string, 0)
+	server := newSANConfigurationServer(t, `{"site_id":42,"res":0,"add_naked_domain_san":true,"use_wildcard_san_instead_of_full_domain_san":true}`, "", &updates)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSANConfiguration(42, SANConfig{AddNakedDomainSan: false, UseWildcardSan: true})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	expected := []string{"naked_domain_san=false"}
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("Expected updates %v, got: %v", expected, updates)
+	}
+}
+
+func TestClientSetSANConfigurationRollsBackWildcardSan(t *testing.T) {
+	updates := make([]string, 0)
+	server := newSANConfigurationServer(t, `{"site_id":42,"res":0,"add_naked_domain_san":false,"use_wildcard_san_instead_of_full_domain_san":false}`, "naked_domain_san", &updates)
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSANConfiguration(42, SANConfig{AddNakedDomainSan: true, UseWildcardSan: true})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	expected := []string{"wildcard_san=true", "naked_domain_san=true", "wildcard_san=false"}
+	if !reflect.DeepEqual(updates, expected) {
+		t.Errorf("Expected updates %v, got: %v", expected, updates)
+	}
+}
+
+func TestValidateSANConfig(t *testing.T) {
+	cases := []struct {
+		domain            string
+		cfg               SANConfig
+		nakedDomainSanSet bool
+		wildcardSanSet    bool
+		valid             bool
+		warning           bool
+	}{
+		{"www.example.com", SANConfig{AddNakedDomainSan: true, UseWildcardSan: true}, true, true, false, false},
+		{"www.example.com", SANConfig{AddNakedDomainSan: true, UseWildcardSan: true}, true, false, true, false},
+		{"www.example.com", SANConfig{AddNakedDomainSan: true, UseWildcardSan: true}, false, true, true, false},
+		{"www.example.com", SANConfig{AddNakedDomainSan: true, UseWildcardSan: true}, false, false, true, false},
+		{"www.example.com", SANConfig{AddNakedDomainSan: true, UseWildcardSan: false}, true, true, true, false},
+		{"www.example.com", SANConfig{AddNakedDomainSan: false, UseWildcardSan: true}, true, true, true, false},
+		{"www.example.com", SANConfig{AddNakedDomainSan: false, UseWildcardSan: false}, true, true, true, false},
+		{"hello.example.com", SANConfig{AddNakedDomainSan: true, UseWildcardSan: true}, true, true, false, false},
+		{"hello.example.com", SANConfig{AddNakedDomainSan: true, UseWildcardSan: false}, true, true, true, true},
+		{"hello.example.com", SANConfig{AddNakedDomainSan: true, UseWildcardSan: true}, true, false, true, true},
+		{"hello.example.com", SANConfig{AddNakedDomainSan: true, UseWildcardSan: true}, false, true, true, false},
+		{"hello.example.com", SANConfig{AddNakedDomainSan: false, UseWildcardSan: true}, true, true, true, false},
+		{"hello.example.com", SANConfig{AddNakedDomainSan: false, UseWildcardSan: false}, true, true, true, false},
+	}
+	for _, c := range cases {
+		warnings, err := validateSANConfig(c.domain, c.cfg, c.nakedDomainSanSet, c.wildcardSanSet)
+		if c.valid && err != nil {
+			t.Errorf("Expected %s %+v (set: %t, %t) to be valid, got: %s", c.domain, c.cfg, c.nakedDomainSanSet, c.wildcardSanSet, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("Expected %s %+v (set: %t, %t) to be invalid", c.domain, c.cfg, c.nakedDomainSanSet, c.wildcardSanSet)
+		}
+		if c.warning != (len(warnings) > 0) {
+			t.Errorf("Expected %s %+v (set: %t, %t) warning: %t, got: %v", c.domain, c.cfg, c.nakedDomainSanSet, c.wildcardSanSet, c.warning, warnings)
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// SetSiteSANs Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetSiteSANsAddAndRemove(t *testing.T) {
+	sans := []string{"a.example.com", "b.example.com"}
+	requests := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		switch req.URL.Path {
+		case fmt.Sprintf("/%s", endpointSiteStatus):
+			validationStatus := "done"
+			if len(requests) > 0 {
+				validationStatus = "pending_user_action"
+			}
+			rw.Write([]byte(fmt.Sprintf(`{"site_id":42,"res":0,"ssl":{"generated_certificate":{"validation_method":"dns","validation_status":%q,"san":["%s"],
+				"validation_data":[{"dns_record_name":"c.example.com","set_type_to":"TXT","set_data_to":["globalsign-domain-verification=abc"]}]}}}`,
+				validationStatus, strings.Join(sans, `","`))))
+		case endpointSiteSANs:
+			san := req.URL.Query().Get("sanValue")
+			if req.URL.Query().Get("extSiteId") != "42" {
+				t.Errorf("Expected extSiteId to be 42, got: %s", req.URL.Query().Get("extSiteId"))
+			}
+			requests = append(requests, fmt.Sprintf("%s %s", req.Method, san))
+			if req.Method == http.MethodDelete {
+				remaining := make([]string, 0)
+				for _, s := range sans {
+					if s != san {
+						remaining = append(remaining, s)
+					}
+				}
+				sans = remaining
+			} else {
+				sans = append(sans, san)
+			}
+			rw.Write([]byte(`{"data":[]}`))
+		case fmt.Sprintf("/%s", endpointSiteUpdate):
+			requests = append(requests, fmt.Sprintf("%s=%s", req.PostForm.Get("param"), req.PostForm.Get("value")))
+			rw.Write([]byte(`{"site_id":42,"res":0}`))
+		default:
+			t.Errorf("Unexpected request to %s", req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSiteSANs(42, []string{"b.example.com", "c.example.com"})
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	expectedRequests := []string{"DELETE a.example.com", "POST c.example.com", "domain_validation=dns"}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("Unexpected requests, expected %v, got: %v", expectedRequests, requests)
+	}
+
+	siteSANs, err := client.GetSiteSANs(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if !reflect.DeepEqual(siteSANs.SANs, []string{"b.example.com", "c.example.com"}) {
+		t.Errorf("Unexpected SANs, got: %v", siteSANs.SANs)
+	}
+	expectedRecords := []SANValidationRecord{{RecordName: "c.example.com", RecordType: "TXT", Values: []string{"globalsign-domain-verification=abc"}}}
+	if !reflect.DeepEqual(siteSANs.PendingValidation, expectedRecords) {
+		t.Errorf("Unexpected pending validation records, expected %+v, got: %+v", expectedRecords, siteSANs.PendingValidation)
+	}
+}
+
+func TestClientSetSiteSANsUnchanged(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != fmt.Sprintf("/%s", endpointSiteStatus) {
+			t.Errorf("Should only have read the site status, got request to %s", req.URL.String())
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0,"ssl":{"generated_certificate":{"validation_method":"dns","san":["a.example.com"]}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSiteSANs(42, []string{"a.example.com"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetSiteSANsKeepsSiteManagedSANs(t *testing.T) {
+	requests := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case fmt.Sprintf("/%s", endpointSiteStatus):
+			rw.Write([]byte(`{"site_id":42,"domain":"www.example.com","res":0,"ssl":{"generated_certificate":{"validation_method":"dns",
+				"san":["www.example.com","example.com","*.example.com","a.example.com"]}}}`))
+		case endpointSiteSANs:
+			requests = append(requests, fmt.Sprintf("%s %s", req.Method, req.URL.Query().Get("sanValue")))
+			rw.Write([]byte(`{"data":[]}`))
+		default:
+			t.Errorf("Unexpected request to %s", req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSiteSANs(42, []string{})
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	expectedRequests := []string{"DELETE a.example.com"}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("Should only have removed the SANs which aren't managed by the site, expected %v, got: %v", expectedRequests, requests)
+	}
+}
+
+func TestSiteManagedSANs(t *testing.T) {
+	cases := map[string][]string{
+		"www.example.com":  {"www.example.com", "*.example.com", "example.com"},
+		"shop.example.com": {"shop.example.com", "*.example.com"},
+		"example.com":      {"example.com", "*.example.com"},
+	}
+	for domain, expected := range cases {
+		if managedSANs := siteManagedSANs(domain); !reflect.DeepEqual(managedSANs, expected) {
+			t.Errorf("Unexpected managed SANs of %s, expected %v, got: %v", domain, expected, managedSANs)
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// CertCoversApex Tests
+////////////////////////////////////////////////////////////////
+
+func TestCertApexCoverage(t *testing.T) {
+	sites := []struct {
+		domain            string
+		sans              []string
+		addNakedDomainSan bool
+		expected          bool
+		expectedReason    string
+	}{
+		{"www.example.com", []string{"www.example.com", "example.com"}, true, true, "example.com is a SAN of the generated certificate"},
+		{"example.com", []string{"example.com"}, false, true, "example.com is a SAN of the generated certificate"},
+		{"www.example.com", []string{"www.example.com", "*.example.com"}, false, false, "example.com isn't a SAN of the generated certificate and the naked domain SAN is disabled"},
+		{"www.example.com", []string{"www.example.com"}, true, false, "the naked domain SAN is enabled but example.com isn't a SAN of the generated certificate yet"},
+		{"shop.example.com", []string{"shop.example.com", "example.com"}, false, false, "shop.example.com is a subdomain site, its apex domain isn't part of the site"},
+	}
+	for _, site := range sites {
+		siteStatusResponse := &SiteStatusResponse{Domain: site.domain, AddNakedDomainSan: site.addNakedDomainSan}
+		siteStatusResponse.Ssl.GeneratedCertificate.San = site.sans
+		coversApex, reason := certApexCoverage(siteStatusResponse)
+		if coversApex != site.expected || reason != site.expectedReason {
+			t.Errorf("Unexpected apex coverage of %s with SANs %v, expected %t (%s), got: %t (%s)", site.domain, site.sans, site.expected, site.expectedReason, coversApex, reason)
+		}
+	}
+}
+
+func TestClientCertCoversApex(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"site_id":42,"domain":"www.example.com","res":0,"add_naked_domain_san":true,"ssl":{"generated_certificate":{"san":["www.example.com","example.com"]}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	coversApex, err := client.CertCoversApex(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if !coversApex {
+		t.Errorf("Should have covered the apex domain")
+	}
+}
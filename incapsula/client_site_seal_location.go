@@ -0,0 +1,63 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+)
+
+const sealLocationParam = "seal_location"
+
+// Allowed trust seal positions
+var sealPositions = []string{"fixed", "floating", "inline"}
+
+// SealConfig contains the trust seal configuration of a site
+type SealConfig struct {
+	ID       string
+	Type     string
+	Position string
+}
+
+// SetSealLocation sets the trust seal location of a site, along with its type and position when provided
+func (c *Client) SetSealLocation(siteID int, seal SealConfig) error {
+	log.Printf("[INFO] Setting Incapsula seal location (%s) with type (%s) and position (%s) for site_id: %d\n", seal.ID, seal.Type, seal.Position, siteID)
+
+	values, err := sealLocationValues(seal)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.updateSite(strconv.Itoa(siteID), sealLocationParam, seal.ID, values)
+	if err != nil {
+		return fmt.Errorf("Error setting seal location for site_id %d: %s", siteID, err)
+	}
+
+	return nil
+}
+
+// sealLocationValues returns the values sent along with the seal_location param
+func sealLocationValues(seal SealConfig) (url.Values, error) {
+	if seal.Position != "" && !contains(sealPositions, seal.Position) {
+		return nil, fmt.Errorf("Error - invalid seal position (%s), must be one of %v", seal.Position, sealPositions)
+	}
+
+	values := url.Values{}
+	if seal.Type != "" {
+		values.Set("seal_type", seal.Type)
+	}
+	if seal.Position != "" {
+		values.Set("seal_position", seal.Position)
+	}
+
+	return values, nil
+}
+
+// getSealConfig returns the full trust seal configuration from the site status
+func getSealConfig(siteStatusResponse *SiteStatusResponse) SealConfig {
+	return SealConfig{
+		ID:       siteStatusResponse.SealLocation.ID,
+		Type:     siteStatusResponse.SealLocation.Type,
+		Position: siteStatusResponse.SealLocation.Position,
+	}
+}
@@ -0,0 +1,77 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetSealLocation Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetSealLocationFullConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteUpdate) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteUpdate, req.URL.String())
+		}
+		req.ParseForm()
+		expected := map[string]string{
+			"site_id":       "42",
+			"param":         sealLocationParam,
+			"value":         "api.seal_location.bottom_left",
+			"seal_type":     "api.seal_type.dynamic",
+			"seal_position": "floating",
+		}
+		for key, value := range expected {
+			if req.PostForm.Get(key) != value {
+				t.Errorf("Expected %s to be %s, got: %s", key, value, req.PostForm.Get(key))
+			}
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSealLocation(42, SealConfig{ID: "api.seal_location.bottom_left", Type: "api.seal_type.dynamic", Position: "floating"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetSealLocationInvalidPosition(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSealLocation(42, SealConfig{ID: "api.seal_location.bottom_left", Position: "top"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error - invalid seal position (top)") {
+		t.Errorf("Should have received an invalid position error, got: %s", err)
+	}
+}
+
+func TestClientSealConfigStatusRoundTrip(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteStatus) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteStatus, req.URL.String())
+		}
+		rw.Write([]byte(`{"site_id":42,"sealLocation":{"id":"api.seal_location.bottom_left","name":"Bottom left","type":"api.seal_type.dynamic","position":"floating"},"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteStatusResponse, err := client.SiteStatus("foo.com", 42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	sealConfig := getSealConfig(siteStatusResponse)
+	expected := SealConfig{ID: "api.seal_location.bottom_left", Type: "api.seal_type.dynamic", Position: "floating"}
+	if sealConfig != expected {
+		t.Errorf("Seal config doesn't match, expected %+v, got: %+v", expected, sealConfig)
+	}
+}
@@ -0,0 +1,128 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Endpoints (unexported consts)
+// The security events of a site, e.g. /events/v1/sites/{siteId}/security-events
+const endpointSiteSecurityEventsBase = "/events/v1/sites"
+
+// Security event action enumerations. Only the events that stopped the request are returned.
+const (
+	SecurityEventActionBlock     = "block"
+	SecurityEventActionChallenge = "challenge"
+)
+
+var securityEventActions = []string{SecurityEventActionBlock, SecurityEventActionChallenge}
+
+// Number of events of a single security events query
+const maxSecurityEvents = 1000
+
+// Longest time range of a single security events query
+const maxSecurityEventsRange = 30 * 24 * time.Hour
+
+// SecurityEventDTO is a security event as returned by the events API
+type SecurityEventDTO struct {
+	Timestamp   int64  `json:"timestamp"`
+	EventType   string `json:"event_type"`
+	RuleID      string `json:"rule_id"`
+	RuleName    string `json:"rule_name"`
+	Action      string `json:"action"`
+	ClientIP    string `json:"client_ip"`
+	CountryCode string `json:"country_code"`
+}
+
+// SecurityEventsResponse contains the security events of a site
+type SecurityEventsResponse struct {
+	Events []SecurityEventDTO `json:"events"`
+}
+
+// SecurityEvent is a request of a site that was blocked or challenged by a security rule
+type SecurityEvent struct {
+	Time     time.Time
+	Type     string
+	RuleID   string
+	Rule     string
+	Action   string
+	ClientIP string
+	Country  string
+}
+
+// GetSiteSecurityEvents gets the last blocked and challenged requests of a site between from and to, newest first. The
+// limit must be between 1 and 1000 and the time range can't exceed 30 days.
+func (c *Client) GetSiteSecurityEvents(siteID int, limit int, from, to time.Time) ([]SecurityEvent, error) {
+	log.Printf("[INFO] Getting Incapsula security events for site_id %d from %s to %s\n", siteID, from, to)
+
+	err := validateSecurityEventsQuery(limit, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"start":   strconv.FormatInt(from.UnixNano()/int64(time.Millisecond), 10),
+		"end":     strconv.FormatInt(to.UnixNano()/int64(time.Millisecond), 10),
+		"limit":   strconv.Itoa(limit),
+		"actions": strings.Join(securityEventActions, ","),
+	}
+	reqURL := fmt.Sprintf("%s/%d/security-events", c.endpointURL(endpointSiteSecurityEventsBase), siteID)
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(http.MethodGet, reqURL, nil, params, ReadSiteSecurityEvents)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error from Incapsula service when reading security events for site_id %d: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Read Security Events JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("[ERROR] Error status code %d from Incapsula service when reading security events for site_id %d: %s", resp.StatusCode, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var securityEventsResponse SecurityEventsResponse
+	err = json.Unmarshal([]byte(responseBody), &securityEventsResponse)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing security events JSON response for site_id %d: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	securityEvents := make([]SecurityEvent, 0, len(securityEventsResponse.Events))
+	for _, event := range securityEventsResponse.Events {
+		securityEvents = append(securityEvents, SecurityEvent{
+			Time:     time.Unix(0, event.Timestamp*int64(time.Millisecond)).UTC(),
+			Type:     event.EventType,
+			RuleID:   event.RuleID,
+			Rule:     event.RuleName,
+			Action:   event.Action,
+			ClientIP: event.ClientIP,
+			Country:  event.CountryCode,
+		})
+	}
+
+	return securityEvents, nil
+}
+
+// validateSecurityEventsQuery checks the limit, that from is before to and that the range doesn't exceed maxSecurityEventsRange
+func validateSecurityEventsQuery(limit int, from, to time.Time) error {
+	if limit < 1 || limit > maxSecurityEvents {
+		return fmt.Errorf("Error - invalid security events limit (%d), must be between 1 and %d", limit, maxSecurityEvents)
+	}
+	if !from.Before(to) {
+		return fmt.Errorf("Error - invalid security events time range, from (%s) must be before to (%s)", from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+	if to.Sub(from) > maxSecurityEventsRange {
+		return fmt.Errorf("Error - invalid security events time range, it can't exceed %d days", int(maxSecurityEventsRange.Hours()/24))
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package incapsula
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// GetSiteSecurityEvents Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetSiteSecurityEventsInvalidQuery(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	from := time.Date(2022, 5, 8, 0, 0, 0, 0, time.UTC)
+
+	invalidQueries := map[string]struct {
+		limit int
+		to    time.Time
+	}{
+		"Error - invalid security events limit (0)":                           {0, from.Add(time.Hour)},
+		"Error - invalid security events limit (1001)":                        {1001, from.Add(time.Hour)},
+		"Error - invalid security events time range, from":                    {100, from.Add(-time.Hour)},
+		"Error - invalid security events time range, it can't exceed 30 days": {100, from.Add(31 * 24 * time.Hour)},
+	}
+	for expectedError, query := range invalidQueries {
+		_, err := client.GetSiteSecurityEvents(42, query.limit, from, query.to)
+		if err == nil || !strings.HasPrefix(err.Error(), expectedError) {
+			t.Errorf("Should have received an error starting with %q, got: %v", expectedError, err)
+		}
+	}
+}
+
+func TestClientGetSiteSecurityEventsMixedEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/events/v1/sites/42/security-events" {
+			t.Errorf("Should have have hit /events/v1/sites/42/security-events endpoint. Got: %s", req.URL.Path)
+		}
+		query := req.URL.Query()
+		if query.Get("limit") != "10" || query.Get("start") != "1651363200000" || query.Get("end") != "1651449600000" || query.Get("actions") != "block,challenge" {
+			t.Errorf("Unexpected query params, got: %s", req.URL.RawQuery)
+		}
+		rw.Write([]byte(`{"events":[
+			{"timestamp":1651395600000,"event_type":"waf","rule_id":"api.threats.sql_injection","rule_name":"SQL Injection","action":"block","client_ip":"192.0.2.10","country_code":"US"},
+			{"timestamp":1651392000000,"event_type":"bot","rule_id":"api.threats.bot_access_control","rule_name":"Bot Access Control","action":"challenge","client_ip":"198.51.100.7","country_code":"DE"},
+			{"timestamp":1651388400000,"event_type":"acl","rule_id":"api.acl.blacklisted_countries","rule_name":"Blocked Countries","action":"block","client_ip":"203.0.113.5","country_code":"KP"}
+		]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	from := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	securityEvents, err := client.GetSiteSecurityEvents(42, 10, from, from.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if len(securityEvents) != 3 {
+		t.Fatalf("Should have received 3 events, got: %d", len(securityEvents))
+	}
+	if !securityEvents[0].Time.Equal(time.Date(2022, 5, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected event time, got: %s", securityEvents[0].Time)
+	}
+	expectedEvents := []SecurityEvent{
+		{Time: securityEvents[0].Time, Type: "waf", RuleID: "api.threats.sql_injection", Rule: "SQL Injection", Action: SecurityEventActionBlock, ClientIP: "192.0.2.10", Country: "US"},
+		{Time: securityEvents[1].Time, Type: "bot", RuleID: "api.threats.bot_access_control", Rule: "Bot Access Control", Action: SecurityEventActionChallenge, ClientIP: "198.51.100.7", Country: "DE"},
+		{Time: securityEvents[2].Time, Type: "acl", RuleID: "api.acl.blacklisted_countries", Rule: "Blocked Countries", Action: SecurityEventActionBlock, ClientIP: "203.0.113.5", Country: "KP"},
+	}
+	for i, expected := range expectedEvents {
+		if securityEvents[i] != expected {
+			t.Errorf("Unexpected event %d, expected %+v, got: %+v", i, expected, securityEvents[i])
+		}
+	}
+}
+
+func TestClientGetSiteSecurityEventsBadJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	from := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	_, err := client.GetSiteSecurityEvents(42, 10, from, from.Add(time.Hour))
+	if err == nil || !strings.HasPrefix(err.Error(), "[ERROR] Error parsing security events JSON response for site_id 42") {
+		t.Errorf("Should have received a JSON parse error, got: %v", err)
+	}
+}
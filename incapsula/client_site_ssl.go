@@ -0,0 +1,66 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// SiteSSL contains the SSL state of a site, as reported by the site status
+type SiteSSL struct {
+	OriginServerDetected            bool
+	OriginServerDetectionStatus     string
+	CustomCertificateActive         bool
+	CustomCertificateExpirationDate time.Time
+	CustomCertificateIssuer         string
+	GeneratedCertificateCA          string
+	GeneratedCertificateMethod      string
+	GeneratedCertificateStatus      string
+	GeneratedCertificateSANs        []string
+	GeneratedCertificateCoversApex  bool
+	GeneratedCertificateApexReason  string
+	SupportAllTLSVersions           bool
+	SealLocation                    string
+}
+
+// GetSiteSSL gets the SSL state of a site with a single site status call
+func (c *Client) GetSiteSSL(siteID int) (*SiteSSL, error) {
+	log.Printf("[INFO] Getting Incapsula SSL state for site_id: %d\n", siteID)
+
+	siteStatusResponse, err := c.SiteStatus("site-ssl-read", siteID)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting SSL state for site_id %d: %s", siteID, err)
+	}
+
+	siteSSL := siteSSLFromStatus(siteStatusResponse)
+	return &siteSSL, nil
+}
+
+// siteSSLFromStatus extracts the SSL state from a site status. The custom certificate expiration date is zero when
+// the site has no custom certificate.
+func siteSSLFromStatus(siteStatusResponse *SiteStatusResponse) SiteSSL {
+	ssl := siteStatusResponse.Ssl
+
+	var expirationDate time.Time
+	if ssl.CustomCertificate.ExpirationDate != 0 {
+		expirationDate = time.Unix(0, ssl.CustomCertificate.ExpirationDate*int64(time.Millisecond)).UTC()
+	}
+
+	coversApex, apexReason := certApexCoverage(siteStatusResponse)
+
+	return SiteSSL{
+		OriginServerDetected:            ssl.OriginServer.Detected,
+		OriginServerDetectionStatus:     ssl.OriginServer.DetectionStatus,
+		CustomCertificateActive:         ssl.CustomCertificate.Active,
+		CustomCertificateExpirationDate: expirationDate,
+		CustomCertificateIssuer:         ssl.CustomCertificate.Issuer,
+		GeneratedCertificateCA:          ssl.GeneratedCertificate.Ca,
+		GeneratedCertificateMethod:      ssl.GeneratedCertificate.ValidationMethod,
+		GeneratedCertificateStatus:      ssl.GeneratedCertificate.ValidationStatus,
+		GeneratedCertificateSANs:        ssl.GeneratedCertificate.San,
+		GeneratedCertificateCoversApex:  coversApex,
+		GeneratedCertificateApexReason:  apexReason,
+		SupportAllTLSVersions:           siteStatusResponse.SupportAllTLSVersions,
+		SealLocation:                    siteStatusResponse.SealLocation.ID,
+	}
+}
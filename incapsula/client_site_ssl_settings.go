@@ -111,3 +111,45 @@ func (c *Client) ReadSiteSSLSettings(siteID int, accountID int) (*SSLSettingsRes
 
 	return &sslSettingsResponse, resp.StatusCode, nil
 }
+
+const hstsPreloadWarning = "HSTS preload is enabled: once browsers add the domain to their preload list, removing it takes months and all its sub-domains must keep supporting HTTPS"
+
+// SetHSTS sets the HSTS configuration of a site, leaving its TLS settings unchanged
+func (c *Client) SetHSTS(siteID int, cfg HSTSConfiguration) error {
+	log.Printf("[INFO] Setting Incapsula HSTS (enabled: %t, max-age: %d) for Site ID %d\n", cfg.IsEnabled, cfg.MaxAge, siteID)
+
+	err := validateHSTSConfiguration(&cfg)
+	if err != nil {
+		return err
+	}
+	if hstsPreloadEnabled(nil, &cfg) {
+		log.Printf("[WARN] Site ID %d: %s\n", siteID, hstsPreloadWarning)
+	}
+
+	settings := SSLSettingsResponse{
+		Data: []SSLSettingsDTO{{HstsConfiguration: &cfg}},
+	}
+	_, err = c.UpdateSiteSSLSettings(siteID, 0, settings)
+	if err != nil {
+		return fmt.Errorf("Error setting HSTS for Site ID %d: %s", siteID, err)
+	}
+
+	return nil
+}
+
+// validateHSTSConfiguration checks that the max-age of an HSTS configuration is non-negative
+func validateHSTSConfiguration(cfg *HSTSConfiguration) error {
+	if cfg.MaxAge < 0 {
+		return fmt.Errorf("Error - invalid HSTS max-age (%d), must be non-negative", cfg.MaxAge)
+	}
+	return nil
+}
+
+// hstsPreloadEnabled returns true when the desired HSTS configuration turns on preloading. A nil current
+// configuration means HSTS isn't configured yet.
+func hstsPreloadEnabled(current, desired *HSTSConfiguration) bool {
+	if desired == nil || !desired.IsEnabled || !desired.PreLoaded {
+		return false
+	}
+	return current == nil || !current.IsEnabled || !current.PreLoaded
+}
@@ -2,6 +2,7 @@ package incapsula
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -347,3 +348,58 @@ func getValidJSONResponse() string {
 		}`
 	return validResponse
 }
+
+////////////////////////////////////////////////////////////////
+// SetHSTS Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetHSTSRequestBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPatch || req.URL.Path != "/sites-mgmt/v3/sites/42/settings/TLSConfiguration" {
+			t.Errorf("Should have patched the TLS configuration, got: %s %s", req.Method, req.URL.String())
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		expected := `{"data":[{"hstsConfiguration":{"isEnabled":true,"maxAge":63072000,"subDomainsIncluded":true,"preLoaded":false}}]}`
+		if string(body) != expected {
+			t.Errorf("Unexpected request body, expected %s, got: %s", expected, string(body))
+		}
+		rw.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	client := &Client{config: getClientTestConfig("foo", "bar", server), httpClient: &http.Client{}}
+	err := client.SetHSTS(42, HSTSConfiguration{IsEnabled: true, MaxAge: 63072000, SubDomainsIncluded: true})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetHSTSNegativeMaxAge(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetHSTS(42, HSTSConfiguration{IsEnabled: true, MaxAge: -1})
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid HSTS max-age (-1), must be non-negative") {
+		t.Errorf("Should have received an invalid max-age error, got: %v", err)
+	}
+}
+
+func TestHSTSPreloadEnabled(t *testing.T) {
+	preloaded := &HSTSConfiguration{IsEnabled: true, MaxAge: 31536000, SubDomainsIncluded: true, PreLoaded: true}
+	notPreloaded := &HSTSConfiguration{IsEnabled: true, MaxAge: 31536000}
+
+	if !hstsPreloadEnabled(nil, preloaded) {
+		t.Errorf("Should have warned when preloading a site without HSTS")
+	}
+	if !hstsPreloadEnabled(notPreloaded, preloaded) {
+		t.Errorf("Should have warned when turning on preload")
+	}
+	if hstsPreloadEnabled(preloaded, preloaded) {
+		t.Errorf("Should not have warned when preload is already on")
+	}
+	if hstsPreloadEnabled(preloaded, notPreloaded) {
+		t.Errorf("Should not have warned when turning off preload")
+	}
+	if hstsPreloadEnabled(nil, &HSTSConfiguration{PreLoaded: true}) {
+		t.Errorf("Should not have warned when HSTS is disabled")
+	}
+}
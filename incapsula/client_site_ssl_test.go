@@ -0,0 +1,56 @@
+package incapsula
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// GetSiteSSL Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetSiteSSL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"site_id":42,"domain":"www.example.com","res":0,"support_all_tls_versions":true,"sealLocation":{"id":"api.seal_location.bottom_right","name":"Bottom right"},
+			"ssl":{"origin_server":{"detected":true,"detectionStatus":"ok"},
+			"custom_certificate":{"active":true,"expirationDate":1672531200000,"issuer":"DigiCert"},
+			"generated_certificate":{"ca":"GS","validation_method":"dns","san":["example.com","*.example.com"],"validation_status":"done"}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteSSL, err := client.GetSiteSSL(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	expected := SiteSSL{
+		OriginServerDetected:            true,
+		OriginServerDetectionStatus:     "ok",
+		CustomCertificateActive:         true,
+		CustomCertificateExpirationDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		CustomCertificateIssuer:         "DigiCert",
+		GeneratedCertificateCA:          "GS",
+		GeneratedCertificateMethod:      "dns",
+		GeneratedCertificateStatus:      "done",
+		GeneratedCertificateSANs:        []string{"example.com", "*.example.com"},
+		GeneratedCertificateCoversApex:  true,
+		GeneratedCertificateApexReason:  "example.com is a SAN of the generated certificate",
+		SupportAllTLSVersions:           true,
+		SealLocation:                    "api.seal_location.bottom_right",
+	}
+	if !reflect.DeepEqual(*siteSSL, expected) {
+		t.Errorf("Unexpected SSL state, expected %+v, got: %+v", expected, *siteSSL)
+	}
+}
+
+func TestSiteSSLFromStatusNoCustomCertificate(t *testing.T) {
+	siteSSL := siteSSLFromStatus(&SiteStatusResponse{})
+	if !siteSSL.CustomCertificateExpirationDate.IsZero() {
+		t.Errorf("Should have received a zero expiration date, got: %s", siteSSL.CustomCertificateExpirationDate)
+	}
+}
@@ -0,0 +1,80 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The Incapsula API doesn't support site tags, so they are stored in the site's ref_id as key=value pairs separated by ';'
+const (
+	siteTagsParam          = "ref_id"
+	siteTagsPairSeparator  = ";"
+	siteTagsValueSeparator = "="
+)
+
+// SetSiteTags replaces the tags of a site. An empty map removes all the tags.
+func (c *Client) SetSiteTags(siteID int, tags map[string]string) error {
+	log.Printf("[INFO] Setting Incapsula tags for site_id: %d\n", siteID)
+
+	refID, err := encodeSiteTags(tags)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.UpdateSite(strconv.Itoa(siteID), siteTagsParam, refID)
+	if err != nil {
+		return fmt.Errorf("Error setting tags for site_id %d: %s", siteID, err)
+	}
+
+	return nil
+}
+
+// GetSiteTags returns the tags of a site
+func (c *Client) GetSiteTags(siteID int) (map[string]string, error) {
+	log.Printf("[INFO] Getting Incapsula tags for site_id: %d\n", siteID)
+
+	siteStatusResponse, err := c.SiteStatus("", siteID)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting tags for site_id %d: %s", siteID, err)
+	}
+
+	return decodeSiteTags(siteStatusResponse.RefID), nil
+}
+
+// encodeSiteTags serializes the tags sorted by key, so the same tags always produce the same ref_id
+func encodeSiteTags(tags map[string]string) (string, error) {
+	keys := make([]string, 0, len(tags))
+	for key, value := range tags {
+		if key == "" {
+			return "", fmt.Errorf("Error - tag keys can't be empty")
+		}
+		if strings.ContainsAny(key, siteTagsPairSeparator+siteTagsValueSeparator) || strings.Contains(value, siteTagsPairSeparator) {
+			return "", fmt.Errorf("Error - tag %s can't contain '%s', and its key can't contain '%s'", key, siteTagsPairSeparator, siteTagsValueSeparator)
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+siteTagsValueSeparator+tags[key])
+	}
+
+	return strings.Join(pairs, siteTagsPairSeparator), nil
+}
+
+// decodeSiteTags parses the tags out of a ref_id, ignoring anything which isn't a key=value pair
+func decodeSiteTags(refID string) map[string]string {
+	tags := make(map[string]string)
+	for _, pair := range strings.Split(refID, siteTagsPairSeparator) {
+		keyValue := strings.SplitN(pair, siteTagsValueSeparator, 2)
+		if len(keyValue) != 2 || keyValue[0] == "" {
+			continue
+		}
+		tags[keyValue[0]] = keyValue[1]
+	}
+	return tags
+}
@@ -0,0 +1,79 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetSiteTags Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetSiteTagsAddUpdateRemove(t *testing.T) {
+	refID := ""
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		switch req.URL.String() {
+		case fmt.Sprintf("/%s", endpointSiteUpdate):
+			if req.PostForm.Get("param") != siteTagsParam {
+				t.Errorf("Expected param to be %s, got: %s", siteTagsParam, req.PostForm.Get("param"))
+			}
+			refID = req.PostForm.Get("value")
+			rw.Write([]byte(`{"site_id":42,"res":0}`))
+		case fmt.Sprintf("/%s", endpointSiteStatus):
+			rw.Write([]byte(fmt.Sprintf(`{"site_id":42,"res":0,"ref_id":%q}`, refID)))
+		default:
+			t.Errorf("Unexpected request to %s", req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	steps := []struct {
+		name          string
+		tags          map[string]string
+		expectedRefID string
+	}{
+		{"add", map[string]string{"team": "web", "cost_center": "1234"}, "cost_center=1234;team=web"},
+		{"update", map[string]string{"team": "api", "cost_center": "1234"}, "cost_center=1234;team=api"},
+		{"remove", map[string]string{"team": "api"}, "team=api"},
+		{"remove all", map[string]string{}, ""},
+	}
+	for _, step := range steps {
+		err := client.SetSiteTags(42, step.tags)
+		if err != nil {
+			t.Fatalf("%s: Should not have received an error, got: %s", step.name, err)
+		}
+		if refID != step.expectedRefID {
+			t.Errorf("%s: Expected ref_id to be %q, got: %q", step.name, step.expectedRefID, refID)
+		}
+		tags, err := client.GetSiteTags(42)
+		if err != nil {
+			t.Fatalf("%s: Should not have received an error, got: %s", step.name, err)
+		}
+		if !reflect.DeepEqual(tags, step.tags) {
+			t.Errorf("%s: Expected tags %v, got: %v", step.name, step.tags, tags)
+		}
+	}
+}
+
+func TestClientSetSiteTagsInvalidTag(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSiteTags(42, map[string]string{"team": "web;api"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+}
+
+func TestDecodeSiteTagsIgnoresPlainRefID(t *testing.T) {
+	tags := decodeSiteTags("legacy-ref-id")
+	if len(tags) != 0 {
+		t.Errorf("Should not have decoded tags from a plain ref_id, got: %v", tags)
+	}
+}
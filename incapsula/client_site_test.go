@@ -175,6 +175,41 @@ func TestClientSiteStatusInvalidSite(t *testing.T) {
 	}
 }
 
+func TestClientSiteStatusInvalidSiteDebugInfo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteStatus) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteStatus, req.URL.String())
+		}
+		rw.Write([]byte(`{"res":9413,"res_message":"Unknown/unauthorized site_id","debug_info":{"site_id":"123","id-info":"13007"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	domain := "foo.com"
+	siteID := 123
+	siteStatusResponse, err := client.SiteStatus(domain, siteID)
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.HasSuffix(err.Error(), "(id-info: 13007)") {
+		t.Errorf("Should have received the id-info in the error, got: %s", err)
+	}
+	incapsulaError, ok := err.(*IncapsulaError)
+	if !ok {
+		t.Fatalf("Should have received an IncapsulaError, got: %T", err)
+	}
+	if incapsulaError.IDInfo != "13007" {
+		t.Errorf("id-info doesn't match, got: %s", incapsulaError.IDInfo)
+	}
+	if incapsulaError.Res != "9413" {
+		t.Errorf("res doesn't match, got: %s", incapsulaError.Res)
+	}
+	if siteStatusResponse == nil || siteStatusResponse.DebugInfo.IDInfo != "13007" {
+		t.Errorf("Should have received a siteStatusResponse instance with the id-info")
+	}
+}
+
 func TestClientSiteStatusValidSite(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
 		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteStatus) {
@@ -203,6 +238,88 @@ func TestClientSiteStatusValidSite(t *testing.T) {
 	}
 }
 
+func TestClientSiteStatusTruncatedResponseRetried(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		if requests == 1 {
+			rw.Write([]byte(`{"site_creation_date":1527885500000, "dns":[`))
+			return
+		}
+		rw.Write([]byte(`{"site_creation_date":1527885500000, "dns":[], "res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteStatusResponse, err := client.SiteStatus("foo.com", 123)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if requests != 2 {
+		t.Errorf("Should have retried the truncated response once, got %d requests", requests)
+	}
+	if siteStatusResponse == nil || siteStatusResponse.SiteCreationDate != 1527885500000 {
+		t.Errorf("Should have received the site status of the retry")
+	}
+}
+
+func TestClientSiteStatusFieldsSendsFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteStatus) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteStatus, req.URL.String())
+		}
+		req.ParseForm()
+		if req.PostForm.Get("site_id") != "123" || req.PostForm.Get("fields") != "security,ssl" {
+			t.Errorf("Unexpected site_id/fields, got: %s/%s", req.PostForm.Get("site_id"), req.PostForm.Get("fields"))
+		}
+		rw.Write([]byte(`{"site_id":123,"ssl":{"custom_certificate":{"active":true}},"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteStatusResponse, err := client.SiteStatusFields(123, []string{"security", "ssl"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if siteStatusResponse == nil || !siteStatusResponse.Ssl.CustomCertificate.Active {
+		t.Errorf("Should have received the requested ssl section")
+	}
+}
+
+func TestClientSiteStatusFieldsInvalidField(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.SiteStatusFields(123, []string{"everything"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error - invalid site status field (everything)") {
+		t.Errorf("Should have received an invalid field error, got: %s", err)
+	}
+}
+
+func TestClientPostFormAndDecodeMutationNotRetried(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		rw.Write([]byte(`{"site_id":`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	var siteUpdateResponse SiteUpdateResponse
+	_, err := client.postFormAndDecode(server.URL, nil, UpdateSite, &siteUpdateResponse)
+	if _, invalidJSON := err.(*jsonDecodeError); !invalidJSON {
+		t.Errorf("Should have received a JSON decode error, got: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Should not have retried a mutation, got %d requests", requests)
+	}
+}
+
 ////////////////////////////////////////////////////////////////
 // UpdateSite Tests
 ////////////////////////////////////////////////////////////////
@@ -237,7 +354,7 @@ func TestClientUpdateSiteBadJSON(t *testing.T) {
 	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := "42"
-	updateSiteResponse, err := client.UpdateSite(siteID, "", "")
+	updateSiteResponse, err := client.UpdateSite(siteID, "active", "bypass")
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -261,7 +378,7 @@ func TestClientUpdateSiteInvalidSite(t *testing.T) {
 	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := "42"
-	updateSiteResponse, err := client.UpdateSite(siteID, "", "")
+	updateSiteResponse, err := client.UpdateSite(siteID, "active", "bypass")
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -285,7 +402,7 @@ func TestClientUpdateSiteValidSite(t *testing.T) {
 	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := "42"
-	addSiteResponse, err := client.UpdateSite(siteID, "", "")
+	addSiteResponse, err := client.UpdateSite(siteID, "active", "bypass")
 	if err != nil {
 		t.Errorf("Should not have received an error")
 	}
@@ -0,0 +1,71 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+)
+
+// TLS versions supported between the clients and Incapsula, oldest first
+var tlsVersions = []string{"TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3"}
+
+// The oldest TLS version supported when the site doesn't support all the TLS versions
+const defaultMinTLSVersion = "TLSv1.2"
+
+// TLSConfig is the TLS posture of a site between the clients and Incapsula
+type TLSConfig struct {
+	SupportAllTLSVersions bool
+	// Versions are the supported TLS versions, oldest first
+	Versions   []string
+	MinVersion string
+	MaxVersion string
+}
+
+// GetTLSConfig gets the supported TLS versions of a site with a single site status call
+func (c *Client) GetTLSConfig(siteID int) (*TLSConfig, error) {
+	log.Printf("[INFO] Getting Incapsula TLS configuration for site_id: %d\n", siteID)
+
+	siteStatusResponse, err := c.SiteStatus("", siteID)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting TLS configuration for site_id %d: %s", siteID, err)
+	}
+
+	tlsConfig := tlsConfigFromStatus(siteStatusResponse)
+	return &tlsConfig, nil
+}
+
+// tlsConfigFromStatus extracts the TLS configuration from a site status. The min and max versions of the status take
+// precedence, otherwise all the versions are supported with support_all_tls_versions and TLS 1.2 and above without it.
+func tlsConfigFromStatus(siteStatusResponse *SiteStatusResponse) TLSConfig {
+	minVersion := defaultMinTLSVersion
+	if siteStatusResponse.SupportAllTLSVersions {
+		minVersion = tlsVersions[0]
+	}
+	if contains(tlsVersions, siteStatusResponse.MinTLSVersion) {
+		minVersion = siteStatusResponse.MinTLSVersion
+	}
+	maxVersion := tlsVersions[len(tlsVersions)-1]
+	if contains(tlsVersions, siteStatusResponse.MaxTLSVersion) {
+		maxVersion = siteStatusResponse.MaxTLSVersion
+	}
+
+	versions := make([]string, 0, len(tlsVersions))
+	inRange := false
+	for _, version := range tlsVersions {
+		if version == minVersion {
+			inRange = true
+		}
+		if inRange {
+			versions = append(versions, version)
+		}
+		if version == maxVersion {
+			break
+		}
+	}
+
+	return TLSConfig{
+		SupportAllTLSVersions: siteStatusResponse.SupportAllTLSVersions,
+		Versions:              versions,
+		MinVersion:            minVersion,
+		MaxVersion:            maxVersion,
+	}
+}
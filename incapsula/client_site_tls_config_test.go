@@ -0,0 +1,64 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// GetTLSConfig Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetTLSConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"site_id":42,"res":0,"support_all_tls_versions":false}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	tlsConfig, err := client.GetTLSConfig(42)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	expected := TLSConfig{
+		Versions:   []string{"TLSv1.2", "TLSv1.3"},
+		MinVersion: "TLSv1.2",
+		MaxVersion: "TLSv1.3",
+	}
+	if !reflect.DeepEqual(*tlsConfig, expected) {
+		t.Errorf("Unexpected TLS configuration, expected %+v, got: %+v", expected, *tlsConfig)
+	}
+}
+
+func TestTLSConfigFromStatus(t *testing.T) {
+	statuses := map[string]TLSConfig{
+		`{"res":0,"support_all_tls_versions":true}`: {
+			SupportAllTLSVersions: true,
+			Versions:              []string{"TLSv1", "TLSv1.1", "TLSv1.2", "TLSv1.3"},
+			MinVersion:            "TLSv1",
+			MaxVersion:            "TLSv1.3",
+		},
+		`{"res":0,"support_all_tls_versions":true,"min_tls_version":"TLSv1.1","max_tls_version":"TLSv1.2"}`: {
+			SupportAllTLSVersions: true,
+			Versions:              []string{"TLSv1.1", "TLSv1.2"},
+			MinVersion:            "TLSv1.1",
+			MaxVersion:            "TLSv1.2",
+		},
+	}
+	for status, expected := range statuses {
+		var siteStatusResponse SiteStatusResponse
+		err := json.Unmarshal([]byte(status), &siteStatusResponse)
+		if err != nil {
+			t.Fatalf("Failed to parse site status: %s", err)
+		}
+		tlsConfig := tlsConfigFromStatus(&siteStatusResponse)
+		if !reflect.DeepEqual(tlsConfig, expected) {
+			t.Errorf("Unexpected TLS configuration for %s, expected %+v, got: %+v", status, expected, tlsConfig)
+		}
+	}
+}
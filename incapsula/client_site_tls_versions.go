@@ -0,0 +1,22 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+)
+
+const supportAllTLSVersionsParam = "support_all_tls_versions"
+
+// SetSupportAllTLSVersions sets whether a site supports all the TLS versions, including TLS 1.0 and 1.1, between the
+// clients and Incapsula
+func (c *Client) SetSupportAllTLSVersions(siteID int, enabled bool) error {
+	log.Printf("[INFO] Setting Incapsula support all TLS versions (%t) for site_id: %d\n", enabled, siteID)
+
+	_, err := c.updateSite(strconv.Itoa(siteID), supportAllTLSVersionsParam, strconv.FormatBool(enabled), nil)
+	if err != nil {
+		return fmt.Errorf("Error setting support all TLS versions (%t) for site_id %d: %s", enabled, siteID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// SetSupportAllTLSVersions Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetSupportAllTLSVersions(t *testing.T) {
+	for _, enabled := range []bool{true, false} {
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			if req.URL.String() != fmt.Sprintf("/%s", endpointSiteUpdate) {
+				t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteUpdate, req.URL.String())
+			}
+			req.ParseForm()
+			if req.PostForm.Get("param") != supportAllTLSVersionsParam || req.PostForm.Get("value") != fmt.Sprintf("%t", enabled) {
+				t.Errorf("Unexpected param/value, got: %s/%s", req.PostForm.Get("param"), req.PostForm.Get("value"))
+			}
+			rw.Write([]byte(`{"site_id":42,"res":0}`))
+		}))
+
+		config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+		client := &Client{config: config, httpClient: &http.Client{}}
+		err := client.SetSupportAllTLSVersions(42, enabled)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("Should not have received an error, got: %s", err)
+		}
+	}
+}
+
+func TestClientSetSupportAllTLSVersionsBadResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":1,"res_message":"Unexpected error"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetSupportAllTLSVersions(42, true)
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error setting support all TLS versions (true) for site_id 42") {
+		t.Errorf("Should have received a set support all TLS versions error, got: %s", err)
+	}
+}
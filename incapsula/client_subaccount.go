@@ -74,7 +74,7 @@ func (c *Client) AddSubAccount(subAccountPayload *SubAccountPayload) (*SubAccoun
 	log.Printf("[DEBUG] refID %s\n", subAccountPayload.RefID)
 	log.Printf("[DEBUG] values %s\n", values)
 
-	resp, err := c.PostFormWithHeaders(fmt.Sprintf("%s/%s", c.config.BaseURL, endpointSubAccountAdd), values, CreateSubAccount)
+	resp, err := c.PostFormWithHeaders(c.endpointURL(endpointSubAccountAdd), values, CreateSubAccount)
 	if err != nil {
 		return nil, fmt.Errorf("Error adding subaccount %s: %s", subAccountPayload.SubAccountName, err)
 	}
@@ -113,7 +113,7 @@ func (c *Client) DeleteSubAccount(subAccountID int) error {
 	log.Printf("[INFO] Deleting Incapsula subaccount id: %d\n", subAccountID)
 
 	// Post form to Incapsula
-	resp, err := c.PostFormWithHeaders(fmt.Sprintf("%s/%s", c.config.BaseURL, endpointSubAccountDelete), url.Values{
+	resp, err := c.PostFormWithHeaders(c.endpointURL(endpointSubAccountDelete), url.Values{
 		"sub_account_id": {strconv.Itoa(subAccountID)},
 	}, DeleteSubAccount)
 	if err != nil {
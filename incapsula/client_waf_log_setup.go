@@ -59,7 +59,7 @@ func (c *Client) activateAndUpdateStatus(wafLogSetupPayload *WAFLogSetupPayload)
 		"account_id": {strconv.Itoa(wafLogSetupPayload.AccountID)},
 	}
 
-	respActivate, errActivate := c.PostFormWithHeaders(fmt.Sprintf("%s/%s", c.config.BaseURL, endpointWAFLogsActivate), valuesActivate, ActivateWAFLogSetup)
+	respActivate, errActivate := c.PostFormWithHeaders(c.endpointURL(endpointWAFLogsActivate), valuesActivate, ActivateWAFLogSetup)
 	if errActivate != nil {
 		return fmt.Errorf("Error activating WAF Log Setup for account  %d: %s", wafLogSetupPayload.AccountID, errActivate)
 	}
@@ -99,7 +99,7 @@ func (c *Client) activateAndUpdateStatus(wafLogSetupPayload *WAFLogSetupPayload)
 		"logs_config_new_status": {logsConfigNewStatus},
 	}
 
-	respStatus, errStatus := c.PostFormWithHeaders(fmt.Sprintf("%s/%s", c.config.BaseURL, endpointWAFLogsChangeStatus), valuesStatus, UpdateStatusWAFLogSetup)
+	respStatus, errStatus := c.PostFormWithHeaders(c.endpointURL(endpointWAFLogsChangeStatus), valuesStatus, UpdateStatusWAFLogSetup)
 	if errActivate != nil {
 		return fmt.Errorf("Error changing WAF Log Setup status for account  %d: %s", wafLogSetupPayload.AccountID, errStatus)
 	}
@@ -153,7 +153,7 @@ func (c *Client) AddWAFLogSetupS3(wafLogSetupPayload *WAFLogSetupPayload) (*WAFL
 		values["save_on_success"][0] = fmt.Sprint(saveOnSuccess)
 		endpoint = endpointTestCreateS3
 	}
-	resp, err := c.PostFormWithHeaders(fmt.Sprintf("%s/%s", c.config.BaseURL, endpoint), values, CreateWAFLogSetup)
+	resp, err := c.PostFormWithHeaders(c.endpointURL(endpoint), values, CreateWAFLogSetup)
 	if err != nil {
 		return nil, fmt.Errorf("Error creating S3 WAF Log Setup for account  %d: %s", wafLogSetupPayload.AccountID, err)
 	}
@@ -212,7 +212,7 @@ func (c *Client) AddWAFLogSetupSFTP(wafLogSetupPayload *WAFLogSetupPayload) (*WA
 		endpoint = endpointTestCreateSFTP
 	}
 
-	resp, err := c.PostFormWithHeaders(fmt.Sprintf("%s/%s", c.config.BaseURL, endpoint), values, CreateWAFLogSetup)
+	resp, err := c.PostFormWithHeaders(c.endpointURL(endpoint), values, CreateWAFLogSetup)
 	if err != nil {
 		return nil, fmt.Errorf("Error creating SFTP WAF Log Setup for account  %d: %s", wafLogSetupPayload.AccountID, err)
 	}
@@ -265,7 +265,7 @@ func (c *Client) RestoreWAFLogSetupDefault(accountID int) (*WAFLogSetupResponse,
 		"account_id": {strconv.Itoa(accountID)},
 	}
 
-	resp, err := c.PostFormWithHeaders(fmt.Sprintf("%s/%s", c.config.BaseURL, endpointCreateDefault), values, DeleteWAFLogSetup)
+	resp, err := c.PostFormWithHeaders(c.endpointURL(endpointCreateDefault), values, DeleteWAFLogSetup)
 	if err != nil {
 		return nil, fmt.Errorf("Error restoring WAF Log Setup to default for account  %d: %s", accountID, err)
 	}
@@ -0,0 +1,66 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"sort"
+)
+
+// WAF rules that are configured with a security_rule_action
+var wafActionRuleIDs = []string{backdoorRuleID, crossSiteScriptingRuleID, illegalResourceAccessRuleID, remoteFileInclusionRuleID, sqlInjectionRuleID}
+
+// WafRuleChange is a WAF rule whose current action differs from the desired one
+type WafRuleChange struct {
+	RuleID        string
+	CurrentAction string
+	DesiredAction string
+}
+
+// GetWafRules returns the WAF rules of a site, read from the security section of the site status
+func (c *Client) GetWafRules(siteID int) ([]WAFRule, error) {
+	log.Printf("[INFO] Getting Incapsula WAF rules for site_id: %d\n", siteID)
+
+	siteStatusResponse, err := c.SiteStatusFields(siteID, []string{"security"})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting WAF rules for site_id %d: %s", siteID, err)
+	}
+
+	return siteStatusResponse.Security.Waf.Rules, nil
+}
+
+// PlanWafRuleChanges compares the desired actions (keyed by rule id) with the current WAF rules of a site and returns
+// the rules that differ, sorted by rule id. Nothing is applied. A rule missing from the site status has an empty
+// current action.
+func (c *Client) PlanWafRuleChanges(siteID int, desired map[string]string) ([]WafRuleChange, error) {
+	for ruleID := range desired {
+		if !contains(wafActionRuleIDs, ruleID) {
+			return nil, fmt.Errorf("Error - WAF security rule rule_id (%s) isn't configured with an action, must be one of %v", ruleID, wafActionRuleIDs)
+		}
+	}
+
+	rules, err := c.GetWafRules(siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	return planWafRuleChanges(rules, desired), nil
+}
+
+func planWafRuleChanges(rules []WAFRule, desired map[string]string) []WafRuleChange {
+	currentActions := make(map[string]string)
+	for _, rule := range rules {
+		currentActions[rule.ID] = rule.Action
+	}
+
+	changes := make([]WafRuleChange, 0)
+	for ruleID, desiredAction := range desired {
+		if currentActions[ruleID] != desiredAction {
+			changes = append(changes, WafRuleChange{RuleID: ruleID, CurrentAction: currentActions[ruleID], DesiredAction: desiredAction})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].RuleID < changes[j].RuleID
+	})
+
+	return changes
+}
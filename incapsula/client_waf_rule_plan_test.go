@@ -0,0 +1,81 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// PlanWafRuleChanges Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientPlanWafRuleChanges(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != fmt.Sprintf("/%s", endpointSiteStatus) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteStatus, req.URL.Path)
+		}
+		if req.Method != http.MethodPost {
+			t.Errorf("Should not have applied any change, got a %s request", req.Method)
+		}
+		rw.Write([]byte(`{"res":0,"security":{"waf":{"rules":[
+			{"id":"api.threats.sql_injection","action":"api.threats.action.block_request"},
+			{"id":"api.threats.cross_site_scripting","action":"api.threats.action.alert"},
+			{"id":"api.threats.backdoor","action":"api.threats.action.quarantine_url"},
+			{"id":"api.threats.ddos","activation_mode":"api.threats.ddos.activation_mode.auto"}]}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	changes, err := client.PlanWafRuleChanges(42, map[string]string{
+		sqlInjectionRuleID:          "api.threats.action.block_request",
+		crossSiteScriptingRuleID:    "api.threats.action.block_request",
+		backdoorRuleID:              "api.threats.action.quarantine_url",
+		remoteFileInclusionRuleID:   "api.threats.action.block_ip",
+		illegalResourceAccessRuleID: "api.threats.action.alert",
+	})
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	expected := []WafRuleChange{
+		{RuleID: crossSiteScriptingRuleID, CurrentAction: "api.threats.action.alert", DesiredAction: "api.threats.action.block_request"},
+		{RuleID: illegalResourceAccessRuleID, CurrentAction: "", DesiredAction: "api.threats.action.alert"},
+		{RuleID: remoteFileInclusionRuleID, CurrentAction: "", DesiredAction: "api.threats.action.block_ip"},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Unexpected changes, expected %+v, got: %+v", expected, changes)
+	}
+}
+
+func TestClientPlanWafRuleChangesNoChanges(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":0,"security":{"waf":{"rules":[{"id":"api.threats.sql_injection","action":"api.threats.action.block_request"}]}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	changes, err := client.PlanWafRuleChanges(42, map[string]string{sqlInjectionRuleID: "api.threats.action.block_request"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("Should not have found changes, got: %+v", changes)
+	}
+}
+
+func TestClientPlanWafRuleChangesInvalidRuleID(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.PlanWafRuleChanges(42, map[string]string{ddosRuleID: "api.threats.action.block_request"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	} else if !strings.HasPrefix(err.Error(), "Error - WAF security rule rule_id (api.threats.ddos) isn't configured with an action") {
+		t.Errorf("Should have received an invalid rule error, got: %s", err)
+	}
+}
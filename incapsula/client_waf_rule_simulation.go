@@ -0,0 +1,60 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// WAF rule action which only alerts on the detected threats, used to simulate a rule before blocking
+const wafRuleActionAlert = "api.threats.action.alert"
+
+// SetWafRuleSimulation puts a WAF rule in alert mode for the given duration, so its impact can be measured before it
+// blocks. Incapsula has no schedule for the rule actions: promoting the rule to its blocking action once the duration
+// has elapsed is driven by the provider, which records the end of the simulation (see wafRuleSimulationEnd). Only the
+// rules configured with an action support simulation.
+func (c *Client) SetWafRuleSimulation(siteID int, ruleID string, duration time.Duration) error {
+	log.Printf("[INFO] Simulating Incapsula WAF rule id (%s) for %s on site_id: %d\n", ruleID, duration, siteID)
+
+	values, err := wafRuleSimulationValues(siteID, ruleID, duration)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.postWAFSecurityRule(siteID, ruleID, values)
+	if err != nil {
+		return fmt.Errorf("Error simulating WAF rule %s for site_id %d: %s", ruleID, siteID, err)
+	}
+
+	return nil
+}
+
+// wafRuleSimulationValues validates the simulation and returns the values sent to put the rule in alert mode
+func wafRuleSimulationValues(siteID int, ruleID string, duration time.Duration) (url.Values, error) {
+	if !contains(wafActionRuleIDs, ruleID) {
+		return nil, fmt.Errorf("Error - WAF security rule rule_id (%s) doesn't support simulation, must be one of %v", ruleID, wafActionRuleIDs)
+	}
+	if duration <= 0 {
+		return nil, fmt.Errorf("Error - invalid WAF rule simulation duration (%s), must be positive", duration)
+	}
+
+	return url.Values{
+		"site_id":              {strconv.Itoa(siteID)},
+		"rule_id":              {ruleID},
+		"security_rule_action": {wafRuleActionAlert},
+	}, nil
+}
+
+// wafRuleSimulationEnd returns when a simulation started at start should be promoted to the rule's blocking action
+func wafRuleSimulationEnd(start time.Time, duration time.Duration) string {
+	return start.Add(duration).UTC().Format(time.RFC3339)
+}
+
+// wafRuleSimulationEnded returns true once the recorded end of a simulation has passed. An end that can't be parsed
+// is treated as passed, so the rule doesn't stay in alert mode.
+func wafRuleSimulationEnded(simulationEnd string, now time.Time) bool {
+	end, err := time.Parse(time.RFC3339, simulationEnd)
+	return err != nil || !now.Before(end)
+}
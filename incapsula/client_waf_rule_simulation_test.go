@@ -0,0 +1,79 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// SetWafRuleSimulation Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetWafRuleSimulationValidRule(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointWAFRuleConfigure) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointWAFRuleConfigure, req.URL.String())
+		}
+		req.ParseForm()
+		if req.PostForm.Get("site_id") != "42" || req.PostForm.Get("rule_id") != sqlInjectionRuleID {
+			t.Errorf("Unexpected site_id/rule_id, got: %s/%s", req.PostForm.Get("site_id"), req.PostForm.Get("rule_id"))
+		}
+		if req.PostForm.Get("security_rule_action") != wafRuleActionAlert {
+			t.Errorf("Expected security_rule_action to be %s, got: %s", wafRuleActionAlert, req.PostForm.Get("security_rule_action"))
+		}
+		rw.Write([]byte(`{"site_id":42,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetWafRuleSimulation(42, sqlInjectionRuleID, 168*time.Hour)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetWafRuleSimulationUnsupportedRule(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetWafRuleSimulation(42, ddosRuleID, time.Hour)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error - WAF security rule rule_id (api.threats.ddos) doesn't support simulation") {
+		t.Errorf("Should have received an unsupported rule error, got: %s", err)
+	}
+}
+
+func TestClientSetWafRuleSimulationInvalidDuration(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetWafRuleSimulation(42, sqlInjectionRuleID, 0)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error - invalid WAF rule simulation duration") {
+		t.Errorf("Should have received an invalid duration error, got: %s", err)
+	}
+}
+
+func TestWafRuleSimulationEnded(t *testing.T) {
+	start := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	simulationEnd := wafRuleSimulationEnd(start, 24*time.Hour)
+	if simulationEnd != "2023-03-02T12:00:00Z" {
+		t.Errorf("Unexpected simulation end, got: %s", simulationEnd)
+	}
+	if wafRuleSimulationEnded(simulationEnd, start.Add(time.Hour)) {
+		t.Errorf("Simulation should not have ended after an hour")
+	}
+	if !wafRuleSimulationEnded(simulationEnd, start.Add(24*time.Hour)) {
+		t.Errorf("Simulation should have ended after its duration")
+	}
+	if !wafRuleSimulationEnded("badness", start) {
+		t.Errorf("A simulation end which can't be parsed should be treated as ended")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/url"
 	"strconv"
+	"strings"
 )
 
 // Endpoints (unexported consts)
@@ -46,8 +47,169 @@ func (c *Client) ConfigureWAFSecurityRule(siteID int, ruleID, securityRuleAction
 		return nil, fmt.Errorf("Error - invalid WAF security rule rule_id (%s)", ruleID)
 	}
 
+	return c.postWAFSecurityRule(siteID, ruleID, values)
+}
+
+// DDoS rule activation modes
+const (
+	ddosActivationModeAuto = "api.threats.ddos.activation_mode.auto"
+	ddosActivationModeOn   = "api.threats.ddos.activation_mode.on"
+)
+
+// Allowed DDoS traffic thresholds, in requests per second
+var ddosTrafficThresholds = []int{10, 20, 50, 100, 200, 500, 750, 1000, 2000, 3000, 4000, 5000}
+
+// SetDDoSMode configures the DDoS rule of a site. In auto mode the threshold is adaptive, so threshold must be 0.
+// Otherwise the rule is on and the site is considered under DDoS above the threshold.
+func (c *Client) SetDDoSMode(siteID int, auto bool, threshold int) error {
+	values, err := ddosModeValues(siteID, auto, threshold)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Configuring Incapsula WAF rule id (%s) with activation mode (%s) and DDoS traffic threshold (%s) for site id (%d)\n", ddosRuleID, values.Get("activation_mode"), values.Get("ddos_traffic_threshold"), siteID)
+
+	_, err = c.postWAFSecurityRule(siteID, ddosRuleID, values)
+	return err
+}
+
+func ddosModeValues(siteID int, auto bool, threshold int) (url.Values, error) {
+	values := url.Values{
+		"site_id": {strconv.Itoa(siteID)},
+		"rule_id": {ddosRuleID},
+	}
+
+	if auto {
+		if threshold != 0 {
+			return nil, fmt.Errorf("Error - a DDoS traffic threshold (%d) can't be set for WAF security rule rule_id (%s) in auto mode", threshold, ddosRuleID)
+		}
+		values.Add("activation_mode", ddosActivationModeAuto)
+		return values, nil
+	}
+
+	found := false
+	for _, allowedThreshold := range ddosTrafficThresholds {
+		if threshold == allowedThreshold {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("Error - invalid DDoS traffic threshold (%d) for WAF security rule rule_id (%s), must be one of %v", threshold, ddosRuleID, ddosTrafficThresholds)
+	}
+	values.Add("activation_mode", ddosActivationModeOn)
+	values.Add("ddos_traffic_threshold", strconv.Itoa(threshold))
+
+	return values, nil
+}
+
+// SetBotAccessControlAllowlist replaces the client application types exempted from the bot access control WAF rule
+// (e.g. monitoring bots). They're managed as an exception of the rule, found by the previous client application types
+// since the exception id isn't known. An empty allowlist removes the exception.
+func (c *Client) SetBotAccessControlAllowlist(siteID int, previousClientAppTypes, clientAppTypes []string) error {
+	log.Printf("[INFO] Setting Incapsula bot access control allowlist (%v) for site id (%d)\n", clientAppTypes, siteID)
+
+	var exception *SecurityRuleException
+	if len(previousClientAppTypes) > 0 {
+		var err error
+		exception, err = c.FindWafException(siteID, botAccessControlExceptionRuleID, ExceptionKey{ClientAppTypes: strings.Join(previousClientAppTypes, ",")})
+		if err != nil {
+			return fmt.Errorf("Error finding the allowlist exception of WAF security rule rule_id (%s) for site_id (%d): %s", botAccessControlRuleID, siteID, err)
+		}
+	}
+
+	if len(clientAppTypes) == 0 {
+		if exception == nil {
+			return nil
+		}
+		return c.DeleteSecurityRuleException(siteID, botAccessControlExceptionRuleID, strconv.Itoa(exception.ID))
+	}
+
+	if exception != nil {
+		_, err := c.EditSecurityRuleException(siteID, botAccessControlExceptionRuleID, strings.Join(clientAppTypes, ","), "", "", "", "", "", "", "", "", strconv.Itoa(exception.ID))
+		return err
+	}
+
+	_, err := c.AddSecurityRuleException(siteID, botAccessControlExceptionRuleID, strings.Join(clientAppTypes, ","), "", "", "", "", "", "", "", "")
+	return err
+}
+
+func botAccessControlRuleValues(siteID int, blockBadBots, challengeSuspectedBots string) url.Values {
+	return url.Values{
+		"site_id":                  {strconv.Itoa(siteID)},
+		"rule_id":                  {botAccessControlRuleID},
+		"block_bad_bots":           {blockBadBots},
+		"challenge_suspected_bots": {challengeSuspectedBots},
+	}
+}
+
+// SetBadBotPolicy sets block_bad_bots of the bot access control WAF rule along with the bad bot signatures (client
+// application ids) that are still allowed. The other settings of the rule are read from the site status and kept.
+func (c *Client) SetBadBotPolicy(siteID int, enabled bool, allowedSignatures []string) error {
+	err := validateBadBotSignatures(enabled, allowedSignatures)
+	if err != nil {
+		return err
+	}
+
+	siteStatusResponse, err := c.SiteStatusFields(siteID, []string{"security"})
+	if err != nil {
+		return err
+	}
+
+	var botRule *WAFRule
+	for i, rule := range siteStatusResponse.Security.Waf.Rules {
+		if rule.ID == botAccessControlRuleID {
+			botRule = &siteStatusResponse.Security.Waf.Rules[i]
+			break
+		}
+	}
+	if botRule == nil {
+		return fmt.Errorf("Error - WAF security rule rule_id (%s) not found for site_id (%d)", botAccessControlRuleID, siteID)
+	}
+
+	values := badBotPolicyValues(siteID, enabled, allowedSignatures, botRule)
+
+	log.Printf("[INFO] Configuring Incapsula WAF rule id (%s) with block_bad_bots (%t) and %d allowed bad bot signatures for site id (%d)\n", botAccessControlRuleID, enabled, len(allowedSignatures), siteID)
+
+	_, err = c.postWAFSecurityRule(siteID, botAccessControlRuleID, values)
+	return err
+}
+
+// badBotPolicyValues returns the bot access control rule values with block_bad_bots and the bad bot allowlist replaced
+func badBotPolicyValues(siteID int, enabled bool, allowedSignatures []string, botRule *WAFRule) url.Values {
+	values := botAccessControlRuleValues(siteID, strconv.FormatBool(enabled), strconv.FormatBool(botRule.ChallengeSuspectedBots))
+
+	// An empty allowlist is sent explicitly so that previously allowed signatures are removed
+	values.Set("bad_bots_allowlist", strings.Join(allowedSignatures, ","))
+
+	return values
+}
+
+// validateBadBotSignatures checks that the allowed signatures are distinct client application ids (see the
+// incapsula_client_apps data source) and that they're only set when bad bots are blocked
+func validateBadBotSignatures(enabled bool, allowedSignatures []string) error {
+	if !enabled && len(allowedSignatures) > 0 {
+		return fmt.Errorf("Error - a bad bot allowlist for WAF security rule rule_id (%s) requires block_bad_bots to be true", botAccessControlRuleID)
+	}
+
+	seen := make(map[string]bool)
+	for _, signature := range allowedSignatures {
+		clientAppID, err := strconv.Atoi(signature)
+		if err != nil || clientAppID <= 0 {
+			return fmt.Errorf("Error - invalid bad bot signature (%s), must be a numeric client application id", signature)
+		}
+		if seen[signature] {
+			return fmt.Errorf("Error - duplicate bad bot signature (%s)", signature)
+		}
+		seen[signature] = true
+	}
+
+	return nil
+}
+
+func (c *Client) postWAFSecurityRule(siteID int, ruleID string, values url.Values) (*SiteStatusResponse, error) {
 	// Post form to Incapsula
-	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointWAFRuleConfigure)
+	reqURL := c.endpointURL(endpointWAFRuleConfigure)
 	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateSecurityRule)
 	if err != nil {
 		return nil, fmt.Errorf("Error configuring WAF security rule rule_id (%s) for site_id (%d)", ruleID, siteID)
@@ -8,6 +8,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 ////////////////////////////////////////////////////////////////
@@ -287,3 +289,266 @@ func TestClientConfigureWAFSecurityRuleResultCodeStringValidRule(t *testing.T) {
 		t.Errorf("Should not have received a nil configureWAFSecurityRuleResponse instance")
 	}
 }
+
+////////////////////////////////////////////////////////////////
+// SetBotAccessControlAllowlist Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetBotAccessControlAllowlistAddsException(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_waf_security_rule.TestClientSetBotAccessControlAllowlistAddsException")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointExceptionConfigure) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointExceptionConfigure, req.URL.String())
+		}
+		req.ParseForm()
+		expected := map[string]string{
+			"site_id":          "1234",
+			"rule_id":          botAccessControlExceptionRuleID,
+			"client_app_types": "Site Helper,Monitoring",
+		}
+		for key, value := range expected {
+			if req.PostForm.Get(key) != value {
+				t.Errorf("Expected %s to be %s, got: %s", key, value, req.PostForm.Get(key))
+			}
+		}
+		for _, key := range []string{"block_non_essential_bots", "client_apps", "whitelist_id"} {
+			if _, ok := req.PostForm[key]; ok {
+				t.Errorf("Should not have sent %s", key)
+			}
+		}
+		rw.Write([]byte(`{"res":"0","exception_id":"42"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetBotAccessControlAllowlist(1234, nil, []string{"Site Helper", "Monitoring"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetBotAccessControlAllowlistEditsException(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_waf_security_rule.TestClientSetBotAccessControlAllowlistEditsException")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() == fmt.Sprintf("/%s", endpointExceptionList) {
+			rw.Write([]byte(`{"res":0,"security":{"waf":{"rules":[{"id":"api.threats.bot_access_control","exceptions":[{"id":42,"values":[{"id":"api.rule_exception_type.client_app_type","client_app_types":["Site Helper"]}]}]}]}}}`))
+			return
+		}
+		req.ParseForm()
+		if req.PostForm.Get("whitelist_id") != "42" || req.PostForm.Get("client_app_types") != "Monitoring" {
+			t.Errorf("Should have edited exception 42 with the new client application types, got: %s/%s", req.PostForm.Get("whitelist_id"), req.PostForm.Get("client_app_types"))
+		}
+		rw.Write([]byte(`{"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetBotAccessControlAllowlist(1234, []string{"Site Helper"}, []string{"Monitoring"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetBotAccessControlAllowlistEmptyDeletesException(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_waf_security_rule.TestClientSetBotAccessControlAllowlistEmptyDeletesException")
+	deleted := false
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() == fmt.Sprintf("/%s", endpointExceptionList) {
+			rw.Write([]byte(`{"res":0,"security":{"waf":{"rules":[{"id":"api.threats.bot_access_control","exceptions":[{"id":42,"values":[{"id":"api.rule_exception_type.client_app_type","client_app_types":["Site Helper"]}]}]}]}}}`))
+			return
+		}
+		req.ParseForm()
+		if req.PostForm.Get("whitelist_id") != "42" || req.PostForm.Get("delete_whitelist") != "true" {
+			t.Errorf("Should have deleted exception 42, got: %v", req.PostForm)
+		}
+		deleted = true
+		rw.Write([]byte(`{"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetBotAccessControlAllowlist(1234, []string{"Site Helper"}, nil)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if !deleted {
+		t.Errorf("Should have deleted the allowlist exception")
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// SetDDoSMode Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetDDoSModeAuto(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		if req.PostForm.Get("rule_id") != ddosRuleID || req.PostForm.Get("activation_mode") != ddosActivationModeAuto {
+			t.Errorf("Unexpected rule_id/activation_mode, got: %s/%s", req.PostForm.Get("rule_id"), req.PostForm.Get("activation_mode"))
+		}
+		if _, ok := req.PostForm["ddos_traffic_threshold"]; ok {
+			t.Errorf("Should not have sent a DDoS traffic threshold in auto mode")
+		}
+		rw.Write([]byte(`{"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetDDoSMode(1234, true, 0)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetDDoSModeThreshold(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		if req.PostForm.Get("activation_mode") != ddosActivationModeOn || req.PostForm.Get("ddos_traffic_threshold") != "750" {
+			t.Errorf("Unexpected activation_mode/ddos_traffic_threshold, got: %s/%s", req.PostForm.Get("activation_mode"), req.PostForm.Get("ddos_traffic_threshold"))
+		}
+		rw.Write([]byte(`{"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetDDoSMode(1234, false, 750)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetDDoSModeInvalidThreshold(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	err := client.SetDDoSMode(1234, true, 1000)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - a DDoS traffic threshold (1000) can't be set") {
+		t.Errorf("Should have received a threshold in auto mode error, got: %v", err)
+	}
+
+	err = client.SetDDoSMode(1234, false, 123)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - invalid DDoS traffic threshold (123)") {
+		t.Errorf("Should have received an invalid threshold error, got: %v", err)
+	}
+}
+
+func TestConfigureDDoSRuleThresholdOnlyInManualMode(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	for _, activationMode := range []string{ddosActivationModeAuto, "api.threats.ddos.activation_mode.off"} {
+		d := schema.TestResourceDataRaw(t, resourceWAFSecurityRule().Schema, map[string]interface{}{
+			"site_id":                1234,
+			"rule_id":                ddosRuleID,
+			"activation_mode":        activationMode,
+			"ddos_traffic_threshold": "1000",
+		})
+		err := configureDDoSRule(client, d)
+		if err == nil || !strings.Contains(err.Error(), "DDoS traffic threshold (1000)") {
+			t.Errorf("Should have rejected the threshold with activation_mode %s, got: %v", activationMode, err)
+		}
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// SetBadBotPolicy Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetBadBotPolicyCombinedRequestBody(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_waf_security_rule.TestClientSetBadBotPolicyCombinedRequestBody")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() == fmt.Sprintf("/%s", endpointSiteStatus) {
+			rw.Write([]byte(`{"res":0,"security":{"waf":{"rules":[{"id":"api.threats.bot_access_control","block_bad_bots":true,"challenge_suspected_bots":true}]}}}`))
+			return
+		}
+		if req.URL.String() != fmt.Sprintf("/%s", endpointWAFRuleConfigure) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointWAFRuleConfigure, req.URL.String())
+		}
+		req.ParseForm()
+		expected := map[string]string{
+			"site_id":                  "1234",
+			"rule_id":                  botAccessControlRuleID,
+			"block_bad_bots":           "true",
+			"challenge_suspected_bots": "true",
+			"bad_bots_allowlist":       "1071,1455",
+		}
+		for key, value := range expected {
+			if req.PostForm.Get(key) != value {
+				t.Errorf("Expected %s to be %s, got: %s", key, value, req.PostForm.Get(key))
+			}
+		}
+		rw.Write([]byte(`{"res":0,"res_message":"OK"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetBadBotPolicy(1234, true, []string{"1071", "1455"})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetBadBotPolicyEmptyAllowlist(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_waf_security_rule.TestClientSetBadBotPolicyEmptyAllowlist")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() == fmt.Sprintf("/%s", endpointSiteStatus) {
+			rw.Write([]byte(`{"res":0,"security":{"waf":{"rules":[{"id":"api.threats.bot_access_control","block_bad_bots":true,"bad_bots_allowlist":["1071"]}]}}}`))
+			return
+		}
+		req.ParseForm()
+		if values, ok := req.PostForm["bad_bots_allowlist"]; !ok || values[0] != "" {
+			t.Errorf("Should have sent an empty bad_bots_allowlist, got: %v", req.PostForm["bad_bots_allowlist"])
+		}
+		if req.PostForm.Get("block_bad_bots") != "false" || req.PostForm.Get("challenge_suspected_bots") != "false" {
+			t.Errorf("Unexpected block_bad_bots/challenge_suspected_bots, got: %s/%s", req.PostForm.Get("block_bad_bots"), req.PostForm.Get("challenge_suspected_bots"))
+		}
+		rw.Write([]byte(`{"res":0,"res_message":"OK"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetBadBotPolicy(1234, false, nil)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientSetBadBotPolicyInvalidSignatures(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_waf_security_rule.TestClientSetBadBotPolicyInvalidSignatures")
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+
+	cases := []struct {
+		enabled    bool
+		signatures []string
+		expected   string
+	}{
+		{true, []string{"curl"}, "Error - invalid bad bot signature (curl)"},
+		{true, []string{"0"}, "Error - invalid bad bot signature (0)"},
+		{true, []string{"1071", "1071"}, "Error - duplicate bad bot signature (1071)"},
+		{false, []string{"1071"}, "Error - a bad bot allowlist for WAF security rule rule_id (api.threats.bot_access_control) requires block_bad_bots to be true"},
+	}
+	for _, c := range cases {
+		err := client.SetBadBotPolicy(1234, c.enabled, c.signatures)
+		if err == nil {
+			t.Errorf("Should have received an error for signatures %v", c.signatures)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), c.expected) {
+			t.Errorf("Expected error %s, got: %s", c.expected, err)
+		}
+	}
+}
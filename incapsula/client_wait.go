@@ -0,0 +1,139 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// Default timeouts of the wait helpers, used when neither the call nor the provider configuration sets one
+const (
+	defaultCertWaitTimeout      = 10 * time.Minute
+	defaultDeleteConfirmTimeout = 2 * time.Minute
+	defaultStatusStableTimeout  = 5 * time.Minute
+	defaultDNSReadyTimeout      = 30 * time.Minute
+)
+
+// Interval between two polls of the wait helpers
+var waitPollInterval = 5 * time.Second
+
+// Incapsula v1 API res code returned when the site doesn't exist (anymore)
+const resSiteNotFound = "9413"
+
+// waitTimeout returns the first non-zero timeout of the explicit timeout, the configured timeout and the default
+func waitTimeout(timeout, configured, fallback time.Duration) time.Duration {
+	if timeout > 0 {
+		return timeout
+	}
+	if configured > 0 {
+		return configured
+	}
+	return fallback
+}
+
+// pollUntil calls check every waitPollInterval until it reports done, fails or the timeout expires
+func pollUntil(timeout time.Duration, check func() (bool, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if time.Now().Add(waitPollInterval).After(deadline) {
+			return fmt.Errorf("timed out after %s", timeout)
+		}
+		time.Sleep(waitPollInterval)
+	}
+}
+
+// WaitForCustomCertificate waits until the custom certificate of the site is active.
+// A zero timeout falls back to the provider's cert_wait_timeout.
+func (c *Client) WaitForCustomCertificate(siteID int, timeout time.Duration) (*CustomCertInfo, error) {
+	timeout = waitTimeout(timeout, c.config.CertWaitTimeout, defaultCertWaitTimeout)
+	log.Printf("[INFO] Waiting up to %s for the custom certificate of site_id %d to be active\n", timeout, siteID)
+
+	var customCertInfo *CustomCertInfo
+	err := pollUntil(timeout, func() (bool, error) {
+		var err error
+		customCertInfo, err = c.GetCustomCertificateInfo(siteID)
+		if err != nil {
+			return false, err
+		}
+		return customCertInfo.Active, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for the custom certificate of site_id %d to be active: %s", siteID, err)
+	}
+
+	return customCertInfo, nil
+}
+
+// WaitForSiteDeleted waits until the site status reports that the site doesn't exist.
+// A zero timeout falls back to the provider's delete_confirm_timeout.
+func (c *Client) WaitForSiteDeleted(siteID int, timeout time.Duration) error {
+	timeout = waitTimeout(timeout, c.config.DeleteConfirmTimeout, defaultDeleteConfirmTimeout)
+	log.Printf("[INFO] Waiting up to %s for site_id %d to be deleted\n", timeout, siteID)
+
+	err := pollUntil(timeout, func() (bool, error) {
+		_, err := c.SiteStatus("", siteID)
+		if incapsulaError, ok := err.(*IncapsulaError); ok && incapsulaError.Res == resSiteNotFound {
+			return true, nil
+		}
+		return false, err
+	})
+	if err != nil {
+		return fmt.Errorf("Error waiting for site_id %d to be deleted: %s", siteID, err)
+	}
+
+	return nil
+}
+
+// WaitForSiteStatusStable waits until two consecutive site status reads have no differences in the managed attributes
+// (see DiffSiteStatus), and returns the last read. A site pending DNS changes can be stable, it stays pending until
+// the DNS records point to Imperva.
+// A zero timeout falls back to the provider's status_stable_timeout.
+func (c *Client) WaitForSiteStatusStable(siteID int, timeout time.Duration) (*SiteStatusResponse, error) {
+	timeout = waitTimeout(timeout, c.config.StatusStableTimeout, defaultStatusStableTimeout)
+	log.Printf("[INFO] Waiting up to %s for the status of site_id %d to be stable\n", timeout, siteID)
+
+	var previous *SiteStatusResponse
+	err := pollUntil(timeout, func() (bool, error) {
+		current, err := c.SiteStatus("", siteID)
+		if err != nil {
+			return false, err
+		}
+		stable := previous != nil && len(DiffSiteStatus(previous, current)) == 0
+		previous = current
+		return stable, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for the status of site_id %d to be stable: %s", siteID, err)
+	}
+
+	return previous, nil
+}
+
+// WaitForDNSReady waits until the site is no longer pending DNS changes (see SiteState), and returns the last site
+// status read. A zero timeout falls back to 30 minutes.
+func (c *Client) WaitForDNSReady(siteID int, timeout time.Duration) (*SiteStatusResponse, error) {
+	timeout = waitTimeout(timeout, 0, defaultDNSReadyTimeout)
+	log.Printf("[INFO] Waiting up to %s for the DNS changes of site_id %d to be detected\n", timeout, siteID)
+
+	var siteStatusResponse *SiteStatusResponse
+	err := pollUntil(timeout, func() (bool, error) {
+		var err error
+		siteStatusResponse, err = c.SiteStatus("", siteID)
+		if err != nil {
+			return false, err
+		}
+		return siteStatusResponse.State() != SiteStatePendingDNSChanges, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for the DNS changes of site_id %d to be detected: %s", siteID, err)
+	}
+
+	return siteStatusResponse, nil
+}
@@ -0,0 +1,145 @@
+package incapsula
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// Wait Helpers Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientWaitForCustomCertificateUsesConfiguredTimeout(t *testing.T) {
+	defer func(interval time.Duration) { waitPollInterval = interval }(waitPollInterval)
+	waitPollInterval = 10 * time.Millisecond
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		rw.Write([]byte(`{"res":0,"ssl":{"custom_certificate":{"active":false}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, CertWaitTimeout: 50 * time.Millisecond}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	start := time.Now()
+	_, err := client.WaitForCustomCertificate(42, 0)
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.Contains(err.Error(), "timed out after 50ms") {
+		t.Errorf("Should have timed out after the configured timeout, got: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed > defaultCertWaitTimeout/2 {
+		t.Errorf("Should not have waited for the default timeout, waited: %s", elapsed)
+	}
+	if requests < 2 {
+		t.Errorf("Should have polled more than once, got %d requests", requests)
+	}
+}
+
+func TestClientWaitForSiteDeleted(t *testing.T) {
+	defer func(interval time.Duration) { waitPollInterval = interval }(waitPollInterval)
+	waitPollInterval = 10 * time.Millisecond
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		if requests < 3 {
+			rw.Write([]byte(`{"site_id":42,"res":0}`))
+			return
+		}
+		rw.Write([]byte(`{"res":9413,"res_message":"Unknown/unauthorized site_id"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.WaitForSiteDeleted(42, time.Second)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if requests != 3 {
+		t.Errorf("Should have polled until the site was not found, got %d requests", requests)
+	}
+}
+
+func TestClientWaitForSiteStatusStableWaitsForIdenticalReads(t *testing.T) {
+	defer func(interval time.Duration) { waitPollInterval = interval }(waitPollInterval)
+	waitPollInterval = 10 * time.Millisecond
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		if requests < 2 {
+			rw.Write([]byte(`{"site_id":42,"status":"pending-dns-changes","acceleration_level_raw":"none","res":0}`))
+			return
+		}
+		rw.Write([]byte(`{"site_id":42,"status":"pending-dns-changes","acceleration_level_raw":"aggressive","res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteStatusResponse, err := client.WaitForSiteStatusStable(42, time.Second)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if siteStatusResponse.AccelerationLevelRaw != "aggressive" {
+		t.Errorf("Should have returned the last read, got acceleration level: %s", siteStatusResponse.AccelerationLevelRaw)
+	}
+	if requests != 3 {
+		t.Errorf("Should have stopped polling after two identical reads of the pending site, got %d requests", requests)
+	}
+}
+
+func TestClientWaitForDNSReady(t *testing.T) {
+	defer func(interval time.Duration) { waitPollInterval = interval }(waitPollInterval)
+	waitPollInterval = 10 * time.Millisecond
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requests++
+		if requests <= 2 {
+			rw.Write([]byte(`{"site_id":42,"status":"pending-dns-changes","res":0}`))
+			return
+		}
+		rw.Write([]byte(`{"site_id":42,"status":"fully-configured","res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteStatusResponse, err := client.WaitForDNSReady(42, time.Second)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if siteStatusResponse.State() != SiteStateFullyConfigured {
+		t.Errorf("Should have returned the ready site status, got: %s", siteStatusResponse.State())
+	}
+	if requests != 3 {
+		t.Errorf("Should have polled until the site was no longer pending DNS changes, got %d requests", requests)
+	}
+}
+
+func TestClientWaitForDNSReadyTimeout(t *testing.T) {
+	defer func(interval time.Duration) { waitPollInterval = interval }(waitPollInterval)
+	waitPollInterval = 10 * time.Millisecond
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"site_id":42,"status":"pending-dns-changes","res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.WaitForDNSReady(42, 50*time.Millisecond)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	} else if !strings.HasPrefix(err.Error(), "Error waiting for the DNS changes of site_id 42 to be detected: timed out") {
+		t.Errorf("Should have received a timeout error, got: %s", err)
+	}
+}
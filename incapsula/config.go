@@ -1,9 +1,15 @@
 package incapsula
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"fmt"
 	"log"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 )
 
 // Config represents the configuration required for the Incapsula Client
@@ -32,6 +38,22 @@ type Config struct {
 	// API V2
 	// Same as revision 2 but with a different subdomain
 	BaseURLAPI string
+
+	// Endpoint base URL overrides (endpoint path -> base URL, no trailing slash)
+	// Used for provider development, e.g. to point a single endpoint at staging
+	EndpointBaseURLOverrides map[string]string
+
+	// Default timeouts of the wait helpers, can be overridden per call
+	// Zero means the helper's built-in default is used
+	CertWaitTimeout      time.Duration
+	DeleteConfirmTimeout time.Duration
+	StatusStableTimeout  time.Duration
+
+	// Forward proxy URL for the outbound requests, the proxy environment variables are used when empty
+	ProxyURL string
+
+	// PEM encoded CA certificates trusted in addition to the system roots, e.g. the CA of a TLS inspecting proxy
+	CACertPEM string
 }
 
 var missingAPIIDMessage = "API Identifier (api_id) must be provided"
@@ -40,6 +62,7 @@ var missingBaseURLMessage = "Base URL must be provided"
 var missingBaseURLRev2Message = "Base URL Revision 2 must be provided"
 var missingBaseURLRev3Message = "Base URL Revision 3 must be provided"
 var missingBaseURLAPIMessage = "Base URL API must be provided"
+var invalidCACertPEMMessage = "No valid certificates found in the CA certificate PEM (ca_cert_pem)"
 
 // Client configures and returns a fully initialized Incapsula Client
 func (c *Config) Client() (interface{}, error) {
@@ -75,8 +98,15 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, errors.New(missingBaseURLAPIMessage)
 	}
 
+	// Build the HTTP transport (proxy and custom CA)
+	transport, err := c.httpTransport()
+	if err != nil {
+		return nil, err
+	}
+
 	// Create client
 	client := NewClient(c)
+	client.httpClient.Transport = transport
 
 	// Verify client credentials
 	accountStatusResponse, err := client.Verify()
@@ -84,6 +114,33 @@ func (c *Config) Client() (interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	logCredentialInfo(credentialInfoFromAccountStatus(accountStatusResponse))
 
 	return client, nil
 }
+
+// httpTransport returns the transport of the HTTP client, applying the proxy and the custom CA certificates
+func (c *Config) httpTransport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if strings.TrimSpace(c.ProxyURL) != "" {
+		proxyURL, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid proxy URL (proxy_url): %s", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if strings.TrimSpace(c.CACertPEM) != "" {
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM([]byte(c.CACertPEM)) {
+			return nil, errors.New(invalidCACertPEMMessage)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs}
+	}
+
+	return transport, nil
+}
@@ -1,6 +1,7 @@
 package incapsula
 
 import (
+	"encoding/pem"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -121,3 +122,34 @@ func TestValidCredentials(t *testing.T) {
 		t.Error("Client should not be nil")
 	}
 }
+
+func TestCustomCACertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":0,"res_message":"OK"}`))
+	}))
+	defer server.Close()
+
+	// The credentials check is sent over TLS to the test server, so it only succeeds when its certificate is trusted
+	caCertPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	config := Config{APIID: "good", APIKey: "good", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLRev3: server.URL, BaseURLAPI: server.URL, CACertPEM: string(caCertPEM)}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	transport, ok := client.(*Client).httpClient.Transport.(*http.Transport)
+	if !ok || transport.TLSClientConfig == nil || transport.TLSClientConfig.RootCAs == nil {
+		t.Fatalf("Should have set the custom CA in the transport RootCAs")
+	}
+}
+
+func TestInvalidCACertificate(t *testing.T) {
+	config := Config{APIID: "good", APIKey: "good", BaseURL: "badness.incapsula.com", BaseURLRev2: "badness.incapsula.com", BaseURLRev3: "badness.incapsula.com", BaseURLAPI: "badness.incapsula.com", CACertPEM: "not a certificate"}
+	_, err := config.Client()
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if err.Error() != invalidCACertPEMMessage {
+		t.Errorf("Should have received invalid CA certificate message, got: %s", err)
+	}
+}
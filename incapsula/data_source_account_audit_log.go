@@ -0,0 +1,118 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"time"
+)
+
+func dataSourceAccountAuditLog() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAccountAuditLogRead,
+
+		Description: "Provides the audit events of an account: who changed what, and when.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"from": {
+				Description:  "Start of the time range, in RFC 3339 format, e.g. 2022-01-01T00:00:00Z.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"to": {
+				Description:  "End of the time range, in RFC 3339 format. The time range can't exceed 90 days.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			// Computed Attributes
+			"events": {
+				Description: "The audit events, oldest first. At most 1000 events are returned.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAccountAuditLogRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	accountID := d.Get("account_id").(int)
+	from, _ := time.Parse(time.RFC3339, d.Get("from").(string))
+	to, _ := time.Parse(time.RFC3339, d.Get("to").(string))
+	auditEvents, err := client.GetAccountAuditLog(accountID, from, to)
+	if err != nil {
+		return diag.Errorf("Error getting audit log for account %d: %s", accountID, err)
+	}
+
+	events := make([]map[string]interface{}, len(auditEvents))
+	for i, event := range auditEvents {
+		events[i] = map[string]interface{}{
+			"time":          event.Time.Format(time.RFC3339),
+			"type":          event.Type,
+			"user_id":       event.UserID,
+			"user_details":  event.UserDetails,
+			"account_id":    event.AccountID,
+			"resource_type": event.ResourceType,
+			"resource_id":   event.ResourceID,
+			"action":        event.Action,
+			"message":       event.Message,
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d/%d/%d", accountID, from.Unix(), to.Unix()))
+	d.Set("events", events)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package incapsula
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+)
+
+func dataSourceAccountCertificates() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAccountCertificatesRead,
+
+		Description: "Provides the certificates of all the sites of an account.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Computed Attributes
+			"certificates": {
+				Description: "The certificates of the account.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expiration_date": {
+							Description: "The expiration date of the certificate, in milliseconds since the epoch.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"sans": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAccountCertificatesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	accountID := d.Get("account_id").(int)
+	certificateSummaries, err := client.ListAccountCertificates(accountID)
+	if err != nil {
+		return diag.Errorf("Error getting certificates for account %d: %s", accountID, err)
+	}
+
+	certificates := make([]map[string]interface{}, len(certificateSummaries))
+	for i, certificate := range certificateSummaries {
+		certificates[i] = map[string]interface{}{
+			"id":              certificate.ID,
+			"name":            certificate.Name,
+			"type":            certificate.Type,
+			"status":          certificate.Status,
+			"expiration_date": certificate.ExpirationDate,
+			"sans":            certificate.SANs,
+		}
+	}
+
+	d.SetId(strconv.Itoa(accountID))
+	d.Set("certificates", certificates)
+
+	return nil
+}
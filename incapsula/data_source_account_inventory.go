@@ -0,0 +1,110 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+)
+
+func dataSourceAccountInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAccountInventoryRead,
+
+		Description: "Provides the key attributes of all the sites of an account, e.g. to sync a CMDB.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Computed Attributes
+			"sites": {
+				Description: "The sites of the account, sorted by site id.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"site_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Description: "The status of the site, e.g. fully_configured.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"active": {
+							Description: "Whether the site traffic goes through Incapsula: `active` or `bypass`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"account_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"acceleration_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cert_status": {
+							Description: "The validation status of the managed certificate, or `ACTIVE` when a custom certificate is in use.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"error": {
+							Description: "The error reading the status of the site, in which case the other attributes come from the site list.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAccountInventoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	accountID := d.Get("account_id").(int)
+	items, err := client.GetAccountInventory(accountID)
+	if err != nil {
+		return diag.Errorf("Error getting inventory for account %d: %s", accountID, err)
+	}
+
+	var diags diag.Diagnostics
+	sites := make([]map[string]interface{}, len(items))
+	for i, item := range items {
+		sites[i] = map[string]interface{}{
+			"site_id":            item.SiteID,
+			"domain":             item.Domain,
+			"status":             item.Status,
+			"active":             item.Active,
+			"account_id":         item.AccountID,
+			"acceleration_level": item.AccelerationLevel,
+			"cert_status":        item.CertStatus,
+			"error":              item.Error,
+		}
+		if item.Error != "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Incomplete inventory for Incapsula site %d", item.SiteID),
+				Detail:   item.Error,
+			})
+		}
+	}
+
+	d.SetId(strconv.Itoa(accountID))
+	d.Set("sites", sites)
+
+	return diags
+}
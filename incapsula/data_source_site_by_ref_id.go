@@ -0,0 +1,79 @@
+package incapsula
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+)
+
+func dataSourceSiteByRefID() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteByRefIDRead,
+
+		Description: "Provides the site whose ref_id matches, for organizations using ref_id as their internal asset id.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"ref_id": {
+				Description: "The reference ID of the site. Exactly one site must match.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to search. If not specified, the account identified by the authentication parameters is searched.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			// Computed Attributes
+			"site_id": {
+				Description: "Numeric identifier of the site.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"domain": {
+				Description: "The fully qualified domain name of the site.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The provisioning state of the site, e.g. fully-configured.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"active": {
+				Description: "active or bypass.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSiteByRefIDRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	var accountID *int
+	if v, ok := d.GetOk("account_id"); ok {
+		id := v.(int)
+		accountID = &id
+	}
+
+	site, err := client.FindSiteByRefID(d.Get("ref_id").(string), accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(site.SiteID))
+	d.Set("site_id", site.SiteID)
+	d.Set("account_id", site.AccountID)
+	d.Set("domain", site.Domain)
+	d.Set("status", site.Status)
+	d.Set("active", site.Active)
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package incapsula
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+	"time"
+)
+
+func dataSourceSiteCertificateChain() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteCertificateChainRead,
+
+		Description: "Provides the chain of the custom certificate of a site: the leaf certificate followed by the intermediates.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Computed Attributes
+			"fingerprint": {
+				Description: "The SHA-256 fingerprint of the leaf certificate, e.g. A6:6E:...:13. Empty when the site has no custom certificate.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"chain": {
+				Description: "The certificates of the chain, leaf first. Empty when the site has no custom certificate.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subject": {
+							Description: "The subject distinguished name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"issuer": {
+							Description: "The issuer distinguished name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"serial_number": {
+							Description: "The serial number, in decimal.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"not_before": {
+							Description: "The start of the validity period, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"not_after": {
+							Description: "The end of the validity period, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_ca": {
+							Description: "Whether the certificate is a CA certificate.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"fingerprint": {
+							Description: "The SHA-256 fingerprint of the certificate.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSiteCertificateChainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID := strconv.Itoa(d.Get("site_id").(int))
+	chain, err := client.GetCertificateChain(siteID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	chainList := make([]interface{}, 0, len(chain))
+	for _, certInfo := range chain {
+		chainList = append(chainList, map[string]interface{}{
+			"subject":       certInfo.Subject,
+			"issuer":        certInfo.Issuer,
+			"serial_number": certInfo.SerialNumber,
+			"not_before":    certInfo.NotBefore.UTC().Format(time.RFC3339),
+			"not_after":     certInfo.NotAfter.UTC().Format(time.RFC3339),
+			"is_ca":         certInfo.IsCA,
+			"fingerprint":   certInfo.Fingerprint,
+		})
+	}
+
+	fingerprint := ""
+	if len(chain) > 0 {
+		fingerprint = chain[0].Fingerprint
+	}
+
+	d.SetId(siteID)
+	d.Set("fingerprint", fingerprint)
+	d.Set("chain", chainList)
+
+	return nil
+}
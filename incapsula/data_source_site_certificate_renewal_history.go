@@ -0,0 +1,86 @@
+package incapsula
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+	"time"
+)
+
+func dataSourceSiteCertificateRenewalHistory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteCertificateRenewalHistoryRead,
+
+		Description: "Provides the renewal history of the managed certificate of a site, most recent first.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Computed Attributes
+			"renewals": {
+				Description: "The issuances of the managed certificate, most recent first. Limited to the last 50.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issued_at": {
+							Description: "When the certificate was issued, in RFC 3339 format.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"expires_at": {
+							Description: "When the certificate expires, in RFC 3339 format. Empty when unknown.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"ca": {
+							Description: "The certificate authority that issued the certificate.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The status of the renewal.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSiteCertificateRenewalHistoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID := strconv.Itoa(d.Get("site_id").(int))
+	events, err := client.GetCertRenewalHistory(siteID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	renewals := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		expiresAt := ""
+		if !event.ExpiresAt.IsZero() {
+			expiresAt = event.ExpiresAt.Format(time.RFC3339)
+		}
+		renewals = append(renewals, map[string]interface{}{
+			"issued_at":  event.IssuedAt.Format(time.RFC3339),
+			"expires_at": expiresAt,
+			"ca":         event.CA,
+			"status":     event.Status,
+		})
+	}
+
+	d.SetId(siteID)
+	d.Set("renewals", renewals)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package incapsula
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+)
+
+func dataSourceSiteEffectiveCachingConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteEffectiveCachingConfigRead,
+
+		Description: "Provides the caching configuration of a site merged with the defaults of its account.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account whose defaults are merged. Defaults to the account of the site.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+			},
+
+			// Computed Attributes
+			"settings": {
+				Description: "The caching settings of the site, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Description: "Where the value comes from: site or account.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSiteEffectiveCachingConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID := d.Get("site_id").(int)
+	var accountID *int
+	if v, ok := d.GetOk("account_id"); ok {
+		id := v.(int)
+		accountID = &id
+	}
+
+	cachingConfig, err := client.GetEffectiveCachingConfig(siteID, accountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	settings := make([]map[string]interface{}, 0, len(cachingConfig.Settings))
+	for _, setting := range cachingConfig.Settings {
+		settings = append(settings, map[string]interface{}{
+			"name":   setting.Name,
+			"value":  setting.Value,
+			"source": setting.Source,
+		})
+	}
+
+	d.SetId(strconv.Itoa(siteID))
+	d.Set("account_id", cachingConfig.AccountID)
+	d.Set("settings", settings)
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package incapsula
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+)
+
+func dataSourceSiteEffectivePolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteEffectivePoliciesRead,
+
+		Description: "Provides the policies applied to a site, both directly associated and inherited from the account defaults.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account of the site. Taken from the site when not set.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"has_waf_policy": {
+				Description: "Whether a WAF policy applies to the site, either directly associated or inherited.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"policies": {
+				Description: "The policies applied to the site.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source": {
+							Description: "Where the policy comes from. Possible values: direct, inherited",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSiteEffectivePoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID := d.Get("site_id").(int)
+	var accountID *int
+	if v, ok := d.GetOk("account_id"); ok {
+		id := v.(int)
+		accountID = &id
+	}
+
+	effectivePolicies, err := client.GetEffectiveSitePolicies(siteID, accountID)
+	if err != nil {
+		return diag.Errorf("Error getting effective policies for site_id %d: %s", siteID, err)
+	}
+
+	hasWafPolicy := false
+	policies := make([]map[string]interface{}, len(effectivePolicies))
+	for i, policy := range effectivePolicies {
+		if policy.PolicyType == wafRulesPolicyType {
+			hasWafPolicy = true
+		}
+		policies[i] = map[string]interface{}{
+			"id":          policy.PolicyID,
+			"name":        policy.Name,
+			"policy_type": policy.PolicyType,
+			"source":      policy.Source,
+		}
+	}
+
+	d.SetId(strconv.Itoa(siteID))
+	d.Set("policies", policies)
+	d.Set("has_waf_policy", hasWafPolicy)
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package incapsula
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+)
+
+func dataSourceSiteGeoBlocks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteGeoBlocksRead,
+
+		Description: "Provides the countries and continents blocked by the ACL rules of a site.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Computed Attributes
+			"blocked_countries": {
+				Description: "The blocked countries, as ISO 3166-1 alpha-2 codes, sorted.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"blocked_continents": {
+				Description: "The blocked continents, as continent codes, sorted.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSiteGeoBlocksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID := d.Get("site_id").(int)
+	geoBlocks, err := client.GetSiteGeoBlocks(siteID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(siteID))
+	d.Set("blocked_countries", geoBlocks.Countries)
+	d.Set("blocked_continents", geoBlocks.Continents)
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"time"
+)
+
+func dataSourceSiteSecurityEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteSecurityEventsRead,
+
+		Description: "Provides the last blocked and challenged requests of a site.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"from": {
+				Description:  "Start of the time range, in RFC 3339 format, e.g. 2022-01-01T00:00:00Z.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"to": {
+				Description:  "End of the time range, in RFC 3339 format. The time range can't exceed 30 days.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			// Optional Arguments
+			"limit": {
+				Description:  "The number of events to return, between 1 and 1000.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      100,
+				ValidateFunc: validation.IntBetween(1, maxSecurityEvents),
+			},
+
+			// Computed Attributes
+			"events": {
+				Description: "The security events, newest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSiteSecurityEventsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID := d.Get("site_id").(int)
+	limit := d.Get("limit").(int)
+	from, _ := time.Parse(time.RFC3339, d.Get("from").(string))
+	to, _ := time.Parse(time.RFC3339, d.Get("to").(string))
+	securityEvents, err := client.GetSiteSecurityEvents(siteID, limit, from, to)
+	if err != nil {
+		return diag.Errorf("Error getting security events for site_id %d: %s", siteID, err)
+	}
+
+	events := make([]map[string]interface{}, len(securityEvents))
+	for i, event := range securityEvents {
+		events[i] = map[string]interface{}{
+			"timestamp": event.Time.Format(time.RFC3339),
+			"type":      event.Type,
+			"rule_id":   event.RuleID,
+			"rule":      event.Rule,
+			"action":    event.Action,
+			"client_ip": event.ClientIP,
+			"country":   event.Country,
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d/%d/%d/%d", siteID, from.Unix(), to.Unix(), limit))
+	d.Set("events", events)
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package incapsula
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+	"time"
+)
+
+func dataSourceSiteSSL() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteSSLRead,
+
+		Description: "Provides the SSL state of a site: origin detection, custom and generated certificates, TLS support and trust seal.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Computed Attributes
+			"origin_server_detected": {
+				Description: "Whether SSL support was detected on the origin server.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"origin_server_detection_status": {
+				Description: "Status of the origin server SSL detection.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"custom_certificate_active": {
+				Description: "Whether a custom certificate is active on the site.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"custom_certificate_expiration_date": {
+				Description: "Expiration date of the custom certificate, in RFC 3339 format. Empty when the site has no custom certificate.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"custom_certificate_issuer": {
+				Description: "Issuer of the custom certificate.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"generated_certificate_ca": {
+				Description: "Certificate authority of the generated certificate.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"generated_certificate_validation_method": {
+				Description: "Domain validation method of the generated certificate.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"generated_certificate_validation_status": {
+				Description: "Domain validation status of the generated certificate.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"generated_certificate_sans": {
+				Description: "SANs of the generated certificate.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"generated_certificate_covers_apex": {
+				Description: "Whether the generated certificate covers the apex (naked) domain of a www or apex site. Always false for other subdomain sites.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"generated_certificate_apex_reason": {
+				Description: "Why the generated certificate does or doesn't cover the apex domain.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"support_all_tls_versions": {
+				Description: "Whether all the TLS versions are supported.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"seal_location": {
+				Description: "Location of the trust seal.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSiteSSLRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID := d.Get("site_id").(int)
+	siteSSL, err := client.GetSiteSSL(siteID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	expirationDate := ""
+	if !siteSSL.CustomCertificateExpirationDate.IsZero() {
+		expirationDate = siteSSL.CustomCertificateExpirationDate.Format(time.RFC3339)
+	}
+
+	d.SetId(strconv.Itoa(siteID))
+	d.Set("origin_server_detected", siteSSL.OriginServerDetected)
+	d.Set("origin_server_detection_status", siteSSL.OriginServerDetectionStatus)
+	d.Set("custom_certificate_active", siteSSL.CustomCertificateActive)
+	d.Set("custom_certificate_expiration_date", expirationDate)
+	d.Set("custom_certificate_issuer", siteSSL.CustomCertificateIssuer)
+	d.Set("generated_certificate_ca", siteSSL.GeneratedCertificateCA)
+	d.Set("generated_certificate_validation_method", siteSSL.GeneratedCertificateMethod)
+	d.Set("generated_certificate_validation_status", siteSSL.GeneratedCertificateStatus)
+	d.Set("generated_certificate_sans", siteSSL.GeneratedCertificateSANs)
+	d.Set("generated_certificate_covers_apex", siteSSL.GeneratedCertificateCoversApex)
+	d.Set("generated_certificate_apex_reason", siteSSL.GeneratedCertificateApexReason)
+	d.Set("support_all_tls_versions", siteSSL.SupportAllTLSVersions)
+	d.Set("seal_location", siteSSL.SealLocation)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package incapsula
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+)
+
+func dataSourceSiteTLS() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSiteTLSRead,
+
+		Description: "Provides the TLS posture of a site between the clients and Imperva: supported TLS versions.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Computed Attributes
+			"support_all_tls_versions": {
+				Description: "Whether all the TLS versions are supported.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"tls_versions": {
+				Description: "The supported TLS versions, oldest first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"min_tls_version": {
+				Description: "The oldest supported TLS version.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"max_tls_version": {
+				Description: "The newest supported TLS version.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSiteTLSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID := d.Get("site_id").(int)
+	tlsConfig, err := client.GetTLSConfig(siteID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(siteID))
+	d.Set("support_all_tls_versions", tlsConfig.SupportAllTLSVersions)
+	d.Set("tls_versions", tlsConfig.Versions)
+	d.Set("min_tls_version", tlsConfig.MinVersion)
+	d.Set("max_tls_version", tlsConfig.MaxVersion)
+
+	return nil
+}
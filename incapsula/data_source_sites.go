@@ -0,0 +1,95 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"time"
+)
+
+func dataSourceSites() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSitesRead,
+
+		Description: "Provides the sites of an account, optionally only the recently created ones.",
+
+		Schema: map[string]*schema.Schema{
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to list. If not specified, the sites of the account identified by the authentication parameters are listed.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"created_after": {
+				Description:  "Only list the sites created after this time, in RFC 3339 format, e.g. 2022-01-01T00:00:00Z.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			// Computed Attributes
+			"sites": {
+				Description: "The sites of the account.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"site_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ref_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"site_creation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSitesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	accountID := d.Get("account_id").(int)
+	var createdAfter time.Time
+	if v, ok := d.GetOk("created_after"); ok {
+		createdAfter, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	siteList, err := client.ListSites(accountID, createdAfter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sites := make([]map[string]interface{}, len(siteList))
+	for i, site := range siteList {
+		sites[i] = map[string]interface{}{
+			"site_id":            site.SiteID,
+			"domain":             site.Domain,
+			"ref_id":             site.RefID,
+			"account_id":         site.AccountID,
+			"site_creation_date": siteCreationTime(&site).Format(time.RFC3339),
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", accountID, d.Get("created_after").(string)))
+	d.Set("sites", sites)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package incapsula
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+)
+
+func dataSourceWafPolicyConflicts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceWafPolicyConflictsRead,
+
+		Description: "Provides the sites of an account with more than one WAF policy associated. The conflicts are reported, not failed on.",
+
+		Schema: map[string]*schema.Schema{
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to scan. The account of the API credentials when not set.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"conflicts": {
+				Description: "The sites with more than one WAF policy, ordered by site_id.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"site_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_ids": {
+							Description: "The WAF policies associated with the site.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceWafPolicyConflictsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	accountID := d.Get("account_id").(int)
+	wafPolicyConflicts, err := client.ReportWafPolicyConflicts(accountID)
+	if err != nil {
+		return diag.Errorf("Error reporting WAF policy conflicts of account %d: %s", accountID, err)
+	}
+
+	conflicts := make([]map[string]interface{}, len(wafPolicyConflicts))
+	for i, conflict := range wafPolicyConflicts {
+		conflicts[i] = map[string]interface{}{
+			"site_id":    conflict.SiteID,
+			"domain":     conflict.Domain,
+			"policy_ids": conflict.PolicyIDs,
+		}
+	}
+
+	d.SetId(strconv.Itoa(accountID))
+	d.Set("conflicts", conflicts)
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -50,3 +51,18 @@ func suppressEquivalentJSONStringDiffs(k, old, new string, d *schema.ResourceDat
 
 	return reflect.DeepEqual(o1, o2)
 }
+
+// suppressMovedSiteAccountIDDiff suppresses the diff of a site account_id still set to the account the site was moved
+// from outside of Terraform, the site isn't replaced to move it back
+func suppressMovedSiteAccountIDDiff(k, old, new string, d *schema.ResourceData) bool {
+	movedFromAccountID := d.Get("moved_from_account_id").(int)
+	return d.Id() != "" && movedFromAccountID != 0 && new == strconv.Itoa(movedFromAccountID)
+}
+
+// suppressEquivalentAccelerationLevelDiff suppresses the diff between an acceleration_level and its raw level, e.g.
+// advanced and aggressive
+func suppressEquivalentAccelerationLevelDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldRaw, oldErr := normalizeAccelerationLevelRaw(old)
+	newRaw, newErr := normalizeAccelerationLevelRaw(new)
+	return oldErr == nil && newErr == nil && oldRaw == newRaw
+}
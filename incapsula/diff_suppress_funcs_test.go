@@ -30,3 +30,15 @@ func TestSuppressEquivalentStringDiffsSameStringsDifferent(t *testing.T) {
 		t.Errorf("Should not be equivalent")
 	}
 }
+
+func TestSuppressEquivalentAccelerationLevelDiffLevelAndRaw(t *testing.T) {
+	if !suppressEquivalentAccelerationLevelDiff("", "advanced", "aggressive", nil) {
+		t.Errorf("Should be equivalent")
+	}
+}
+
+func TestSuppressEquivalentAccelerationLevelDiffDifferentLevels(t *testing.T) {
+	if suppressEquivalentAccelerationLevelDiff("", "standard", "aggressive", nil) {
+		t.Errorf("Should not be equivalent")
+	}
+}
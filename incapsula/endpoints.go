@@ -0,0 +1,124 @@
+package incapsula
+
+import (
+	"log"
+	"strings"
+)
+
+// apiBase identifies which of the configured base URLs an endpoint is served from
+type apiBase int
+
+const (
+	apiBaseV1 apiBase = iota
+	apiBaseRev2
+	apiBaseRev3
+	apiBaseAPI
+)
+
+// endpointBases maps each named endpoint to the base URL it is served from.
+// When Incapsula migrates an endpoint between the legacy and the newer APIs, only this map needs to change.
+// Aliases sharing the same path (e.g. endpointSiteStatus and endpointCertificateList) resolve through a single entry.
+var endpointBases = map[string]apiBase{
+	// Sites
+	endpointSiteAdd:    apiBaseV1,
+	endpointSiteStatus: apiBaseV1,
+	endpointSiteUpdate: apiBaseV1,
+	endpointSiteDelete: apiBaseV1,
+	endpointSiteList:   apiBaseV1,
+	endpointSiteMove:   apiBaseV1,
+
+	endpointSiteLogLevel:            apiBaseV1,
+	endpointDataStorageRegionGet:    apiBaseV1,
+	endpointDataStorageRegionUpdate: apiBaseV1,
+	endpointWAFRuleConfigure:        apiBaseV1,
+	endpointExceptionConfigure:      apiBaseV1,
+	endpointACLRuleConfigure:        apiBaseV1,
+	endpointPerformanceAdvanced:     apiBaseV1,
+	endpointSiteDualFactorConfigure: apiBaseV1,
+	endpointSiteSettingsBase:        apiBaseRev2,
+	endpointSiteSecurityEventsBase:  apiBaseAPI,
+
+	// Certificates
+	endpointCertificateAdd:                  apiBaseV1,
+	endpointCertificateDelete:               apiBaseV1,
+	endpointCertificateSigningRequestCreate: apiBaseV1,
+	endpointMTLSCertificate:                 apiBaseAPI,
+	endpointSiteSANs:                        apiBaseAPI,
+	endpointAccountCertificates:             apiBaseAPI,
+
+	// Data centers
+	endpointDataCenterAdd:          apiBaseV1,
+	endpointDataCenterList:         apiBaseV1,
+	endpointDataCenterEdit:         apiBaseV1,
+	endpointDataCenterDelete:       apiBaseV1,
+	endpointDataCenterHealthCheck:  apiBaseV1,
+	endpointDataCenterServerAdd:    apiBaseV1,
+	endpointDataCenterServerEdit:   apiBaseV1,
+	endpointDataCenterServerDelete: apiBaseV1,
+
+	// Accounts
+	endpointAccountAdd:                     apiBaseV1,
+	endpointAccountStatus:                  apiBaseV1,
+	endpointAccountUpdate:                  apiBaseV1,
+	endpointAccountDelete:                  apiBaseV1,
+	endpointAccountDataStorageRegionGet:    apiBaseV1,
+	endpointAccountDataStorageRegionUpdate: apiBaseV1,
+	endpointAccountAllowedRegionsUpdate:    apiBaseV1,
+	endpointSubAccountAdd:                  apiBaseV1,
+	endpointSubAccountDelete:               apiBaseV1,
+	endpointAuditTrailEvents:               apiBaseAPI,
+
+	// WAF log setup
+	endpointCreateDefault:       apiBaseV1,
+	endpointCreateS3:            apiBaseV1,
+	endpointCreateSFTP:          apiBaseV1,
+	endpointTestCreateS3:        apiBaseV1,
+	endpointTestCreateSFTP:      apiBaseV1,
+	endpointWAFLogsActivate:     apiBaseV1,
+	endpointWAFLogsChangeStatus: apiBaseV1,
+	endpointLogDeliveryGet:      apiBaseV1,
+	endpointCreateSyslog:        apiBaseV1,
+
+	// Users and roles
+	endpointRole:             apiBaseAPI,
+	endpointAbilitiesGet:     apiBaseAPI,
+	endpointUserOperationNew: apiBaseAPI,
+
+	// Other APIs
+	endPointNotificationCenterPolicy: apiBaseAPI,
+	endpointSiemConnection:           apiBaseAPI,
+	endpointSiemLogConfiguration:     apiBaseAPI,
+	endpointDomainManagement:         apiBaseAPI,
+	endpointATOSiteBase:              apiBaseAPI,
+	endpointConfigUrl:                apiBaseAPI,
+	endpointPolicyAssetsBase:         apiBaseAPI,
+}
+
+func (c *Config) baseURLFor(base apiBase) string {
+	switch base {
+	case apiBaseRev2:
+		return c.BaseURLRev2
+	case apiBaseRev3:
+		return c.BaseURLRev3
+	case apiBaseAPI:
+		return c.BaseURLAPI
+	default:
+		return c.BaseURL
+	}
+}
+
+// endpointURL resolves a named endpoint to its full URL.
+// Config.EndpointBaseURLOverrides takes precedence over the base in endpointBases (e.g. to test a single endpoint against staging).
+// An endpoint missing from endpointBases falls back to the v1 base URL.
+func (c *Client) endpointURL(endpoint string) string {
+	baseURL, ok := c.config.EndpointBaseURLOverrides[endpoint]
+	if !ok {
+		base, mapped := endpointBases[endpoint]
+		if !mapped {
+			log.Printf("[WARN] Endpoint %s has no base URL in endpointBases, falling back to the v1 base URL\n", endpoint)
+		}
+		baseURL = c.config.baseURLFor(base)
+	}
+
+	return strings.TrimSuffix(baseURL, "/") + "/" + strings.TrimPrefix(endpoint, "/")
+}
@@ -0,0 +1,135 @@
+package incapsula
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestEndpointURLResolvesToExpectedBase(t *testing.T) {
+	config := &Config{
+		BaseURL:     "https://v1.example.com",
+		BaseURLRev2: "https://rev2.example.com",
+		BaseURLRev3: "https://rev3.example.com",
+		BaseURLAPI:  "https://api.example.com",
+	}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	expectedBases := map[apiBase]string{
+		apiBaseV1:   config.BaseURL,
+		apiBaseRev2: config.BaseURLRev2,
+		apiBaseRev3: config.BaseURLRev3,
+		apiBaseAPI:  config.BaseURLAPI,
+	}
+
+	for endpoint, base := range endpointBases {
+		expected := fmt.Sprintf("%s/%s", expectedBases[base], endpoint)
+		if endpoint[0] == '/' {
+			expected = fmt.Sprintf("%s%s", expectedBases[base], endpoint)
+		}
+		if reqURL := client.endpointURL(endpoint); reqURL != expected {
+			t.Errorf("Endpoint %s should have resolved to %s, got: %s", endpoint, expected, reqURL)
+		}
+	}
+
+	cases := map[string]string{
+		endpointSiteAdd:          "https://v1.example.com/sites/add",
+		endpointSiteStatus:       "https://v1.example.com/sites/status",
+		endpointAccountStatus:    "https://v1.example.com/account",
+		endpointRoleGet:          "https://api.example.com/user-management/v1/roles",
+		endpointSiemConnection:   "https://api.example.com/siem-config-service/v3/connections",
+		endpointMTLSCertificate:  "https://api.example.com/certificates-ui/v3/mtls/origin",
+		endpointDomainManagement: "https://api.example.com/site-domain-manager/v2/sites/",
+	}
+	for endpoint, expected := range cases {
+		if reqURL := client.endpointURL(endpoint); reqURL != expected {
+			t.Errorf("Endpoint %s should have resolved to %s, got: %s", endpoint, expected, reqURL)
+		}
+	}
+}
+
+func TestEndpointURLOverride(t *testing.T) {
+	config := &Config{
+		BaseURL:                  "https://v1.example.com",
+		BaseURLAPI:               "https://api.example.com",
+		EndpointBaseURLOverrides: map[string]string{endpointSiteStatus: "https://staging.example.com/api/prov/v1/"},
+	}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	if reqURL := client.endpointURL(endpointSiteStatus); reqURL != "https://staging.example.com/api/prov/v1/sites/status" {
+		t.Errorf("Overridden endpoint resolved to the wrong URL, got: %s", reqURL)
+	}
+	if reqURL := client.endpointURL(endpointSiteAdd); reqURL != "https://v1.example.com/sites/add" {
+		t.Errorf("Endpoint without an override should keep its default base, got: %s", reqURL)
+	}
+}
+
+func TestEndpointURLCallsAreMapped(t *testing.T) {
+	fileSet := token.NewFileSet()
+	packages, err := parser.ParseDir(fileSet, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatalf("Failed to parse the package: %s", err)
+	}
+
+	// Collect the string constants of the package, including aliases of other constants (e.g. endpointRoleGet), and
+	// the endpoints passed to endpointURL
+	constants := make(map[string]string)
+	aliases := make(map[string]string)
+	endpoints := make(map[string]token.Position)
+	for _, pkg := range packages {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(node ast.Node) bool {
+				switch node := node.(type) {
+				case *ast.ValueSpec:
+					for i, name := range node.Names {
+						if i < len(node.Values) {
+							if literal, ok := node.Values[i].(*ast.BasicLit); ok && literal.Kind == token.STRING {
+								constants[name.Name], _ = strconv.Unquote(literal.Value)
+							} else if ident, ok := node.Values[i].(*ast.Ident); ok {
+								aliases[name.Name] = ident.Name
+							}
+						}
+					}
+				case *ast.CallExpr:
+					if selector, ok := node.Fun.(*ast.SelectorExpr); ok && selector.Sel.Name == "endpointURL" && len(node.Args) == 1 {
+						if ident, ok := node.Args[0].(*ast.Ident); ok {
+							endpoints[ident.Name] = fileSet.Position(node.Pos())
+						} else {
+							t.Errorf("endpointURL should be called with a named endpoint at %s", fileSet.Position(node.Pos()))
+						}
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	for alias, name := range aliases {
+		if path, ok := constants[name]; ok {
+			constants[alias] = path
+		}
+	}
+
+	for name, position := range endpoints {
+		if name == "endpoint" {
+			// The parameter of endpointURL itself
+			continue
+		}
+		path, ok := constants[name]
+		if !ok {
+			t.Errorf("Endpoint %s used at %s isn't a string constant", name, position)
+			continue
+		}
+		if _, ok := endpointBases[path]; !ok {
+			t.Errorf("Endpoint %s (%s) used at %s isn't mapped in endpointBases", name, path, position)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package incapsula
+
+import "fmt"
+
+// DebugInfo is the debug block returned by the Incapsula v1 API.
+// IDInfo is the correlation id Imperva support asks for when investigating a failed call.
+type DebugInfo struct {
+	IDInfo string `json:"id-info"`
+}
+
+// IncapsulaError is returned when the Incapsula v1 API answers with a non-success res code
+type IncapsulaError struct {
+	Message string
+	Res     string
+	IDInfo  string
+}
+
+func (e *IncapsulaError) Error() string {
+	if e.IDInfo == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s (id-info: %s)", e.Message, e.IDInfo)
+}
+
+// newIncapsulaError builds an IncapsulaError carrying the id-info from the response debug block
+func newIncapsulaError(res string, debugInfo DebugInfo, format string, a ...interface{}) *IncapsulaError {
+	return &IncapsulaError{
+		Message: fmt.Sprintf(format, a...),
+		Res:     res,
+		IDInfo:  debugInfo.IDInfo,
+	}
+}
+
+// Incapsula v1 API res code returned when the account isn't entitled to the requested feature
+const resFeatureNotPermitted = "9414"
+
+// isFeatureNotPermitted returns true when err is an IncapsulaError for a feature the account isn't entitled to
+func isFeatureNotPermitted(err error) bool {
+	incapsulaError, ok := err.(*IncapsulaError)
+	return ok && incapsulaError.Res == resFeatureNotPermitted
+}
+
+// Incapsula v1 API res code returned by accounts/add when the parent account can't have more sub accounts
+const resOperationNotAllowed = "9415"
+
+// isOperationNotAllowed returns true when err is an IncapsulaError for an operation the account isn't allowed to
+// perform, e.g. adding an account beyond the quota of its parent
+func isOperationNotAllowed(err error) bool {
+	incapsulaError, ok := err.(*IncapsulaError)
+	return ok && incapsulaError.Res == resOperationNotAllowed
+}
@@ -5,7 +5,9 @@ const VerifyAccount = "verify_account"
 const CreateSite = "create_site"
 const ReadSite = "read_site"
 const UpdateSite = "update_site"
+const MoveSite = "move_site"
 const DeleteSite = "delete_site"
+const ReadSiteList = "read_site_list"
 
 const CreatePolicy = "create_policy"
 const ReadPolicy = "read_policy"
@@ -35,12 +37,17 @@ const DeleteWAFLogSetup = "delete_waf_log_setup"
 const ActivateWAFLogSetup = "activate_waf_log_setup"
 const UpdateStatusWAFLogSetup = "update_status_waf_log_setup"
 
+const ReadLogDelivery = "read_log_delivery"
+const UpdateLogDelivery = "update_log_delivery"
+
 const ReadAccountDataStorageRegion = "read_account_data_storage_region"
 const UpdateAccountDataStorageRegion = "update_account_data_storage_region"
 
 const ReadSiteMasking = "read_site_masking"
 const UpdateSiteMasking = "update_site_masking"
 
+const UpdateSiteDualFactor = "update_site_dual_factor"
+
 const UpdateLogLevel = "update_log_level"
 
 const ReadSitePerformance = "read_site_performance"
@@ -62,6 +69,18 @@ const ReadCacheRule = "read_cache_rule"
 const UpdateCacheRule = "update_cache_rule"
 const DeleteCacheRule = "delete_cache_rule"
 
+const CreatePathAccelerationRule = "create_path_acceleration_rule"
+const ReadPathAccelerationRule = "read_path_acceleration_rule"
+const DeletePathAccelerationRule = "delete_path_acceleration_rule"
+
+const CreateRateLimitRule = "create_rate_limit_rule"
+const ReadRateLimitRule = "read_rate_limit_rule"
+const DeleteRateLimitRule = "delete_rate_limit_rule"
+
+const CreateCacheVaryRule = "create_cache_vary_rule"
+const ReadCacheVaryRule = "read_cache_vary_rule"
+const DeleteCacheVaryRule = "delete_cache_vary_rule"
+
 const CreateIncapRule = "create_incap_rule"
 const ReadIncapRule = "read_incap_rule"
 const UpdateIncapRule = "update_incap_rule"
@@ -81,6 +100,11 @@ const ReadCustomCertificate = "read_custom_certificate"
 const UpdateCustomCertificate = "update_custom_certificate"
 const DeleteCustomCertificate = "delete_custom_certificate"
 
+const ReadAccountCertificates = "read_account_certificates"
+
+const ReadAccountAuditLog = "read_account_audit_log"
+const ReadSiteSecurityEvents = "read_site_security_events"
+
 const CreateHSMCustomCertificate = "create_hsm_custom_certificate"
 const ReadHSMCustomCertificate = "read_hsm_custom_certificate"
 const DeleteHsmCustomCertificate = "delete_hsm_custom_certificate"
@@ -1,6 +1,9 @@
 package incapsula
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -32,6 +35,24 @@ func init() {
 		"base_url_rev_3": "The base URL (revision 3) for API operations. Used for provider development.",
 
 		"base_url_api": "The base URL (same as v2 but with different subdomain) for API operations. Used for provider development.",
+
+		"endpoint_base_url_overrides": "A map of endpoint paths (e.g. sites/status) to the base URL they should be sent to, " +
+			"overriding the default base URL of the endpoint. Used for provider development.",
+
+		"proxy_url": "The URL of the forward proxy to send the API requests through, e.g. http://proxy.example.com:3128. " +
+			"Defaults to the HTTPS_PROXY and NO_PROXY environment variables.",
+
+		"ca_cert_pem": "PEM encoded CA certificates to trust in addition to the system roots, " +
+			"e.g. the CA of a TLS inspecting proxy.",
+
+		"cert_wait_timeout": "How long to wait for an uploaded custom certificate to become active, as a duration (e.g. 10m). " +
+			"Defaults to 10m.",
+
+		"delete_confirm_timeout": "How long to wait for the deletion of a site to be confirmed by the API, as a duration (e.g. 2m). " +
+			"Defaults to 2m.",
+
+		"status_stable_timeout": "How long to wait for the status of a new site to stop changing before it's read, as a duration (e.g. 5m). " +
+			"Defaults to 5m.",
 	}
 }
 
@@ -43,11 +64,42 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		BaseURLRev2: d.Get("base_url_rev_2").(string),
 		BaseURLRev3: d.Get("base_url_rev_3").(string),
 		BaseURLAPI:  d.Get("base_url_api").(string),
+		ProxyURL:    d.Get("proxy_url").(string),
+		CACertPEM:   d.Get("ca_cert_pem").(string),
+	}
+
+	if overrides, ok := d.GetOk("endpoint_base_url_overrides"); ok {
+		config.EndpointBaseURLOverrides = make(map[string]string)
+		for endpoint, baseURL := range overrides.(map[string]interface{}) {
+			config.EndpointBaseURLOverrides[endpoint] = baseURL.(string)
+		}
+	}
+
+	for attribute, timeout := range map[string]*time.Duration{
+		"cert_wait_timeout":      &config.CertWaitTimeout,
+		"delete_confirm_timeout": &config.DeleteConfirmTimeout,
+		"status_stable_timeout":  &config.StatusStableTimeout,
+	} {
+		if value, ok := d.GetOk(attribute); ok {
+			duration, err := time.ParseDuration(value.(string))
+			if err != nil {
+				return nil, fmt.Errorf("Error parsing %s: %s", attribute, err)
+			}
+			*timeout = duration
+		}
 	}
 
 	return config.Client()
 }
 
+// validateDuration checks that the attribute is a valid duration string (e.g. 90s, 5m)
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid duration (e.g. 90s, 5m): %s", k, err))
+	}
+	return
+}
+
 // Provider returns a *schema.Provider.
 func Provider() *schema.Provider {
 	provider := &schema.Provider{
@@ -88,15 +140,65 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("INCAPSULA_BASE_URL_API", baseURLAPI),
 				Description: descriptions["base_url_api"],
 			},
+			"endpoint_base_url_overrides": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["endpoint_base_url_overrides"],
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INCAPSULA_PROXY_URL", ""),
+				Description: descriptions["proxy_url"],
+			},
+			"ca_cert_pem": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("INCAPSULA_CA_CERT_PEM", ""),
+				Description: descriptions["ca_cert_pem"],
+			},
+			"cert_wait_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["cert_wait_timeout"],
+				ValidateFunc: validateDuration,
+			},
+			"delete_confirm_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["delete_confirm_timeout"],
+				ValidateFunc: validateDuration,
+			},
+			"status_stable_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  descriptions["status_stable_timeout"],
+				ValidateFunc: validateDuration,
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"incapsula_role_abilities":      dataSourceRoleAbilities(),
-			"incapsula_data_center":         dataSourceDataCenter(),
-			"incapsula_account_data":        dataSourceAccount(),
-			"incapsula_client_apps_data":    dataSourceClientApps(),
-			"incapsula_account_permissions": dataSourceAccountPermissions(),
-			"incapsula_account_roles":       dataSourceAccountRoles(),
+			"incapsula_role_abilities":                   dataSourceRoleAbilities(),
+			"incapsula_data_center":                      dataSourceDataCenter(),
+			"incapsula_account_data":                     dataSourceAccount(),
+			"incapsula_client_apps_data":                 dataSourceClientApps(),
+			"incapsula_account_permissions":              dataSourceAccountPermissions(),
+			"incapsula_account_roles":                    dataSourceAccountRoles(),
+			"incapsula_site_effective_policies":          dataSourceSiteEffectivePolicies(),
+			"incapsula_waf_policy_conflicts":             dataSourceWafPolicyConflicts(),
+			"incapsula_account_certificates":             dataSourceAccountCertificates(),
+			"incapsula_account_inventory":                dataSourceAccountInventory(),
+			"incapsula_account_audit_log":                dataSourceAccountAuditLog(),
+			"incapsula_site_ssl":                         dataSourceSiteSSL(),
+			"incapsula_site_by_ref_id":                   dataSourceSiteByRefID(),
+			"incapsula_site_security_events":             dataSourceSiteSecurityEvents(),
+			"incapsula_site_geo_blocks":                  dataSourceSiteGeoBlocks(),
+			"incapsula_site_effective_caching_config":    dataSourceSiteEffectiveCachingConfig(),
+			"incapsula_sites":                            dataSourceSites(),
+			"incapsula_site_tls":                         dataSourceSiteTLS(),
+			"incapsula_site_certificate_chain":           dataSourceSiteCertificateChain(),
+			"incapsula_site_certificate_renewal_history": dataSourceSiteCertificateRenewalHistory(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -131,6 +233,7 @@ func Provider() *schema.Provider {
 			"incapsula_application_delivery":                                   resourceApplicationDelivery(),
 			"incapsula_site_monitoring":                                        resourceSiteMonitoring(),
 			"incapsula_account_ssl_settings":                                   resourceAccountSSLSettings(),
+			"incapsula_account_geo_blocking":                                   resourceAccountGeoBlocking(),
 			"incapsula_mtls_imperva_to_origin_certificate":                     resourceMtlsImpervaToOriginCertificate(),
 			"incapsula_mtls_imperva_to_origin_certificate_site_association":    resourceMtlsImpervaToOriginCertificateSiteAssociation(),
 			"incapsula_mtls_client_to_imperva_ca_certificate":                  resourceMtlsClientToImpervaCertificate(),
@@ -147,6 +250,14 @@ func Provider() *schema.Provider {
 			"incapsula_abp_websites":                                           resourceAbpWebsites(),
 			"incapsula_delivery_rules_configuration":                           resourceDeliveryRulesConfiguration(),
 			"incapsula_simplified_redirect_rules_configuration":                resourceSimplifiedRedirectRulesConfiguration(),
+			"incapsula_path_acceleration_rules":                                resourcePathAccelerationRules(),
+			"incapsula_log_delivery":                                           resourceLogDelivery(),
+			"incapsula_site_scheduled_state":                                   resourceSiteScheduledState(),
+			"incapsula_site_dual_factor_settings":                              resourceSiteDualFactorSettings(),
+			"incapsula_rate_limit_rule":                                        resourceRateLimitRule(),
+			"incapsula_cache_vary_rule":                                        resourceCacheVaryRule(),
+			"incapsula_account_defaults":                                       resourceAccountDefaults(),
+			"incapsula_response_header":                                        resourceResponseHeader(),
 		},
 	}
 
@@ -1,6 +1,7 @@
 package incapsula
 
 import (
+	"fmt"
 	"log"
 	"strconv"
 	"time"
@@ -102,7 +103,31 @@ func resourceAccount() *schema.Resource {
 				Type:         schema.TypeString,
 				Default:      "US",
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice([]string{"APAC", "EU", "US", "AU"}, false),
+				ValidateFunc: validation.StringInSlice(dataStorageRegions, false),
+			},
+			"allowed_data_storage_regions": {
+				Description: "Data regions allowed for the sites of an account on a multi-region plan. Options are `APAC`, `EU`, `US` and `AU`. Must include `data_storage_region`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(dataStorageRegions, false),
+				},
+			},
+			"api_key_ip_allowlist": {
+				Description: "Source IPs and CIDR ranges allowed to use the API keys of the account, e.g. 192.0.2.0/24. The API keys can be used from any IP when empty.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+						if _, err := normalizeIPOrCIDR(val.(string)); err != nil {
+							errs = append(errs, err)
+						}
+						return
+					},
+				},
 			},
 			"consent_required": {
 				Description: "Blocks Imperva from performing sensitive operations on your behalf. Options are `true`, `false`.",
@@ -148,16 +173,15 @@ func resourceAccountCreate(d *schema.ResourceData, m interface{}) error {
 
 	log.Printf("[INFO] Creating Incapsula account for email: %s\n", email)
 
-	accountAddResponse, err := client.AddAccount(
-		email,
-		d.Get("ref_id").(string),
-		d.Get("user_name").(string),
-		d.Get("plan_id").(string),
-		d.Get("account_name").(string),
-		d.Get("log_level").(string),
-		d.Get("logs_account_id").(int),
-		d.Get("parent_id").(int),
-	)
+	account, err := client.CreateAccount(d.Get("parent_id").(int), AccountInput{
+		Email:         email,
+		AccountName:   d.Get("account_name").(string),
+		UserName:      d.Get("user_name").(string),
+		PlanID:        d.Get("plan_id").(string),
+		RefID:         d.Get("ref_id").(string),
+		LogLevel:      d.Get("log_level").(string),
+		LogsAccountID: d.Get("logs_account_id").(int),
+	})
 
 	if err != nil {
 		log.Printf("[ERROR] Could not create Incapsula account for email: %s, %s\n", email, err)
@@ -165,7 +189,7 @@ func resourceAccountCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	// Set the Account ID
-	d.SetId(strconv.Itoa(accountAddResponse.Account.AccountID))
+	d.SetId(strconv.Itoa(account.AccountID))
 	log.Printf("[INFO] Created Incapsula account for email: %s\n", email)
 
 	// There may be a timing/race condition here
@@ -177,11 +201,21 @@ func resourceAccountCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = updateAllowedDataStorageRegions(client, d)
+	if err != nil {
+		return err
+	}
+
 	err = updateDefaultDataStorageRegion(client, d)
 	if err != nil {
 		return err
 	}
 
+	err = updateAPIKeyIPAllowlist(client, d)
+	if err != nil {
+		return err
+	}
+
 	// Set the rest of the state from the resource read
 	return resourceAccountRead(d, m)
 }
@@ -230,6 +264,8 @@ func resourceAccountRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 	d.Set("data_storage_region", defaultAccountDataStorageRegion.Region)
+	d.Set("allowed_data_storage_regions", accountDataRegions(defaultAccountDataStorageRegion))
+	d.Set("api_key_ip_allowlist", accountStatusResponse.Account.APIKeyAllowedIPs)
 
 	log.Printf("[INFO] Finished reading Incapsula account for account ud: %d\n", accountID)
 
@@ -262,11 +298,21 @@ func resourceAccountUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = updateAllowedDataStorageRegions(client, d)
+	if err != nil {
+		return err
+	}
+
 	err = updateDefaultDataStorageRegion(client, d)
 	if err != nil {
 		return err
 	}
 
+	err = updateAPIKeyIPAllowlist(client, d)
+	if err != nil {
+		return err
+	}
+
 	// Set the rest of the state from the resource read
 	return resourceAccountRead(d, m)
 }
@@ -343,6 +389,29 @@ func updateAccountLogLevel(client *Client, d *schema.ResourceData) error {
 	return nil
 }
 
+// updateAllowedDataStorageRegions is applied before the default region, which must be one of the allowed regions
+func updateAllowedDataStorageRegions(client *Client, d *schema.ResourceData) error {
+	if !d.HasChange("allowed_data_storage_regions") {
+		return nil
+	}
+
+	regions := toStringSlice(d.Get("allowed_data_storage_regions").(*schema.Set).List())
+	if len(regions) == 0 {
+		return nil
+	}
+	defaultRegion := d.Get("data_storage_region").(string)
+	if !contains(regions, defaultRegion) {
+		return fmt.Errorf("allowed_data_storage_regions %v must include the data_storage_region %s", regions, defaultRegion)
+	}
+	accountID, _ := strconv.Atoi(d.Id())
+	err := client.SetAccountAllowedRegions(accountID, regions)
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula allowed data storage regions: %v for account_id: %s %s\n", regions, d.Id(), err)
+		return err
+	}
+	return nil
+}
+
 func updateDefaultDataStorageRegion(client *Client, d *schema.ResourceData) error {
 	if d.HasChange("data_storage_region") {
 		region := d.Get("data_storage_region").(string)
@@ -354,3 +423,18 @@ func updateDefaultDataStorageRegion(client *Client, d *schema.ResourceData) erro
 	}
 	return nil
 }
+
+func updateAPIKeyIPAllowlist(client *Client, d *schema.ResourceData) error {
+	if !d.HasChange("api_key_ip_allowlist") {
+		return nil
+	}
+
+	ips := toStringSlice(d.Get("api_key_ip_allowlist").(*schema.Set).List())
+	accountID, _ := strconv.Atoi(d.Id())
+	err := client.SetAPIKeyIPAllowlist(accountID, ips)
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula API key IP allowlist: %v for account_id: %s %s\n", ips, d.Id(), err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package incapsula
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAccountDefaults() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAccountDefaultsCreate,
+		ReadContext:   resourceAccountDefaultsRead,
+		UpdateContext: resourceAccountDefaultsUpdate,
+		DeleteContext: resourceAccountDefaultsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				d.Set("account_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on. If not specified, the account identified by the authentication parameters is used.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"acceleration_level": {
+				Description:  "Acceleration level of new sites: none, standard or aggressive.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(accountDefaultAccelerationLevels, false),
+			},
+			"log_level": {
+				Description:  "Log level of new sites: full, security or none.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(accountDefaultLogLevels, false),
+			},
+			"naked_domain_san_for_new_www_sites": {
+				Description: "Add the naked domain SAN to the generated certificate of new www sites.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"wildcard_san_for_new_sites": {
+				Description:  "Add the wildcard SAN to the generated certificate of new sites: True, False or Default.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(accountWildcardSANModes, false),
+			},
+			"restricted_cname_reuse": {
+				Description: "Restrict the CNAME reuse of new sites. Sites can override it with their own restricted_cname_reuse.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"block_non_essential_bots": {
+				Description: "Block non-essential bots in the bot access control rule of new sites.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceAccountDefaultsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		credentialInfo, err := client.WhoAmI()
+		if err != nil {
+			return diag.Errorf("Error resolving the account of the authentication parameters: %s", err)
+		}
+		accountID = strconv.Itoa(credentialInfo.AccountID)
+	}
+	d.SetId(accountID)
+
+	return resourceAccountDefaultsUpdate(ctx, d, m)
+}
+
+func resourceAccountDefaultsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	accountID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountDefaults, err := client.GetAccountDefaults(accountID)
+	if err != nil {
+		return diag.Errorf("Error reading defaults of account %d: %s", accountID, err)
+	}
+
+	d.Set("account_id", d.Id())
+	d.Set("acceleration_level", accountDefaults.AccelerationLevel)
+	d.Set("log_level", accountDefaults.LogLevel)
+	d.Set("naked_domain_san_for_new_www_sites", accountDefaults.NakedDomainSANForNewWWWSites)
+	d.Set("wildcard_san_for_new_sites", accountDefaults.WildcardSANForNewSites)
+	d.Set("restricted_cname_reuse", accountDefaults.RestrictedCnameReuse)
+	d.Set("block_non_essential_bots", accountDefaults.BlockNonEssentialBots)
+
+	return nil
+}
+
+func resourceAccountDefaultsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	accountID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if level, ok := d.GetOk("acceleration_level"); ok && d.HasChange("acceleration_level") {
+		err = client.SetAccountDefaultAcceleration(accountID, level.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if logLevel, ok := d.GetOk("log_level"); ok && d.HasChange("log_level") {
+		err = client.SetAccountDefaultLogLevel(accountID, logLevel.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("naked_domain_san_for_new_www_sites", "wildcard_san_for_new_sites") {
+		wildcardSAN := d.Get("wildcard_san_for_new_sites").(string)
+		if wildcardSAN == "" {
+			wildcardSAN = "Default"
+		}
+		err = client.SetAccountDefaultSANs(accountID, d.Get("naked_domain_san_for_new_www_sites").(bool), wildcardSAN)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("restricted_cname_reuse") {
+		err = client.SetAccountCnameReuseDefault(accountID, d.Get("restricted_cname_reuse").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("block_non_essential_bots") {
+		err = client.SetAccountBlockNonEssentialBotsDefault(accountID, d.Get("block_non_essential_bots").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceAccountDefaultsRead(ctx, d, m)
+}
+
+func resourceAccountDefaultsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The account keeps its defaults, they're only no longer managed
+	log.Printf("[INFO] Removing Incapsula defaults of account %s from the state, the account keeps its current defaults\n", d.Id())
+	d.SetId("")
+	return nil
+}
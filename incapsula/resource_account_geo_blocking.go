@@ -0,0 +1,108 @@
+package incapsula
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAccountGeoBlocking() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountGeoBlockingUpdate,
+		Read:   resourceAccountGeoBlockingRead,
+		Update: resourceAccountGeoBlockingUpdate,
+		Delete: resourceAccountGeoBlockingDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				accountID, err := strconv.Atoi(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("account_id", accountID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"countries": {
+				Description: "The countries (ISO 3166-1 alpha-2 codes) blocked by default on new sites of the account, e.g. US.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(geoCountryCodeRegex, "must be an uppercase ISO 3166-1 alpha-2 code, e.g. US"),
+				},
+			},
+			"continents": {
+				Description: "The continents blocked by default on new sites of the account. Options are AF, AN, AS, EU, NA, OC and SA.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(geoContinents, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceAccountGeoBlockingUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+
+	err := client.SetAccountGeoBlocking(
+		accountID,
+		toStringSlice(d.Get("countries").(*schema.Set).List()),
+		toStringSlice(d.Get("continents").(*schema.Set).List()),
+	)
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula geo blocking for account id: %d, %s\n", accountID, err)
+		return err
+	}
+
+	d.SetId(strconv.Itoa(accountID))
+
+	return resourceAccountGeoBlockingRead(d, m)
+}
+
+func resourceAccountGeoBlockingRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID, _ := strconv.Atoi(d.Id())
+
+	geoBlocking, err := client.GetAccountGeoBlocking(accountID)
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula geo blocking for account id: %d, %s\n", accountID, err)
+		return err
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("countries", geoBlocking.Countries)
+	d.Set("continents", geoBlocking.Continents)
+
+	return nil
+}
+
+func resourceAccountGeoBlockingDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID, _ := strconv.Atoi(d.Id())
+
+	err := client.SetAccountGeoBlocking(accountID, []string{}, []string{})
+	if err != nil {
+		log.Printf("[ERROR] Could not remove Incapsula geo blocking for account id: %d, %s\n", accountID, err)
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -3,6 +3,7 @@ package incapsula
 import (
 	"fmt"
 	"math/rand"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -208,3 +210,21 @@ func testACCStateAccountID(s *terraform.State) (string, error) {
 	}
 	return "", fmt.Errorf("Error finding an Account\"")
 }
+
+func TestAllowedDataStorageRegionsMustIncludeDefaultRegion(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	d := schema.TestResourceDataRaw(t, resourceAccount().Schema, map[string]interface{}{
+		"email":                        "example@example.com",
+		"data_storage_region":          "EU",
+		"allowed_data_storage_regions": []interface{}{"US", "APAC"},
+	})
+	d.SetId("123")
+	err := updateAllowedDataStorageRegions(client, d)
+	if err == nil {
+		t.Fatalf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "allowed_data_storage_regions") {
+		t.Errorf("Should have received a data storage region error, got: %s", err)
+	}
+}
@@ -77,8 +77,22 @@ func resourceAccountUser() *schema.Resource {
 				},
 				Optional: true,
 			},
+			"site_ids": {
+				Description: "List of site ids the user is restricted to. By default, the user has access to all the sites of the account.",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+				Optional: true,
+			},
 
 			// Computed Arguments
+			"temporary_password": {
+				Description: "The temporary password of the user, if one was returned when the user was created.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
 			"role_names": {
 				Description: "List of role names.",
 				Type:        schema.TypeSet,
@@ -129,14 +143,29 @@ func resourceUserCreate(d *schema.ResourceData, m interface{}) error {
 		roleIds.List(),
 	)
 
-	if err != nil {
+	if _, exists := err.(*AccountUserExistsError); exists {
+		// Adopt the existing user, assigning it the configured roles
+		log.Printf("[WARN] Incapsula user for email: %s already exists, updating its roles\n", email)
+		_, err = client.UpdateAccountUser(accountId, email, roleIds.List())
+		if err != nil {
+			log.Printf("[ERROR] Could not update existing user for email: %s, %s\n", email, err)
+			return err
+		}
+	} else if err != nil {
 		log.Printf("[ERROR] Could not create user for email: %s, %s\n", email, err)
 		return err
+	} else {
+		log.Printf("[INFO] Created Incapsula user for email: %s userid: %s\n", email, UserAddResponse.Data[0].UserID)
+		d.Set("temporary_password", UserAddResponse.Data[0].TemporaryPassword)
 	}
 
 	// Set the User ID
 	d.SetId(fmt.Sprintf("%s/%s", strconv.Itoa(accountId), email))
-	log.Printf("[INFO] Created Incapsula user for email: %s userid: %s\n", email, UserAddResponse.Data[0].UserID)
+
+	err = updateAccountUserSites(client, d)
+	if err != nil {
+		return err
+	}
 
 	// There may be a timing/race condition here
 	// Set an arbitrary period to sleep
@@ -188,6 +217,7 @@ func resourceUserRead(d *schema.ResourceData, m interface{}) error {
 	}
 	d.Set("role_ids", listRolesIds)
 	d.Set("role_names", listRolesNames)
+	d.Set("site_ids", userStatusResponse.Data[0].SiteIds)
 
 	log.Printf("[INFO] Finished reading Incapsula user: %s\n", email)
 
@@ -214,6 +244,11 @@ func resourceUserUpdate(d *schema.ResourceData, m interface{}) error {
 
 	log.Printf("[Info] New Roles for user %s : %+v\n", email, userUpdateResponse.Data[0].Roles)
 
+	err = updateAccountUserSites(client, d)
+	if err != nil {
+		return err
+	}
+
 	// There may be a timing/race condition here
 	// Set an arbitrary period to sleep
 	log.Printf("[DEBUG] Avoid timing/race condition, sleeping %d seconds\n", sleepTimeSeconds)
@@ -250,3 +285,18 @@ func resourceUserDelete(d *schema.ResourceData, m interface{}) error {
 
 	return nil
 }
+
+func updateAccountUserSites(client *Client, d *schema.ResourceData) error {
+	if !d.HasChange("site_ids") {
+		return nil
+	}
+
+	accountId := d.Get("account_id").(int)
+	email := d.Get("email").(string)
+	_, err := client.SetAccountUserSites(accountId, email, d.Get("site_ids").(*schema.Set).List())
+	if err != nil {
+		log.Printf("[ERROR] Could not set sites of user for email: %s, %s\n", email, err)
+		return err
+	}
+	return nil
+}
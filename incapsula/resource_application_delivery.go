@@ -82,6 +82,13 @@ func resourceApplicationDelivery() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
+			"jpeg_quality": {
+				Type:         schema.TypeInt,
+				Description:  "The JPEG compression quality level, between 1 and 100. Requires compress_jpeg to be enabled.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(minJpegQuality, maxJpegQuality),
+			},
 			"progressive_image_rendering": {
 				Type:        schema.TypeBool,
 				Description: "The image is rendered with progressively finer resolution, potentially causing a pixelated effect until the final image is rendered with no loss of quality. This option reduces page load times and allows images to gradually load after the page is rendered.",
@@ -99,7 +106,7 @@ func resourceApplicationDelivery() *schema.Resource {
 			},
 			"compress_png": {
 				Type:        schema.TypeBool,
-				Description: "Compress PNG images. Compression reduces download time by reducing the file size. PNG compression removes only image meta-data with no impact on quality.",
+				Description: "Compress PNG images. Compression reduces download time by reducing the file size. PNG compression removes only image meta-data with no impact on quality. There is no lossy PNG mode, aggressive_compression applies to JPEG images only.",
 				Optional:    true,
 				Default:     true,
 			},
@@ -333,6 +340,9 @@ func resourceApplicationDeliveryRead(ctx context.Context, d *schema.ResourceData
 	d.Set("minify_static_html", applicationDelivery.Compression.MinifyStaticHtml)
 
 	d.Set("compress_jpeg", applicationDelivery.ImageCompression.CompressJpeg)
+	if applicationDelivery.ImageCompression.JpegQuality != 0 {
+		d.Set("jpeg_quality", applicationDelivery.ImageCompression.JpegQuality)
+	}
 	d.Set("progressive_image_rendering", applicationDelivery.ImageCompression.ProgressiveImageRendering)
 	d.Set("aggressive_compression", applicationDelivery.ImageCompression.AggressiveCompression)
 	d.Set("compress_png", applicationDelivery.ImageCompression.CompressPng)
@@ -395,6 +405,15 @@ func resourceApplicationDeliveryUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	if !d.GetRawConfig().GetAttr("jpeg_quality").IsNull() && !d.Get("compress_jpeg").(bool) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "[ERROR] error in Application Delivery resource",
+			Detail:   "JPEG quality requires that compress_jpeg will be enabled for your website",
+		})
+		return diags
+	}
+
 	compression := Compression{
 		FileCompression:  d.Get("file_compression").(bool),
 		CompressionType:  d.Get("compression_type").(string),
@@ -409,6 +428,9 @@ func resourceApplicationDeliveryUpdate(ctx context.Context, d *schema.ResourceDa
 		AggressiveCompression:     d.Get("aggressive_compression").(bool),
 		CompressPng:               d.Get("compress_png").(bool),
 	}
+	if imageCompression.CompressJpeg {
+		imageCompression.JpegQuality = d.Get("jpeg_quality").(int)
+	}
 
 	network := Network{
 		TcpPrePooling:         d.Get("tcp_pre_pooling").(bool),
@@ -0,0 +1,152 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCacheVaryRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCacheVaryRuleCreate,
+		Read:   resourceCacheVaryRuleRead,
+		Delete: resourceCacheVaryRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idSlice := strings.Split(d.Id(), "/")
+				if len(idSlice) != 2 || idSlice[0] == "" || idSlice[1] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected site_id/rule_id", d.Id())
+				}
+
+				d.Set("site_id", idSlice[0])
+				d.SetId(idSlice[1])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		// Cache vary rules can't be updated, any change replaces the rule
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Description:  "What the cache varies by: cookie or header.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(cacheVaryRuleTypes, false),
+			},
+			"name": {
+				Description:  "The cookie or header name, e.g. X-Device-Type.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(cacheVaryNameRegex, "must be a valid cookie or header name"),
+			},
+
+			// Optional Arguments
+			"values": {
+				Description: "The values that get their own cached copy. All the other values share one. When empty, every value gets its own copy.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCacheVaryRuleCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID, err := strconv.Atoi(d.Get("site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	rule := CacheVaryRule{
+		Type:   d.Get("type").(string),
+		Name:   d.Get("name").(string),
+		Values: toStringSlice(d.Get("values").([]interface{})),
+	}
+
+	ruleWithID, err := client.AddCacheVaryRule(siteID, rule)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(ruleWithID.RuleID))
+
+	return resourceCacheVaryRuleRead(d, m)
+}
+
+func resourceCacheVaryRuleRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID, err := strconv.Atoi(d.Get("site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	ruleID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rules, statusCode, err := client.ListCacheVaryRules(siteID)
+
+	// The site may have been deleted
+	if statusCode == 404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		if rule.RuleID == ruleID {
+			d.Set("type", rule.Type)
+			d.Set("name", rule.Name)
+			d.Set("values", rule.Values)
+			return nil
+		}
+	}
+
+	// If the rule is deleted on the server, blow it out locally and run through the normal TF cycle
+	log.Printf("[INFO] Incapsula Cache Vary Rule %d for Site ID %d has already been deleted\n", ruleID, siteID)
+	d.SetId("")
+	return nil
+}
+
+func resourceCacheVaryRuleDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID, err := strconv.Atoi(d.Get("site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	ruleID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = client.DeleteCacheVaryRule(siteID, ruleID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
+	"strconv"
 )
 
 func resourceCertificate() *schema.Resource {
@@ -65,6 +66,22 @@ func resourceCertificate() *schema.Resource {
 					return false
 				},
 			},
+			// Computed Attributes
+			"active": {
+				Description: "Whether the custom certificate is active on the site.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"expiration_date": {
+				Description: "Numeric representation of the custom certificate expiration date.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"issuer": {
+				Description: "The issuer of the custom certificate.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -88,6 +105,12 @@ func resourceCertificateCreate(d *schema.ResourceData, m interface{}) error {
 	// TODO: Setting this to arbitrary value as there is only one cert for each site.
 	d.SetId("12345")
 
+	siteID, _ := strconv.Atoi(d.Get("site_id").(string))
+	_, err = client.WaitForCustomCertificate(siteID, 0)
+	if err != nil {
+		return err
+	}
+
 	return resourceCertificateRead(d, m)
 }
 
@@ -112,6 +135,9 @@ func resourceCertificateRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.Set("input_hash", listCertificatesResponse.SSL.CustomCertificate.InputHash)
+	d.Set("active", listCertificatesResponse.SSL.CustomCertificate.Active)
+	d.Set("expiration_date", listCertificatesResponse.SSL.CustomCertificate.ExpirationDate)
+	d.Set("issuer", listCertificatesResponse.SSL.CustomCertificate.Issuer)
 	d.SetId("12345")
 
 	return nil
@@ -3,11 +3,13 @@ package incapsula
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceDataCenter() *schema.Resource {
@@ -66,6 +68,50 @@ func resourceDataCenter() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"health_check": {
+				Description: "The health check of the origin servers of the data center. Removing it keeps the current health check.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Description: "The path requested on the origin servers, e.g. /health.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"interval": {
+							Description:  "Seconds between two checks.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntBetween(healthCheckMinInterval, healthCheckMaxInterval),
+						},
+						"healthy_threshold": {
+							Description:  "Consecutive successful checks after which a server is up again.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntBetween(1, healthCheckMaxThreshold),
+						},
+						"unhealthy_threshold": {
+							Description:  "Consecutive failed checks after which a server is down.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							ValidateFunc: validation.IntBetween(1, healthCheckMaxThreshold),
+						},
+						"expected_status": {
+							Description:  "The status code of a successful check.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      200,
+							ValidateFunc: validation.IntBetween(100, 599),
+						},
+					},
+				},
+			},
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -105,6 +151,11 @@ func resourceDataCenterCreate(d *schema.ResourceData, m interface{}) error {
 	// Set the dc ID
 	d.SetId(dataCenterAddResponse.DataCenterID)
 
+	err = updateOriginHealthCheck(client, d)
+	if err != nil {
+		return err
+	}
+
 	return resourceDataCenterRead(d, m)
 }
 
@@ -145,6 +196,19 @@ func resourceDataCenterRead(d *schema.ResourceData, m interface{}) error {
 			d.Set("is_content", dataCenter.ContentOnly)
 			// Server address is the first value in the nested servers object
 			d.Set("server_address", dataCenter.Servers[0].Address)
+			if dataCenter.HealthCheck != nil {
+				d.Set("health_check", []interface{}{
+					map[string]interface{}{
+						"path":                dataCenter.HealthCheck.Path,
+						"interval":            dataCenter.HealthCheck.Interval,
+						"healthy_threshold":   dataCenter.HealthCheck.HealthyThreshold,
+						"unhealthy_threshold": dataCenter.HealthCheck.UnhealthyThreshold,
+						"expected_status":     dataCenter.HealthCheck.ExpectedStatus,
+					},
+				})
+			} else {
+				d.Set("health_check", []interface{}{})
+			}
 			found = true
 		}
 	}
@@ -161,6 +225,11 @@ func resourceDataCenterRead(d *schema.ResourceData, m interface{}) error {
 func resourceDataCenterUpdate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 
+	err := updateOriginHealthCheck(client, d)
+	if err != nil {
+		return err
+	}
+
 	return resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		_, err := client.EditDataCenter(
 			d.Id(),
@@ -177,6 +246,32 @@ func resourceDataCenterUpdate(d *schema.ResourceData, m interface{}) error {
 	})
 }
 
+func updateOriginHealthCheck(client *Client, d *schema.ResourceData) error {
+	if !d.HasChange("health_check") {
+		return nil
+	}
+
+	healthCheckList := d.Get("health_check").([]interface{})
+	if len(healthCheckList) == 0 || healthCheckList[0] == nil {
+		return nil
+	}
+
+	healthCheckMap := healthCheckList[0].(map[string]interface{})
+	siteID, _ := strconv.Atoi(d.Get("site_id").(string))
+	err := client.SetOriginHealthCheck(siteID, d.Id(), HealthCheckConfig{
+		Path:               healthCheckMap["path"].(string),
+		Interval:           healthCheckMap["interval"].(int),
+		HealthyThreshold:   healthCheckMap["healthy_threshold"].(int),
+		UnhealthyThreshold: healthCheckMap["unhealthy_threshold"].(int),
+		ExpectedStatus:     healthCheckMap["expected_status"].(int),
+	})
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula origin health check for data center %s of site_id: %s %s\n", d.Id(), d.Get("site_id"), err)
+		return err
+	}
+	return nil
+}
+
 func resourceDataCenterDelete(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 
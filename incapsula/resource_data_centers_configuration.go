@@ -37,7 +37,7 @@ func resourceDataCentersConfiguration() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      "BEST_CONNECTION_TIME",
-				ValidateFunc: validation.StringInSlice([]string{"BEST_CONNECTION_TIME", "GEO_PREFERRED", "GEO_REQUIRED", "WEIGHTED_LB"}, false),
+				ValidateFunc: validation.StringInSlice(siteLbAlgorithms, false),
 			},
 			"fail_over_required_monitors": {
 				Description:  "How many Imperva PoPs should assess Data Center as down before failover is performed.",
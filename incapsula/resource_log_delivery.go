@@ -0,0 +1,187 @@
+package incapsula
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceLogDelivery() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLogDeliveryUpdate,
+		ReadContext:   resourceLogDeliveryRead,
+		UpdateContext: resourceLogDeliveryUpdate,
+		DeleteContext: resourceLogDeliveryDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				accountID, err := strconv.Atoi(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("account_id", accountID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"destination_type": {
+				Description:  "Where the logs are shipped to: DEFAULT (kept in Incapsula), S3, SFTP or SYSLOG.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(logDeliveryDestinations, false),
+			},
+
+			// Optional Arguments
+			"format": {
+				Description:  "Log format: CEF, W3C or LEEF. Not used with the DEFAULT destination.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "CEF",
+				ValidateFunc: validation.StringInSlice(logDeliveryFormats, false),
+			},
+			"s3_bucket_name": {
+				Description: "S3 bucket name.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"s3_access_key": {
+				Description: "S3 access key.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"s3_secret_key": {
+				Description: "S3 secret key.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"sftp_host": {
+				Description: "Host name or IP address of the SFTP server.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"sftp_user_name": {
+				Description: "User name used to log in to the SFTP server.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"sftp_password": {
+				Description: "Password used to log in to the SFTP server.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"sftp_destination_folder": {
+				Description: "Path of the directory on the SFTP server.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"syslog_host": {
+				Description: "Host name or IP address of the syslog server.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"syslog_port": {
+				Description:  "Port of the syslog server.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"syslog_protocol": {
+				Description:  "Protocol used to ship the logs to the syslog server: TCP, UDP or TLS.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "TCP",
+				ValidateFunc: validation.StringInSlice(logDeliverySyslogProtocols, false),
+			},
+		},
+	}
+}
+
+func resourceLogDeliveryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+
+	cfg := LogDeliveryConfig{
+		DestinationType:   d.Get("destination_type").(string),
+		Format:            d.Get("format").(string),
+		BucketName:        d.Get("s3_bucket_name").(string),
+		AccessKey:         d.Get("s3_access_key").(string),
+		SecretKey:         d.Get("s3_secret_key").(string),
+		UserName:          d.Get("sftp_user_name").(string),
+		Password:          d.Get("sftp_password").(string),
+		DestinationFolder: d.Get("sftp_destination_folder").(string),
+		Port:              d.Get("syslog_port").(int),
+		Protocol:          d.Get("syslog_protocol").(string),
+	}
+	if cfg.DestinationType == LogDeliveryDestinationSyslog {
+		cfg.Host = d.Get("syslog_host").(string)
+	} else {
+		cfg.Host = d.Get("sftp_host").(string)
+	}
+
+	err := client.SetLogDelivery(accountID, cfg)
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula log delivery for account %d: %s\n", accountID, err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(accountID))
+
+	return resourceLogDeliveryRead(ctx, d, m)
+}
+
+func resourceLogDeliveryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+
+	cfg, err := client.GetLogDelivery(accountID)
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula log delivery for account %d: %s\n", accountID, err)
+		return diag.FromErr(err)
+	}
+
+	// The credentials aren't returned, so they are kept from the configuration
+	d.Set("destination_type", cfg.DestinationType)
+	if cfg.DestinationType != LogDeliveryDestinationDefault {
+		d.Set("format", cfg.Format)
+	}
+	d.Set("s3_bucket_name", cfg.BucketName)
+	if cfg.DestinationType == LogDeliveryDestinationSyslog {
+		d.Set("syslog_host", cfg.Host)
+		d.Set("syslog_port", cfg.Port)
+		d.Set("syslog_protocol", cfg.Protocol)
+	} else {
+		d.Set("sftp_host", cfg.Host)
+	}
+	d.Set("sftp_user_name", cfg.UserName)
+	d.Set("sftp_destination_folder", cfg.DestinationFolder)
+
+	return nil
+}
+
+func resourceLogDeliveryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+
+	err := client.SetLogDelivery(accountID, LogDeliveryConfig{DestinationType: LogDeliveryDestinationDefault})
+	if err != nil {
+		log.Printf("[ERROR] Could not restore Incapsula default log delivery for account %d: %s\n", accountID, err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,144 @@
+package incapsula
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourcePathAccelerationRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePathAccelerationRulesUpdate,
+		ReadContext:   resourcePathAccelerationRulesRead,
+		UpdateContext: resourcePathAccelerationRulesUpdate,
+		DeleteContext: resourcePathAccelerationRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(data *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				data.Set("site_id", data.Id())
+				return []*schema.ResourceData{data}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"rule": {
+				Description: "Ordered list of path acceleration rules. The first rule matching a request applies, requests not matching any rule use the site acceleration_level.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url_pattern": {
+							Description:  "Absolute path the rule applies to, may contain * wildcards, e.g. /api/*.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(pathAccelerationURLPatternRegex, "must be an absolute path optionally containing * wildcards, e.g. /api/*"),
+						},
+						"acceleration_level": {
+							Description:  "none | standard | aggressive.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(pathAccelerationLevels, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePathAccelerationRulesUpdate(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := data.Get("site_id").(string)
+
+	// The rules can't be reordered, so they are replaced to keep the configured order
+	err := deletePathAccelerationRules(client, siteID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for i, ruleRaw := range data.Get("rule").([]interface{}) {
+		ruleMap := ruleRaw.(map[string]interface{})
+		rule := PathAccelerationRule{
+			URLPattern:        ruleMap["url_pattern"].(string),
+			AccelerationLevel: ruleMap["acceleration_level"].(string),
+			Priority:          i + 1,
+		}
+		_, err := client.AddPathAccelerationRule(siteID, &rule)
+		if err != nil {
+			log.Printf("[ERROR] Failed to add path acceleration rule %s for Site ID %s", rule.URLPattern, siteID)
+			return diag.FromErr(err)
+		}
+	}
+
+	data.SetId(siteID)
+
+	return resourcePathAccelerationRulesRead(ctx, data, m)
+}
+
+func resourcePathAccelerationRulesRead(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := data.Get("site_id").(string)
+
+	rules, statusCode, err := client.ListPathAccelerationRules(siteID)
+
+	// If the site is deleted on the server, blow it out locally and run through the normal TF cycle
+	if statusCode == 404 {
+		log.Printf("[INFO] Incapsula Site with ID %s has already been deleted\n", siteID)
+		data.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ruleList := make([]interface{}, len(rules))
+	for i, rule := range rules {
+		ruleList[i] = map[string]interface{}{
+			"url_pattern":        rule.URLPattern,
+			"acceleration_level": rule.AccelerationLevel,
+		}
+	}
+	data.Set("rule", ruleList)
+
+	return nil
+}
+
+func resourcePathAccelerationRulesDelete(ctx context.Context, data *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	err := deletePathAccelerationRules(client, data.Get("site_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId("")
+	return nil
+}
+
+func deletePathAccelerationRules(client *Client, siteID string) error {
+	rules, _, err := client.ListPathAccelerationRules(siteID)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		err = client.DeletePathAccelerationRule(siteID, rule.RuleID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -45,6 +45,13 @@ func resourcePolicyAssetAssociation() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 			},
+			"replace_existing": {
+				Description: "Replace the WAF Policy already associated with the site instead of failing. Only applies to WAF Policies.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
 		},
 	}
 }
@@ -57,7 +64,12 @@ func resourcePolicyAssetAssociationCreate(d *schema.ResourceData, m interface{})
 	assetType := d.Get("asset_type").(string)
 	currentAccountId := d.Get("account_id").(int)
 
-	err := client.AddPolicyAssetAssociation(policyID, assetID, assetType, &currentAccountId)
+	var err error
+	if d.Get("replace_existing").(bool) {
+		err = client.ReplaceSiteWafPolicy(assetID, policyID, &currentAccountId)
+	} else {
+		err = client.AddPolicyAssetAssociation(policyID, assetID, assetType, &currentAccountId)
+	}
 
 	if err != nil {
 		log.Printf("[ERROR] Could not create Incapsula policy asset association: policy ID (%s) - asset ID (%s) - asset type (%s) - %s\n", policyID, assetID, assetType, err)
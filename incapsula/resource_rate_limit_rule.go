@@ -0,0 +1,153 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRateLimitRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRateLimitRuleCreate,
+		Read:   resourceRateLimitRuleRead,
+		Delete: resourceRateLimitRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idSlice := strings.Split(d.Id(), "/")
+				if len(idSlice) != 2 || idSlice[0] == "" || idSlice[1] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected site_id/rule_id", d.Id())
+				}
+
+				d.Set("site_id", idSlice[0])
+				d.SetId(idSlice[1])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		// Rate limit rules can't be updated, any change replaces the rule
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The rule name.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"url_pattern": {
+				Description:  "The paths the rule applies to. An absolute path optionally containing * wildcards, e.g. /login or /api/*.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(pathAccelerationURLPatternRegex, "must be an absolute path optionally containing * wildcards, e.g. /login"),
+			},
+			"threshold": {
+				Description:  "The number of requests a client can send within the window before the action applies.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(rateLimitMinThreshold, rateLimitMaxThreshold),
+			},
+			"window": {
+				Description:  "The window the requests are counted in, in seconds.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(rateLimitMinWindow, rateLimitMaxWindow),
+			},
+			"action": {
+				Description:  "The action applied to clients exceeding the threshold: block, challenge or throttle.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(rateLimitActions, false),
+			},
+		},
+	}
+}
+
+func resourceRateLimitRuleCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	rule := RateLimitRule{
+		Name:       d.Get("name").(string),
+		URLPattern: d.Get("url_pattern").(string),
+		Threshold:  d.Get("threshold").(int),
+		Window:     d.Get("window").(int),
+		Action:     d.Get("action").(string),
+	}
+
+	ruleWithID, err := client.AddRateLimitRule(d.Get("site_id").(string), &rule)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(ruleWithID.RuleID))
+
+	return resourceRateLimitRuleRead(d, m)
+}
+
+func resourceRateLimitRuleRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(string)
+
+	ruleID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rules, statusCode, err := client.ListRateLimitRules(siteID)
+
+	// The site may have been deleted
+	if statusCode == 404 {
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		if rule.RuleID == ruleID {
+			d.Set("name", rule.Name)
+			d.Set("url_pattern", rule.URLPattern)
+			d.Set("threshold", rule.Threshold)
+			d.Set("window", rule.Window)
+			d.Set("action", rule.Action)
+			return nil
+		}
+	}
+
+	// If the rule is deleted on the server, blow it out locally and run through the normal TF cycle
+	log.Printf("[INFO] Incapsula Rate Limit Rule %d for Site ID %s has already been deleted\n", ruleID, siteID)
+	d.SetId("")
+	return nil
+}
+
+func resourceRateLimitRuleDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	ruleID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = client.DeleteRateLimitRule(d.Get("site_id").(string), ruleID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
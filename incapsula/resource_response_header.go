@@ -0,0 +1,164 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceResponseHeader() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResponseHeaderCreate,
+		Read:   resourceResponseHeaderRead,
+		Update: resourceResponseHeaderUpdate,
+		Delete: resourceResponseHeaderDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idSlice := strings.SplitN(d.Id(), "/", 2)
+				if len(idSlice) != 2 || idSlice[0] == "" || idSlice[1] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected site_id/rule_name", d.Id())
+				}
+
+				d.Set("site_id", idSlice[0])
+				d.Set("name", idSlice[1])
+				d.SetId(idSlice[1])
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the delivery rule.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"header_name": {
+				Description:  "The response header name, e.g. X-Frame-Options.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(cacheVaryNameRegex, "must be a valid header name"),
+			},
+			"action": {
+				Description:  "What to do with the header: add (only when missing), replace or remove.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(responseHeaderActions, false),
+			},
+
+			// Optional Arguments
+			"header_value": {
+				Description: "The header value. Required with the add and replace actions.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"filter": {
+				Description: "The filter selecting the responses the rule applies to. When empty, the rule applies to all the responses.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceResponseHeaderCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID, err := strconv.Atoi(d.Get("site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	err = client.AddResponseHeaderRule(siteID, getResponseHeaderRule(d))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return resourceResponseHeaderRead(d, m)
+}
+
+func resourceResponseHeaderRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID, err := strconv.Atoi(d.Get("site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	rule, err := client.GetResponseHeaderRule(siteID, d.Id())
+	if err != nil {
+		return err
+	}
+
+	// If the rule is deleted on the server, blow it out locally and run through the normal TF cycle
+	if rule == nil {
+		log.Printf("[INFO] Incapsula response header rule %s for Site ID %d has already been deleted\n", d.Id(), siteID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("header_name", rule.HeaderName)
+	d.Set("header_value", rule.HeaderValue)
+	d.Set("action", rule.Action)
+	d.Set("filter", rule.Filter)
+
+	return nil
+}
+
+func resourceResponseHeaderUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID, err := strconv.Atoi(d.Get("site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	err = client.UpdateResponseHeaderRule(siteID, getResponseHeaderRule(d))
+	if err != nil {
+		return err
+	}
+
+	return resourceResponseHeaderRead(d, m)
+}
+
+func resourceResponseHeaderDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID, err := strconv.Atoi(d.Get("site_id").(string))
+	if err != nil {
+		return err
+	}
+
+	err = client.DeleteResponseHeaderRule(siteID, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func getResponseHeaderRule(d *schema.ResourceData) ResponseHeaderRule {
+	return ResponseHeaderRule{
+		Name:        d.Get("name").(string),
+		HeaderName:  d.Get("header_name").(string),
+		HeaderValue: d.Get("header_value").(string),
+		Action:      d.Get("action").(string),
+		Filter:      d.Get("filter").(string),
+	}
+}
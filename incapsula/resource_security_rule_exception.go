@@ -257,6 +257,18 @@ func resourceSecurityRuleExceptionRead(d *schema.ResourceData, m interface{}) er
 		}
 	}
 	if exceptionFound == false {
+		// The exception id changes when the exception is recreated outside of Terraform, so fall back to its natural key
+		exception, err := findSecurityRuleException(siteStatusResponse, ruleID, securityRuleExceptionKey(d))
+		if err != nil {
+			log.Printf("[ERROR] Could not read Incapsula security rule exception whitelist_id (%d) on rule_id (%s) %s\n", whitelistID, ruleID, err)
+			return err
+		}
+		if exception != nil {
+			log.Printf("[INFO] Incapsula security rule exception whitelist_id (%d) on rule_id (%s) was recreated with whitelist_id (%d)\n", whitelistID, ruleID, exception.ID)
+			d.SetId(strconv.Itoa(exception.ID))
+			return nil
+		}
+
 		log.Printf("[ERROR] Read Incapsula security rule exception failed, exception not found: whitelist_id (%d) and rule_id (%s) on site_id (%d)\n", whitelistID, ruleID, d.Get("site_id").(int))
 		d.SetId("")
 	} else {
@@ -266,6 +278,21 @@ func resourceSecurityRuleExceptionRead(d *schema.ResourceData, m interface{}) er
 	return nil
 }
 
+// securityRuleExceptionKey returns the natural key of the exception configured in the resource
+func securityRuleExceptionKey(d *schema.ResourceData) ExceptionKey {
+	return ExceptionKey{
+		ClientAppTypes: d.Get("client_app_types").(string),
+		ClientApps:     d.Get("client_apps").(string),
+		Countries:      d.Get("countries").(string),
+		Continents:     d.Get("continents").(string),
+		IPs:            d.Get("ips").(string),
+		URLPatterns:    d.Get("url_patterns").(string),
+		URLs:           d.Get("urls").(string),
+		UserAgents:     d.Get("user_agents").(string),
+		Parameters:     d.Get("parameters").(string),
+	}
+}
+
 func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 
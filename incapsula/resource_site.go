@@ -1,7 +1,9 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -18,14 +20,29 @@ const sleep_before_retry_seconds = 3
 
 func resourceSite() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSiteCreate,
-		Read:   resourceSiteRead,
-		Update: resourceSiteUpdate,
-		Delete: resourceSiteDelete,
+		CreateContext: resourceSiteCreateContext,
+		ReadContext:   resourceSiteReadContext,
+		UpdateContext: resourceSiteUpdateContext,
+		Delete:        resourceSiteDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			sanConfig := SANConfig{
+				AddNakedDomainSan: diff.Get("naked_domain_san").(bool),
+				UseWildcardSan:    diff.Get("wildcard_san").(bool),
+			}
+			rawConfig := diff.GetRawConfig()
+			nakedDomainSanSet := !rawConfig.IsNull() && !rawConfig.GetAttr("naked_domain_san").IsNull()
+			wildcardSanSet := !rawConfig.IsNull() && !rawConfig.GetAttr("wildcard_san").IsNull()
+			warnings, err := validateSANConfig(diff.Get("domain").(string), sanConfig, nakedDomainSanSet, wildcardSanSet)
+			for _, warning := range warnings {
+				log.Printf("[WARN] %s\n", warning)
+			}
+			return err
+		},
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"domain": {
@@ -45,16 +62,37 @@ func resourceSite() *schema.Resource {
 
 			// Optional Arguments
 			"account_id": {
-				Description: "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
-				Type:        schema.TypeInt,
+				Description:      "Numeric identifier of the account to operate on. If not specified, operation will be performed on the account identified by the authentication parameters.",
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressMovedSiteAccountIDDiff,
+			},
+			"adopt_existing": {
+				Description: "Adopt the existing site when the domain already has one, instead of failing the create. The site must be in the configured account and have the configured ref_id.",
+				Type:        schema.TypeBool,
 				Optional:    true,
-				Computed:    true,
-				ForceNew:    true,
+				Default:     false,
 			},
 			"ref_id": {
-				Description: "Customer specific identifier for this operation.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:   "Customer specific identifier for this operation.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"tags", "ref_tags"},
+			},
+			"tags": {
+				Description:   "Key/value tags of the site. Tags are stored in the site's ref_id, so they can't be used along with ref_id.",
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"ref_id", "ref_tags"},
+				Elem:          &schema.Schema{Type: schema.TypeString},
+			},
+			"ref_tags": {
+				Description:   "Plain tags of the site, stored in the site's ref_id separated by ','. They can't be used along with ref_id or tags.",
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"ref_id", "tags"},
+				Elem:          &schema.Schema{Type: schema.TypeString},
 			},
 			"send_site_setup_emails": {
 				Description: "If this value is false, end users will not get emails about the add site process such as DNS instructions and SSL setup.",
@@ -107,9 +145,21 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 			},
 			"acceleration_level": {
-				Description: "none | standard | aggressive.",
+				Description:      "none | standard | advanced. The raw level aggressive is accepted for advanced.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressEquivalentAccelerationLevelDiff,
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					if _, err := normalizeAccelerationLevelRaw(val.(string)); err != nil {
+						errs = append(errs, err)
+					}
+					return
+				},
+			},
+			"acceleration_level_raw": {
+				Description: "The raw acceleration level of the site: none | standard | aggressive.",
 				Type:        schema.TypeString,
-				Optional:    true,
 				Computed:    true,
 			},
 			"seal_location": {
@@ -118,6 +168,42 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"seal": {
+				Description:   "The trust seal configuration, including its type and position. Conflicts with seal_location.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"seal_location"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The seal location, e.g. api.seal_location.bottom_left.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"type": {
+							Description: "The seal type.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+						},
+						"position": {
+							Description:  "The seal position. Options are `fixed`, `floating`, and `inline`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice(sealPositions, false),
+						},
+					},
+				},
+			},
+			"support_all_tls_versions": {
+				Description: "Support all the TLS versions, including the deprecated TLS 1.0 and 1.1. Enabling it emits a warning.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
 			"restricted_cname_reuse": {
 				Description: "Use this option to allow Imperva to detect and add domains that are using the Imperva-provided CNAME (not recommended). One of: true | false",
 				Type:        schema.TypeString,
@@ -160,10 +246,18 @@ func resourceSite() *schema.Resource {
 				},
 			},
 			"log_level": {
-				Description: "The log level. Options are `full`, `security`, and `none`.",
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
+				Description:  "The log level. Options are `full`, `security`, and `none`.",
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(siteLogLevels, false),
+			},
+			"log_format": {
+				Description:  "The log format. Options are `CEF`, `LEEF`, and `JSON`. Requires `log_level`, and is ignored with the `none` log level.",
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(siteLogFormats, false),
 			},
 			"perf_client_comply_no_cache": {
 				Description: "Comply with No-Cache and Max-Age directives in client requests. By default, these cache directives are ignored. Resources are dynamically profiled and re-configured to optimize performance.",
@@ -189,6 +283,27 @@ func resourceSite() *schema.Resource {
 				Computed:    true,
 				Optional:    true,
 			},
+			"perf_key_query_string_mode": {
+				Description:  "How the query string is used in the cache key. Options are `include_all`, `ignore_all` and `include_specified`.",
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(cacheKeyQueryStringModes, false),
+			},
+			"perf_key_query_string_params": {
+				Description: "The query string parameters included in the cache key. Only used with the `include_specified` query string mode.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"perf_key_cookies": {
+				Description: "The names of the cookies included in the cache key.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"perf_key_unite_naked_full_cache": {
 				Description: "Use the Same Cache for Full and Naked Domains. For example, use the same cached resource for www.example.com/a and example.com/a.",
 				Type:        schema.TypeBool,
@@ -291,16 +406,79 @@ func resourceSite() *schema.Resource {
 				Optional:    true,
 			},
 			"perf_ttl_use_shortest_caching": {
-				Description: "Use shortest caching duration in case of conflicts. By default, the longest duration is used in case of conflict between caching rules or modes. When this option is checked, Imperva uses the shortest duration in case of conflict.",
+				Description:   "Use shortest caching duration in case of conflicts. By default, the longest duration is used in case of conflict between caching rules or modes. When this option is checked, Imperva uses the shortest duration in case of conflict.",
+				Type:          schema.TypeBool,
+				Computed:      true,
+				Optional:      true,
+				ConflictsWith: []string{"perf_ttl_policy"},
+			},
+			"perf_ttl_policy": {
+				Description:   "The caching duration used in case of conflicts: shortest | longest. Supersedes perf_ttl_use_shortest_caching.",
+				Type:          schema.TypeString,
+				Computed:      true,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(cachingTTLPolicies, false),
+				ConflictsWith: []string{"perf_ttl_use_shortest_caching"},
+			},
+			"perf_on_the_fly_compression": {
+				Description: "Compress dynamic content on the fly, reducing the size of responses which can't be cached.",
 				Type:        schema.TypeBool,
 				Computed:    true,
 				Optional:    true,
 			},
+			"cache_redirects": {
+				Description:   "Cache 301, 302, 303, 307 and 308 redirect responses. A cached redirect keeps being served until it expires, even after it's changed on the origin. Alias of perf_response_cache_300x.",
+				Type:          schema.TypeBool,
+				Computed:      true,
+				Optional:      true,
+				ConflictsWith: []string{"perf_response_cache_300x"},
+				Deprecated:    "use perf_response_cache_300x",
+			},
+			"sans": {
+				Description: "The exact set of SANs of the site's Imperva generated certificate, other than the site domain and the SANs of naked_domain_san and wildcard_san. SANs which aren't listed are removed.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"san_validation_records": {
+				Description: "The DNS records to set so the SANs of the Imperva generated certificate can be validated.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
 			"naked_domain_san": {
-				Description: "Use 'true' to add the naked domain SAN to a www site’s SSL certificate. Default value: true",
+				Description:      "Use 'true' to add the naked domain SAN to a www site’s SSL certificate. Default value: true",
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Default:          true,
+				DiffSuppressFunc: suppressInheritedNakedDomainSANDiff,
+			},
+			"inherit_naked_domain_san": {
+				Description: "Use 'true' for a new www site to inherit the naked_domain_san_for_new_www_sites default of its account. naked_domain_san is ignored then. Default value: false",
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     true,
+				Default:     false,
 			},
 			"wildcard_san": {
 				Description: "Use 'true' to add the wildcard SAN or 'false' to add the full domain SAN to the site’s SSL certificate. Default value: true",
@@ -309,6 +487,11 @@ func resourceSite() *schema.Resource {
 				Default:     true,
 			},
 			// Computed Attributes
+			"moved_from_account_id": {
+				Description: "Numeric identifier of the account the site was moved from outside of Terraform. 0 when the site wasn't moved.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 			"site_creation_date": {
 				Description: "Numeric representation of the site creation date.",
 				Type:        schema.TypeInt,
@@ -342,6 +525,11 @@ func resourceSite() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"certificate_type": {
+				Description: "The certificate the site serves: generated | custom | none.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"dns_record_name": {
 				Description: "The DNS Record type TXT that should be created and set to the `domain_verification` output value.",
 				Type:        schema.TypeString,
@@ -361,31 +549,31 @@ func resourceSite() *schema.Resource {
 	}
 }
 
+func resourceSiteCreateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	enablingAllTLSVersions := isEnablingSupportAllTLSVersions(d)
+	err := resourceSiteCreate(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return supportAllTLSVersionsDiagnostics(d, enablingAllTLSVersions)
+}
+
 func resourceSiteCreate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 	domain := d.Get("domain").(string)
 
 	log.Printf("[INFO] Creating Incapsula site for domain: %s\n", domain)
 
-	siteAddResponse, err := client.AddSite(
-		domain,
-		d.Get("ref_id").(string),
-		d.Get("send_site_setup_emails").(string),
-		d.Get("site_ip").(string),
-		d.Get("force_ssl").(string),
-		d.Get("account_id").(int),
-		d.Get("naked_domain_san").(bool),
-		d.Get("wildcard_san").(bool),
-		d.Get("logs_account_id").(string),
-	)
+	nakedDomainSan := siteNakedDomainSAN(client, d)
 
+	siteID, err := addOrAdoptSite(client, d, nakedDomainSan)
 	if err != nil {
 		log.Printf("[ERROR] Could not create Incapsula site for domain: %s, %s\n", domain, err)
 		return err
 	}
 
 	// Set the Site ID
-	d.SetId(strconv.Itoa(siteAddResponse.SiteID))
+	d.SetId(strconv.Itoa(siteID))
 	log.Printf("[INFO] Created Incapsula site for domain: %s\n", domain)
 
 	// There may be a timing/race condition here
@@ -417,11 +605,168 @@ func resourceSiteCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = updatePerformanceAdvancedSettings(client, d)
+	if err != nil {
+		return err
+	}
+
+	err = updateSealConfig(client, d)
+	if err != nil {
+		return err
+	}
+
+	err = updateSupportAllTLSVersions(client, d)
+	if err != nil {
+		return err
+	}
+
+	err = updateSiteTags(client, d)
+	if err != nil {
+		return err
+	}
+
+	err = updateSiteRefTags(client, d)
+	if err != nil {
+		return err
+	}
+
+	err = updateSiteSANs(client, d)
+	if err != nil {
+		return err
+	}
+
+	// The site is created, settings still being applied only delay the read
+	_, err = client.WaitForSiteStatusStable(siteID, 0)
+	if err != nil {
+		log.Printf("[WARN] Incapsula site for domain %s (site_id %d) is still changing, reading it anyway: %s\n", domain, siteID, err)
+	}
+
 	// Set the rest of the state from the resource read
 	return resourceSiteRead(d, m)
 }
 
+// addOrAdoptSite adds the site and returns its id. With adopt_existing, a failed add is looked up by domain: when the
+// domain already has a site it's adopted if it matches the configuration, and the rest of the create configures it.
+func addOrAdoptSite(client *Client, d *schema.ResourceData, nakedDomainSan bool) (int, error) {
+	domain := d.Get("domain").(string)
+	accountID := d.Get("account_id").(int)
+	siteAddResponse, err := client.WithAccount(accountID).AddSite(
+		domain,
+		d.Get("ref_id").(string),
+		d.Get("send_site_setup_emails").(string),
+		d.Get("site_ip").(string),
+		d.Get("force_ssl").(string),
+		0,
+		nakedDomainSan,
+		d.Get("wildcard_san").(bool),
+		d.Get("logs_account_id").(string),
+	)
+	if err == nil {
+		return siteAddResponse.SiteID, nil
+	}
+	if !d.Get("adopt_existing").(bool) {
+		return 0, err
+	}
+
+	// The add fails for other reasons too, only a site found for the domain is adopted
+	site, findErr := client.FindSiteByDomain(domain, accountID)
+	if findErr != nil {
+		log.Printf("[INFO] No existing Incapsula site to adopt for domain %s: %s\n", domain, findErr)
+		return 0, err
+	}
+	log.Printf("[INFO] Incapsula site for domain %s already exists, adopting it\n", domain)
+
+	mismatches := make([]string, 0)
+	if accountID != 0 && site.AccountID != accountID {
+		mismatches = append(mismatches, fmt.Sprintf("account_id is %d instead of %d", site.AccountID, accountID))
+	}
+	if refID := d.Get("ref_id").(string); refID != "" && site.RefID != refID {
+		mismatches = append(mismatches, fmt.Sprintf("ref_id is %q instead of %q", site.RefID, refID))
+	}
+	if len(mismatches) > 0 {
+		return 0, fmt.Errorf("Error - the existing Incapsula site %d for domain %s doesn't match the configuration and wasn't adopted: %s", site.SiteID, domain, strings.Join(mismatches, ", "))
+	}
+
+	log.Printf("[INFO] Adopted existing Incapsula site %d for domain: %s\n", site.SiteID, domain)
+	return site.SiteID, nil
+}
+
+func resourceSiteReadContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	previousAccountID := d.Get("account_id").(int)
+	siteStatusResponse, err := readSite(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	diags := siteErrorDiagnostics(siteStatusResponse)
+	diags = append(diags, siteWarningDiagnostics(siteStatusResponse)...)
+	return append(diags, siteAccountMoveDiagnostics(d, previousAccountID, siteStatusResponse)...)
+}
+
+// siteAccountMoveDiagnostics detects a site moved to another account outside of Terraform. The state keeps the account
+// reported by the site status, the account it was moved from is recorded so the configuration still referencing it
+// doesn't replace the site (see suppressMovedSiteAccountIDDiff), and a warning asks to update the configuration.
+func siteAccountMoveDiagnostics(d *schema.ResourceData, previousAccountID int, siteStatusResponse *SiteStatusResponse) diag.Diagnostics {
+	if siteStatusResponse == nil || previousAccountID == 0 || previousAccountID == siteStatusResponse.AccountID {
+		return nil
+	}
+
+	log.Printf("[WARN] Incapsula site %d was moved from account %d to account %d outside of Terraform\n", siteStatusResponse.SiteID, previousAccountID, siteStatusResponse.AccountID)
+	d.Set("moved_from_account_id", previousAccountID)
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Incapsula site %d was moved to another account", siteStatusResponse.SiteID),
+		Detail:   fmt.Sprintf("The site was moved from account %d to account %d outside of Terraform. The state was updated, set account_id to %d in the configuration.", previousAccountID, siteStatusResponse.AccountID, siteStatusResponse.AccountID),
+	}}
+}
+
 func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
+	_, err := readSite(d, m)
+	return err
+}
+
+// siteErrorDiagnostics converts the additional errors of the site status to error diagnostics, with the debug_info id
+// for support, so an apply fails with the reason the site is blocked
+func siteErrorDiagnostics(siteStatusResponse *SiteStatusResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if siteStatusResponse == nil {
+		return diags
+	}
+	for _, siteErr := range siteStatusResponse.AdditionalErrors {
+		detail := siteErr.Error()
+		if siteStatusResponse.DebugInfo.IDInfo != "" {
+			detail = fmt.Sprintf("%s (id-info: %s)", detail, siteStatusResponse.DebugInfo.IDInfo)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Incapsula site %d has an error", siteStatusResponse.SiteID),
+			Detail:   detail,
+		})
+	}
+	return diags
+}
+
+// siteWarningDiagnostics converts the warnings of the site status to warning diagnostics, with the debug_info id for support
+func siteWarningDiagnostics(siteStatusResponse *SiteStatusResponse) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if siteStatusResponse == nil {
+		return diags
+	}
+	for _, message := range siteStatusResponse.WarningMessages() {
+		detail := message
+		if siteStatusResponse.DebugInfo.IDInfo != "" {
+			detail = fmt.Sprintf("%s (id-info: %s)", message, siteStatusResponse.DebugInfo.IDInfo)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Incapsula site %d has a warning", siteStatusResponse.SiteID),
+			Detail:   detail,
+		})
+	}
+	return diags
+}
+
+// readSite sets the state from the site status and returns it, or nil when the site doesn't exist anymore
+func readSite(d *schema.ResourceData, m interface{}) (*SiteStatusResponse, error) {
 	client := m.(*Client)
 
 	domain := d.Get("domain").(string)
@@ -435,12 +780,12 @@ func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
 	if siteStatusResponse != nil && siteStatusResponse.Res.(float64) == 9413 {
 		log.Printf("[INFO] Incapsula Site ID %d has already been deleted: %s\n", siteID, err)
 		d.SetId("")
-		return nil
+		return nil, nil
 	}
 
 	if err != nil {
 		log.Printf("[ERROR] Could not read Incapsula site for domain: %s, %s\n", domain, err)
-		return err
+		return nil, err
 	}
 
 	d.Set("site_creation_date", siteStatusResponse.SiteCreationDate)
@@ -448,10 +793,45 @@ func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("account_id", siteStatusResponse.AccountID)
 	d.Set("naked_domain_san", siteStatusResponse.AddNakedDomainSan)
 	d.Set("wildcard_san", siteStatusResponse.UseWildcardSanInsteadOfFullDomainSan)
-	d.Set("acceleration_level", siteStatusResponse.AccelerationLevelRaw)
+	d.Set("sans", withoutSiteManagedSANs(siteStatusResponse.Domain, siteStatusResponse.Ssl.GeneratedCertificate.San))
+	sanValidationRecords := make([]map[string]interface{}, 0)
+	for _, record := range pendingSANValidationRecords(siteStatusResponse) {
+		sanValidationRecords = append(sanValidationRecords, map[string]interface{}{
+			"name":   record.RecordName,
+			"type":   record.RecordType,
+			"values": record.Values,
+		})
+	}
+	d.Set("san_validation_records", sanValidationRecords)
+	d.Set("certificate_type", certificateType(siteStatusResponse))
+	accelerationLevel, err := AccelerationLevelFromRaw(siteStatusResponse.AccelerationLevelRaw)
+	if err != nil {
+		log.Printf("[WARN] %s for site_id: %s, reading the acceleration_level as returned\n", err, d.Id())
+		accelerationLevel = siteStatusResponse.AccelerationLevel
+	}
+	d.Set("acceleration_level", accelerationLevel)
+	d.Set("acceleration_level_raw", siteStatusResponse.AccelerationLevelRaw)
 	d.Set("active", siteStatusResponse.Active)
 	d.Set("restricted_cname_reuse", strconv.FormatBool(siteStatusResponse.RestrictedCnameReuse))
 	d.Set("seal_location", siteStatusResponse.SealLocation.ID)
+	_, refIDSet := d.GetOk("ref_id")
+	_, refTagsSet := d.GetOk("ref_tags")
+	if !refIDSet && !refTagsSet {
+		d.Set("tags", decodeSiteTags(siteStatusResponse.RefID))
+	}
+	// Any ref_id decodes as ref tags, so they're only read back once they're managed
+	if refTagsSet {
+		d.Set("ref_tags", decodeSiteRefTags(siteStatusResponse.RefID))
+	}
+	d.Set("support_all_tls_versions", siteStatusResponse.SupportAllTLSVersions)
+	sealConfig := getSealConfig(siteStatusResponse)
+	d.Set("seal", []interface{}{
+		map[string]interface{}{
+			"id":       sealConfig.ID,
+			"type":     sealConfig.Type,
+			"position": sealConfig.Position,
+		},
+	})
 
 	// Set the DNS information
 	dnsARecordValues := make([]string, 0)
@@ -491,12 +871,15 @@ func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
 	if siteStatusResponse.LogLevel != "" {
 		d.Set("log_level", siteStatusResponse.LogLevel)
 	}
+	if siteStatusResponse.LogFormat != "" {
+		d.Set("log_format", siteStatusResponse.LogFormat)
+	}
 
 	// Get the data storage region for the site
 	dataStorageRegionResponse, err := client.GetDataStorageRegion(d.Id())
 	if err != nil {
 		log.Printf("[ERROR] Could not read Incapsula site data storage region for domain: %s and site id: %d, %s\n", domain, siteID, err)
-		return err
+		return nil, err
 	}
 	d.Set("data_storage_region", dataStorageRegionResponse.Region)
 
@@ -504,26 +887,34 @@ func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
 	maskingResponse, err := client.GetMaskingSettings(d.Id())
 	if err != nil {
 		log.Printf("[ERROR] Could not read Incapsula site masking settings for domain: %s and site id: %d, %s\n", domain, siteID, err)
-		return err
+		return nil, err
 	}
 	d.Set("hashing_enabled", maskingResponse.HashingEnabled)
 	d.Set("hash_salt", maskingResponse.HashSalt)
 
+	for attribute, value := range performanceAdvancedSettings(siteStatusResponse) {
+		d.Set(attribute, value)
+	}
+
 	// Get the performance settings for the site
 	performanceSettingsResponse, _, err := client.GetPerformanceSettings(d.Id())
 	if err != nil {
 		log.Printf("[ERROR] Could not read Incapsula site peformance settings for domain: %s and site id: %d, %s\n", domain, siteID, err)
-		return err
+		return nil, err
 	}
 	d.Set("perf_client_comply_no_cache", performanceSettingsResponse.ClientSide.ComplyNoCache)
 	d.Set("perf_client_enable_client_side_caching", performanceSettingsResponse.ClientSide.EnableClientSideCaching)
 	d.Set("perf_client_send_age_header", performanceSettingsResponse.ClientSide.SendAgeHeader)
 	d.Set("perf_key_comply_vary", performanceSettingsResponse.Key.ComplyVary)
+	d.Set("perf_key_query_string_mode", performanceSettingsResponse.Key.QueryStringMode)
+	d.Set("perf_key_query_string_params", performanceSettingsResponse.Key.QueryStringParams)
+	d.Set("perf_key_cookies", performanceSettingsResponse.Key.Cookies)
 	d.Set("perf_key_unite_naked_full_cache", performanceSettingsResponse.Key.UniteNakedFullCache)
 	d.Set("perf_mode_https", performanceSettingsResponse.Mode.HTTPS)
 	d.Set("perf_mode_level", performanceSettingsResponse.Mode.Level)
 	d.Set("perf_mode_time", performanceSettingsResponse.Mode.Time)
 	d.Set("perf_response_cache_300x", performanceSettingsResponse.Response.Cache300X)
+	d.Set("cache_redirects", performanceSettingsResponse.Response.Cache300X)
 	d.Set("perf_response_cache_404_enabled", performanceSettingsResponse.Response.Cache404.Enabled)
 	d.Set("perf_response_cache_404_time", performanceSettingsResponse.Response.Cache404.Time)
 	d.Set("perf_response_cache_empty_responses", performanceSettingsResponse.Response.CacheEmptyResponses)
@@ -536,28 +927,29 @@ func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("perf_response_tag_response_header", performanceSettingsResponse.Response.TagResponseHeader)
 	d.Set("perf_ttl_prefer_last_modified", performanceSettingsResponse.TTL.PreferLastModified)
 	d.Set("perf_ttl_use_shortest_caching", performanceSettingsResponse.TTL.UseShortestCaching)
+	d.Set("perf_ttl_policy", cachingTTLPolicy(performanceSettingsResponse))
 
 	// Get the original data center ID (the first in the list of associated data centers)
 	dcsConfDTO, err := client.GetDataCentersConfiguration(d.Id())
 	if err != nil || len(dcsConfDTO.Data) == 0 || len(dcsConfDTO.Data[0].DataCenters) == 0 {
 		log.Printf("[ERROR] Could not read Incapsula data centers for domain: %s and site id: %d, %s\n", domain, siteID, err)
-		return err
+		return nil, err
 	}
 
 	if len(dcsConfDTO.Data[0].DataCenters[0].OriginServers) == 0 {
 		log.Printf("[ERROR] Could not read Incapsula data center servers for domain: %s and site id: %d, %s\n", domain, siteID, err)
-		return err
+		return nil, err
 	}
 
 	dataCenterID := dcsConfDTO.Data[0].DataCenters[0].ID
 	if dataCenterID == nil {
-		return fmt.Errorf("[ERROR] Incapsula Data Center missing for Site ID %s", d.Get("site_id"))
+		return nil, fmt.Errorf("[ERROR] Incapsula Data Center missing for Site ID %s", d.Get("site_id"))
 	}
 	d.Set("original_data_center_id", *dataCenterID)
 
 	siteIP := dcsConfDTO.Data[0].DataCenters[0].OriginServers[0].Address
 	if siteIP == "" {
-		return fmt.Errorf("[ERROR] Incapsula Data Center missing server address for Site ID %s", d.Get("site_id"))
+		return nil, fmt.Errorf("[ERROR] Incapsula Data Center missing server address for Site ID %s", d.Get("site_id"))
 	}
 
 	if d.IsNewResource() || d.Get("site_ip") == "" {
@@ -566,13 +958,33 @@ func resourceSiteRead(d *schema.ResourceData, m interface{}) error {
 
 	log.Printf("[INFO] Finished reading Incapsula site for domain: %s\n", domain)
 
-	return nil
+	return siteStatusResponse, nil
+}
+
+func resourceSiteUpdateContext(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	enablingAllTLSVersions := isEnablingSupportAllTLSVersions(d)
+	err := resourceSiteUpdate(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return supportAllTLSVersionsDiagnostics(d, enablingAllTLSVersions)
 }
 
 func resourceSiteUpdate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 
-	err := updateAdditionalSiteProperties(update_retries, client, d)
+	// Move the site first, so the other settings are applied in its new account
+	err := updateSiteAccount(client, d)
+	if err != nil {
+		return err
+	}
+
+	err = updateAdditionalSiteProperties(update_retries, client, d)
+	if err != nil {
+		return err
+	}
+
+	err = updateSANConfiguration(client, d)
 	if err != nil {
 		return err
 	}
@@ -597,6 +1009,36 @@ func resourceSiteUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	err = updatePerformanceAdvancedSettings(client, d)
+	if err != nil {
+		return err
+	}
+
+	err = updateSealConfig(client, d)
+	if err != nil {
+		return err
+	}
+
+	err = updateSupportAllTLSVersions(client, d)
+	if err != nil {
+		return err
+	}
+
+	err = updateSiteTags(client, d)
+	if err != nil {
+		return err
+	}
+
+	err = updateSiteRefTags(client, d)
+	if err != nil {
+		return err
+	}
+
+	err = updateSiteSANs(client, d)
+	if err != nil {
+		return err
+	}
+
 	// Set the rest of the state from the resource read
 	return resourceSiteRead(d, m)
 }
@@ -608,7 +1050,7 @@ func resourceSiteDelete(d *schema.ResourceData, m interface{}) error {
 
 	log.Printf("[INFO] Deleting Incapsula site for domain: %s\n", domain)
 
-	return resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		err := client.DeleteSite(domain, siteID)
 
 		if err != nil {
@@ -617,16 +1059,27 @@ func resourceSiteDelete(d *schema.ResourceData, m interface{}) error {
 
 		log.Printf("[INFO] Deleted site (%s) for domain %s\n", d.Id(), domain)
 
-		// Set the ID to empty
-		// Implicitly clears the resource
-		d.SetId("")
-
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	// The site is deleted, a site still reported by the status only delays the confirmation
+	err = client.WaitForSiteDeleted(siteID, 0)
+	if err != nil {
+		log.Printf("[WARN] Could not confirm the deletion of Incapsula site (%s) for domain %s: %s\n", d.Id(), domain, err)
+	}
+
+	// Set the ID to empty
+	// Implicitly clears the resource
+	d.SetId("")
+
+	return nil
 }
 
 func updateAdditionalSiteProperties(retries int, client *Client, d *schema.ResourceData) error {
-	updateParams := [12]string{"acceleration_level", "active", "approver", "domain_redirect_to_full", "domain_validation", "ignore_ssl", "remove_ssl", "ref_id", "seal_location", "restricted_cname_reuse", "naked_domain_san", "wildcard_san"}
+	updateParams := [10]string{"acceleration_level", "active", "approver", "domain_redirect_to_full", "domain_validation", "ignore_ssl", "remove_ssl", "ref_id", "seal_location", "restricted_cname_reuse"}
 	retryCounter := 1
 	return resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 		for i := 0; i < len(updateParams); i++ {
@@ -635,7 +1088,16 @@ func updateAdditionalSiteProperties(retries int, client *Client, d *schema.Resou
 			if d.HasChange(param) && d.Get(param) != "" {
 				value := fmt.Sprintf("%v", d.Get(param))
 				log.Printf("[INFO] Updating Incapsula site param (%s) with value (%s) for site_id: %s\n", param, value, d.Id())
-				_, err := client.UpdateSite(d.Id(), param, value)
+				var err error
+				if param == "acceleration_level" {
+					siteID, _ := strconv.Atoi(d.Id())
+					err = client.SetAccelerationLevel(siteID, value)
+				} else {
+					err = ValidateSiteConfigParam(param, value)
+					if err == nil {
+						_, err = client.UpdateSite(d.Id(), param, value)
+					}
+				}
 				if err != nil {
 					if retryCounter <= retries && strings.Contains(err.Error(), "Add site operation") {
 						log.Printf("[INFO] retry number %d/%d to update Incapsula site param (%s) for site_id: %s\n", retryCounter, retries, param, d.Id())
@@ -689,6 +1151,47 @@ func updateLogLevel(client *Client, d *schema.ResourceData) error {
 			return err
 		}
 	}
+
+	logFormat := d.Get("log_format").(string)
+	// The none log level has no format
+	if logFormat != "" && d.Get("log_level").(string) != "none" && (d.HasChange("log_format") || d.HasChange("log_level") || d.HasChange("logs_account_id")) {
+		siteID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return err
+		}
+		err = client.SetLogConfig(siteID, d.Get("log_level").(string), logFormat)
+		if err != nil {
+			log.Printf("[ERROR] Could not update Incapsula site log format: %s for site_id: %s %s\n", logFormat, d.Id(), err)
+			return err
+		}
+	}
+	return nil
+}
+
+// Advanced performance params by site resource attribute
+var performanceAdvancedParams = map[string]string{
+	"perf_on_the_fly_compression": performanceAdvancedOnTheFlyCompression,
+}
+
+// performanceAdvancedSettings returns the advanced performance settings from the site status by site resource attribute
+func performanceAdvancedSettings(siteStatusResponse *SiteStatusResponse) map[string]bool {
+	return map[string]bool{
+		"perf_on_the_fly_compression": siteStatusResponse.PerformanceConfiguration.OnTheFlyCompression,
+	}
+}
+
+func updatePerformanceAdvancedSettings(client *Client, d *schema.ResourceData) error {
+	for attribute, param := range performanceAdvancedParams {
+		if !d.HasChange(attribute) {
+			continue
+		}
+		value := strconv.FormatBool(d.Get(attribute).(bool))
+		err := client.UpdatePerformanceAdvancedSetting(d.Id(), param, value)
+		if err != nil {
+			log.Printf("[ERROR] Could not update Incapsula site advanced performance setting (%s) with value (%s) for site_id: %s %s\n", param, value, d.Id(), err)
+			return err
+		}
+	}
 	return nil
 }
 
@@ -697,11 +1200,15 @@ func updatePerformanceSettings(client *Client, d *schema.ResourceData) error {
 		d.HasChange("perf_client_enable_client_side_caching") ||
 		d.HasChange("perf_client_send_age_header") ||
 		d.HasChange("perf_key_comply_vary") ||
+		d.HasChange("perf_key_query_string_mode") ||
+		d.HasChange("perf_key_query_string_params") ||
+		d.HasChange("perf_key_cookies") ||
 		d.HasChange("perf_key_unite_naked_full_cache") ||
 		d.HasChange("perf_mode_https") ||
 		d.HasChange("perf_mode_level") ||
 		d.HasChange("perf_mode_time") ||
 		d.HasChange("perf_response_cache_300x") ||
+		d.HasChange("cache_redirects") ||
 		d.HasChange("perf_response_cache_404_enabled") ||
 		d.HasChange("perf_response_cache_404_time") ||
 		d.HasChange("perf_response_cache_empty_responses") ||
@@ -713,17 +1220,32 @@ func updatePerformanceSettings(client *Client, d *schema.ResourceData) error {
 		d.HasChange("perf_response_stale_content_time") ||
 		d.HasChange("perf_response_tag_response_header") ||
 		d.HasChange("perf_ttl_prefer_last_modified") ||
-		d.HasChange("perf_ttl_use_shortest_caching") {
+		d.HasChange("perf_ttl_use_shortest_caching") ||
+		d.HasChange("perf_ttl_policy") {
 		performanceSettings := PerformanceSettings{}
 		performanceSettings.ClientSide.ComplyNoCache = d.Get("perf_client_comply_no_cache").(bool)
 		performanceSettings.ClientSide.EnableClientSideCaching = d.Get("perf_client_enable_client_side_caching").(bool)
 		performanceSettings.ClientSide.SendAgeHeader = d.Get("perf_client_send_age_header").(bool)
-		performanceSettings.Key.ComplyVary = d.Get("perf_key_comply_vary").(bool)
+		cacheKeyPolicy := CacheKeyPolicy{
+			ComplyVary:        d.Get("perf_key_comply_vary").(bool),
+			QueryStringMode:   d.Get("perf_key_query_string_mode").(string),
+			QueryStringParams: toStringSlice(d.Get("perf_key_query_string_params").([]interface{})),
+			Cookies:           toStringSlice(d.Get("perf_key_cookies").([]interface{})),
+		}
+		err := validateCacheKeyPolicy(cacheKeyPolicy)
+		if err != nil {
+			return err
+		}
+		applyCacheKeyPolicy(&performanceSettings, cacheKeyPolicy)
 		performanceSettings.Key.UniteNakedFullCache = d.Get("perf_key_unite_naked_full_cache").(bool)
 		performanceSettings.Mode.HTTPS = d.Get("perf_mode_https").(string)
 		performanceSettings.Mode.Level = d.Get("perf_mode_level").(string)
 		performanceSettings.Mode.Time = d.Get("perf_mode_time").(int)
 		performanceSettings.Response.Cache300X = d.Get("perf_response_cache_300x").(bool)
+		if d.HasChange("cache_redirects") {
+			// cache_redirects is a deprecated alias of perf_response_cache_300x, they can't be set together
+			performanceSettings.Response.Cache300X = d.Get("cache_redirects").(bool)
+		}
 		performanceSettings.Response.Cache404.Enabled = d.Get("perf_response_cache_404_enabled").(bool)
 		performanceSettings.Response.Cache404.Time = d.Get("perf_response_cache_404_time").(int)
 		performanceSettings.Response.CacheEmptyResponses = d.Get("perf_response_cache_empty_responses").(bool)
@@ -736,8 +1258,15 @@ func updatePerformanceSettings(client *Client, d *schema.ResourceData) error {
 		performanceSettings.Response.TagResponseHeader = d.Get("perf_response_tag_response_header").(string)
 		performanceSettings.TTL.PreferLastModified = d.Get("perf_ttl_prefer_last_modified").(bool)
 		performanceSettings.TTL.UseShortestCaching = d.Get("perf_ttl_use_shortest_caching").(bool)
+		if ttlPolicy := d.Get("perf_ttl_policy").(string); ttlPolicy != "" && d.HasChange("perf_ttl_policy") {
+			err = validateCachingTTLPolicy(ttlPolicy)
+			if err != nil {
+				return err
+			}
+			applyCachingTTLPolicy(&performanceSettings, ttlPolicy)
+		}
 
-		_, err := client.UpdatePerformanceSettings(d.Id(), &performanceSettings)
+		_, err = client.UpdatePerformanceSettings(d.Id(), &performanceSettings)
 		if err != nil {
 			log.Printf("[ERROR] Could not update Incapsula performance settings for site_id: %s %s\n", d.Id(), err)
 			return err
@@ -745,3 +1274,197 @@ func updatePerformanceSettings(client *Client, d *schema.ResourceData) error {
 	}
 	return nil
 }
+
+func updateSealConfig(client *Client, d *schema.ResourceData) error {
+	if !d.HasChange("seal") {
+		return nil
+	}
+
+	sealList := d.Get("seal").([]interface{})
+	if len(sealList) == 0 || sealList[0] == nil {
+		return nil
+	}
+
+	sealMap := sealList[0].(map[string]interface{})
+	sealConfig := SealConfig{
+		ID:       sealMap["id"].(string),
+		Type:     sealMap["type"].(string),
+		Position: sealMap["position"].(string),
+	}
+	siteID, _ := strconv.Atoi(d.Id())
+	err := client.SetSealLocation(siteID, sealConfig)
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula seal configuration for site_id: %s %s\n", d.Id(), err)
+		return err
+	}
+	return nil
+}
+
+// updateSupportAllTLSVersions sets support_all_tls_versions when it's configured. Enabling it is warned about by the
+// resource, see supportAllTLSVersionsDiagnostics.
+func updateSupportAllTLSVersions(client *Client, d *schema.ResourceData) error {
+	if !isSupportAllTLSVersionsChanged(d) {
+		return nil
+	}
+
+	siteID, _ := strconv.Atoi(d.Id())
+	enabled := d.Get("support_all_tls_versions").(bool)
+	err := client.SetSupportAllTLSVersions(siteID, enabled)
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula support all TLS versions (%t) for site_id: %s %s\n", enabled, d.Id(), err)
+		return err
+	}
+	return nil
+}
+
+// isSupportAllTLSVersionsChanged returns true when support_all_tls_versions is configured and differs from the state.
+// The attribute is computed, so it isn't sent when it's left out of the configuration.
+func isSupportAllTLSVersionsChanged(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || rawConfig.GetAttr("support_all_tls_versions").IsNull() {
+		return false
+	}
+	return d.HasChange("support_all_tls_versions")
+}
+
+// isEnablingSupportAllTLSVersions returns true when the apply turns support_all_tls_versions on
+func isEnablingSupportAllTLSVersions(d *schema.ResourceData) bool {
+	return isSupportAllTLSVersionsChanged(d) && d.Get("support_all_tls_versions").(bool)
+}
+
+// supportAllTLSVersionsDiagnostics warns when the apply enabled support_all_tls_versions, not on every apply while it
+// stays enabled
+func supportAllTLSVersionsDiagnostics(d *schema.ResourceData, enabled bool) diag.Diagnostics {
+	if !enabled || d.Id() == "" {
+		return nil
+	}
+	siteID, _ := strconv.Atoi(d.Id())
+	return diag.Diagnostics{supportAllTLSVersionsWarning(siteID)}
+}
+
+// supportAllTLSVersionsWarning warns that the site accepts the deprecated TLS versions
+func supportAllTLSVersionsWarning(siteID int) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Incapsula site %d supports all TLS versions", siteID),
+		Detail: "support_all_tls_versions lets clients connect with TLS 1.0 and 1.1, which are deprecated and vulnerable to " +
+			"downgrade attacks. Only enable it for legacy clients that can't be upgraded, and prefer pinning the minimum TLS version to " + defaultMinTLSVersion + " or above.",
+	}
+}
+
+func updateSiteTags(client *Client, d *schema.ResourceData) error {
+	if !d.HasChange("tags") {
+		return nil
+	}
+
+	tags := make(map[string]string)
+	for key, value := range d.Get("tags").(map[string]interface{}) {
+		tags[key] = value.(string)
+	}
+	siteID, _ := strconv.Atoi(d.Id())
+	err := client.SetSiteTags(siteID, tags)
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula tags for site_id: %s %s\n", d.Id(), err)
+		return err
+	}
+	return nil
+}
+
+func updateSiteRefTags(client *Client, d *schema.ResourceData) error {
+	if !d.HasChange("ref_tags") {
+		return nil
+	}
+
+	siteID, _ := strconv.Atoi(d.Id())
+	err := client.SetSiteRefTags(siteID, toStringSlice(d.Get("ref_tags").(*schema.Set).List()))
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula ref tags for site_id: %s %s\n", d.Id(), err)
+		return err
+	}
+	return nil
+}
+
+// updateSiteAccount moves the site when account_id changes, rather than replacing it
+func updateSiteAccount(client *Client, d *schema.ResourceData) error {
+	accountID := d.Get("account_id").(int)
+	if !d.HasChange("account_id") || accountID == 0 {
+		return nil
+	}
+
+	siteID, _ := strconv.Atoi(d.Id())
+	err := client.MoveSite(siteID, accountID)
+	if err != nil {
+		log.Printf("[ERROR] Could not move Incapsula site_id: %s to account %d %s\n", d.Id(), accountID, err)
+		return err
+	}
+	d.Set("moved_from_account_id", 0)
+	return nil
+}
+
+func updateSiteSANs(client *Client, d *schema.ResourceData) error {
+	if !d.HasChange("sans") {
+		return nil
+	}
+
+	sans := toStringSlice(d.Get("sans").(*schema.Set).List())
+	siteID, _ := strconv.Atoi(d.Id())
+	err := client.SetSiteSANs(siteID, sans)
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula SANs for site_id: %s %s\n", d.Id(), err)
+		return err
+	}
+	return nil
+}
+
+// siteNakedDomainSAN returns whether the naked domain SAN is added to a new site. When inherit_naked_domain_san is set,
+// www sites inherit the naked_domain_san_for_new_www_sites default of their account, falling back to true when the
+// account defaults can't be read.
+func siteNakedDomainSAN(client *Client, d *schema.ResourceData) bool {
+	if !d.Get("inherit_naked_domain_san").(bool) {
+		return d.Get("naked_domain_san").(bool)
+	}
+	if !strings.HasPrefix(d.Get("domain").(string), "www.") {
+		return true
+	}
+
+	accountID := d.Get("account_id").(int)
+	if accountID == 0 {
+		credentialInfo, err := client.WhoAmI()
+		if err != nil {
+			log.Printf("[WARN] Could not resolve the account of the authentication parameters, adding the naked domain SAN: %s\n", err)
+			return true
+		}
+		accountID = credentialInfo.AccountID
+	}
+
+	nakedDomainSan, err := client.GetAccountNakedDomainSANDefault(accountID)
+	if err != nil {
+		log.Printf("[WARN] Could not read the naked domain SAN default of account %d, adding the naked domain SAN: %s\n", accountID, err)
+		return true
+	}
+	log.Printf("[INFO] Incapsula site for domain %s inherits the naked domain SAN default (%t) of account %d\n", d.Get("domain").(string), nakedDomainSan, accountID)
+	return nakedDomainSan
+}
+
+// suppressInheritedNakedDomainSANDiff suppresses the diff of naked_domain_san when the site inherits the account default
+func suppressInheritedNakedDomainSANDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Get("inherit_naked_domain_san").(bool)
+}
+
+func updateSANConfiguration(client *Client, d *schema.ResourceData) error {
+	if !d.HasChange("naked_domain_san") && !d.HasChange("wildcard_san") {
+		return nil
+	}
+
+	sanConfig := SANConfig{
+		AddNakedDomainSan: d.Get("naked_domain_san").(bool),
+		UseWildcardSan:    d.Get("wildcard_san").(bool),
+	}
+	siteID, _ := strconv.Atoi(d.Id())
+	err := client.SetSANConfiguration(siteID, sanConfig)
+	if err != nil {
+		log.Printf("[ERROR] Could not set Incapsula SAN configuration for site_id: %s %s\n", d.Id(), err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/mail"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSiteDualFactorSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSiteDualFactorSettingsUpdate,
+		ReadContext:   resourceSiteDualFactorSettingsRead,
+		UpdateContext: resourceSiteDualFactorSettingsUpdate,
+		DeleteContext: resourceSiteDualFactorSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				siteID, err := strconv.Atoi(d.Id())
+				if err != nil {
+					return nil, err
+				}
+				d.Set("site_id", siteID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Description: "Enable two factor authentication for the site.",
+				Type:        schema.TypeBool,
+				Required:    true,
+			},
+
+			// Optional Arguments
+			"allowed_media": {
+				Description: "The channels users can authenticate with: sms, email, app. At least one is required when enabled.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(dualFactorMedia, false),
+				},
+			},
+			"allow_all_users": {
+				Description: "Allow all users to authenticate.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"send_login_notifications": {
+				Description: "Send a notification to users when they log in.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"notification_recipients": {
+				Description: "Email addresses also notified when users log in. Requires send_login_notifications.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+						email := val.(string)
+						if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+							errs = append(errs, fmt.Errorf("%q is invalid, got: %s", key, email))
+						}
+						return
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSiteDualFactorSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(int)
+
+	cfg := SiteDualFactorConfig{
+		Enabled:                d.Get("enabled").(bool),
+		AllowAllUsers:          d.Get("allow_all_users").(bool),
+		SendLoginNotifications: d.Get("send_login_notifications").(bool),
+		AllowedMedia:           toStringSlice(d.Get("allowed_media").(*schema.Set).List()),
+		NotificationRecipients: toStringSlice(d.Get("notification_recipients").(*schema.Set).List()),
+	}
+
+	err := client.ConfigureSiteDualFactor(siteID, cfg)
+	if err != nil {
+		log.Printf("[ERROR] Could not configure two factor authentication for site_id %d: %s\n", siteID, err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(siteID))
+
+	return resourceSiteDualFactorSettingsRead(ctx, d, m)
+}
+
+func resourceSiteDualFactorSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(int)
+
+	siteStatusResponse, err := client.SiteStatusFields(siteID, []string{"siteDualFactorSettings"})
+
+	// Site object may have been deleted
+	if siteStatusResponse != nil && siteStatusResponse.Res.(float64) == 9413 {
+		log.Printf("[INFO] Incapsula Site ID %d has already been deleted: %s\n", siteID, err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read two factor authentication for site_id %d: %s\n", siteID, err)
+		return diag.FromErr(err)
+	}
+
+	cfg := getSiteDualFactorConfig(siteStatusResponse)
+	d.Set("enabled", cfg.Enabled)
+	d.Set("allowed_media", cfg.AllowedMedia)
+	d.Set("allow_all_users", cfg.AllowAllUsers)
+	d.Set("send_login_notifications", cfg.SendLoginNotifications)
+	d.Set("notification_recipients", cfg.NotificationRecipients)
+
+	return nil
+}
+
+func resourceSiteDualFactorSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(int)
+
+	err := client.ConfigureSiteDualFactor(siteID, SiteDualFactorConfig{Enabled: false, AllowAllUsers: true})
+	if err != nil {
+		log.Printf("[ERROR] Could not disable two factor authentication for site_id %d: %s\n", siteID, err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
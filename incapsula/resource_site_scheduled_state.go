@@ -0,0 +1,128 @@
+package incapsula
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The Incapsula API can't schedule a site state change, so this resource records the desired state and the provider
+// applies it on the first plan/apply after the scheduled time.
+func resourceSiteScheduledState() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSiteScheduledStateCreate,
+		ReadContext:   resourceSiteScheduledStateRead,
+		UpdateContext: resourceSiteScheduledStateUpdate,
+		DeleteContext: resourceSiteScheduledStateDelete,
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			at, err := time.Parse(time.RFC3339, diff.Get("scheduled_at").(string))
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			if diff.Id() == "" || diff.HasChange("scheduled_at") || diff.HasChange("state") {
+				err = validateScheduledSiteState(diff.Get("state").(string), at, now)
+				if err != nil {
+					return err
+				}
+				if diff.Id() != "" {
+					return diff.SetNew("applied", false)
+				}
+				return nil
+			}
+			if !diff.Get("applied").(bool) && scheduledSiteStateDue(at, now) {
+				return diff.SetNew("applied", true)
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"state": {
+				Description:  "The state the site is set to at the scheduled time: active or bypass.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(siteActiveStates, false),
+			},
+			"scheduled_at": {
+				Description:  "When the state is set, in RFC3339 format. Must be in the future.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			// Computed Attributes
+			"applied": {
+				Description: "Whether the state has been set on the site.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSiteScheduledStateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	siteID := d.Get("site_id").(int)
+	log.Printf("[INFO] Scheduling Incapsula site state (%s) at %s for site_id: %d\n", d.Get("state").(string), d.Get("scheduled_at").(string), siteID)
+
+	d.SetId(strconv.Itoa(siteID))
+	d.Set("applied", false)
+
+	return resourceSiteScheduledStateRead(ctx, d, m)
+}
+
+func resourceSiteScheduledStateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(int)
+
+	siteStatusResponse, err := client.SiteStatus("scheduled-state", siteID)
+
+	// Site object may have been deleted
+	if siteStatusResponse != nil && siteStatusResponse.Res.(float64) == 9413 {
+		log.Printf("[INFO] Incapsula Site ID %d has already been deleted: %s\n", siteID, err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula site status for site_id %d: %s\n", siteID, err)
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceSiteScheduledStateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	siteID := d.Get("site_id").(int)
+
+	if d.Get("applied").(bool) {
+		state := d.Get("state").(string)
+		err := client.SetSiteActive(siteID, state)
+		if err != nil {
+			log.Printf("[ERROR] Could not set scheduled state (%s) for site_id %d: %s\n", state, siteID, err)
+			d.Set("applied", false)
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceSiteScheduledStateRead(ctx, d, m)
+}
+
+func resourceSiteScheduledStateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Nothing to delete on the site, the schedule only exists in the state
+	d.SetId("")
+	return nil
+}
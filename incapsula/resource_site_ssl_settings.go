@@ -1,8 +1,11 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strconv"
 	"strings"
@@ -16,9 +19,10 @@ var hstsConfigResource = schema.Resource{
 			Optional: true,
 		},
 		"max_age": {
-			Type:     schema.TypeInt,
-			Default:  31536000,
-			Optional: true,
+			Type:         schema.TypeInt,
+			Default:      31536000,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		"sub_domains_included": {
 			Type:     schema.TypeBool,
@@ -64,10 +68,10 @@ var inboundTLSSettingsResource = schema.Resource{
 
 func resourceSiteSSLSettings() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceSiteSSLSettingsRead,
-		Update: resourceSiteSSLSettingsUpdate,
-		Create: resourceSiteSSLSettingsUpdate,
-		Delete: resourceSiteSSLSettingsDelete,
+		Read:          resourceSiteSSLSettingsRead,
+		UpdateContext: resourceSiteSSLSettingsUpdate,
+		CreateContext: resourceSiteSSLSettingsUpdate,
+		Delete:        resourceSiteSSLSettingsDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idSlice := strings.Split(d.Id(), "/")
@@ -128,18 +132,40 @@ func resourceSiteSSLSettings() *schema.Resource {
 	}
 }
 
-func resourceSiteSSLSettingsUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceSiteSSLSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Client)
+	var diags diag.Diagnostics
 
 	setting := getSSLSettingsDTO(d)
 
+	if hstsSettings := setting.Data[0].HstsConfiguration; hstsSettings != nil {
+		err := validateHSTSConfiguration(hstsSettings)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		oldHSTS, _ := d.GetChange("hsts")
+		if hstsPreloadEnabled(hstsSetToHSTSDTO(oldHSTS.(*schema.Set)), hstsSettings) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "HSTS preload enabled",
+				Detail:   fmt.Sprintf("Site ID %d: %s", d.Get("site_id").(int), hstsPreloadWarning),
+			})
+		}
+	}
+
 	_, err := client.UpdateSiteSSLSettings(d.Get("site_id").(int), d.Get("account_id").(int), setting)
 
 	if err != nil {
-		return err
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	err = resourceSiteSSLSettingsRead(d, m)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
 	}
 
-	return resourceSiteSSLSettingsRead(d, m)
+	return diags
 }
 
 func resourceSiteSSLSettingsRead(d *schema.ResourceData, m interface{}) error {
@@ -228,8 +254,12 @@ func mapHSTSResponseToHSTSResource(d *schema.ResourceData, settingsData *SSLSett
 }
 
 func mapHSTSResourceToHSTSDTO(d *schema.ResourceData) *HSTSConfiguration {
-	hsts, ok := d.Get("hsts").(*schema.Set)
-	if !ok || hsts.Len() == 0 {
+	hsts, _ := d.Get("hsts").(*schema.Set)
+	return hstsSetToHSTSDTO(hsts)
+}
+
+func hstsSetToHSTSDTO(hsts *schema.Set) *HSTSConfiguration {
+	if hsts == nil || hsts.Len() == 0 {
 		return nil
 	}
 	hstsList := hsts.List()
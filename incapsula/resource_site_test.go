@@ -1,14 +1,20 @@
 package incapsula
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"math/rand"
 )
@@ -127,3 +133,271 @@ func testAccCheckIncapsulaSiteConfigBasic(domain string) string {
 		domain,
 	)
 }
+
+func TestSiteWarningDiagnostics(t *testing.T) {
+	var siteStatusResponse SiteStatusResponse
+	err := json.Unmarshal([]byte(`{"site_id":42,"res":0,"debug_info":{"id-info":"13007"},
+		"warnings":["The site's DNS isn't pointing to Imperva",{"type":"ssl","message":"The certificate expires in 7 days"}]}`), &siteStatusResponse)
+	if err != nil {
+		t.Fatalf("Failed to parse site status: %s", err)
+	}
+
+	diags := siteWarningDiagnostics(&siteStatusResponse)
+	if len(diags) != 2 {
+		t.Fatalf("Expected 2 diagnostics, got: %d", len(diags))
+	}
+	if diags.HasError() {
+		t.Errorf("Warnings should not yield error diagnostics")
+	}
+	expectedDetails := []string{
+		"The site's DNS isn't pointing to Imperva (id-info: 13007)",
+		"ssl: The certificate expires in 7 days (id-info: 13007)",
+	}
+	for i, d := range diags {
+		if d.Severity != diag.Warning {
+			t.Errorf("Expected a warning diagnostic, got severity: %v", d.Severity)
+		}
+		if d.Detail != expectedDetails[i] {
+			t.Errorf("Expected detail %q, got: %q", expectedDetails[i], d.Detail)
+		}
+	}
+
+	if diags := siteWarningDiagnostics(&SiteStatusResponse{}); len(diags) != 0 {
+		t.Errorf("Should not have produced diagnostics without warnings, got: %v", diags)
+	}
+}
+
+func TestSiteErrorDiagnostics(t *testing.T) {
+	var siteStatusResponse SiteStatusResponse
+	err := json.Unmarshal([]byte(`{"site_id":42,"res":0,"debug_info":{"id-info":"13007"},
+		"warnings":["The site's DNS isn't pointing to Imperva"],
+		"additionalErrors":["The domain is already protected by another site",{"type":"dns","message":"Conflicting CNAME record"}]}`), &siteStatusResponse)
+	if err != nil {
+		t.Fatalf("Failed to parse site status: %s", err)
+	}
+
+	diags := siteErrorDiagnostics(&siteStatusResponse)
+	if len(diags) != 2 {
+		t.Fatalf("Expected 2 diagnostics, got: %d", len(diags))
+	}
+	expectedDetails := []string{
+		"The domain is already protected by another site (id-info: 13007)",
+		"dns: Conflicting CNAME record (id-info: 13007)",
+	}
+	for i, d := range diags {
+		if d.Severity != diag.Error {
+			t.Errorf("Expected an error diagnostic, got severity: %v", d.Severity)
+		}
+		if d.Detail != expectedDetails[i] {
+			t.Errorf("Expected detail %q, got: %q", expectedDetails[i], d.Detail)
+		}
+	}
+
+	if diags := siteWarningDiagnostics(&siteStatusResponse); diags.HasError() {
+		t.Errorf("Warnings should still not yield error diagnostics")
+	}
+	if diags := siteErrorDiagnostics(&SiteStatusResponse{}); len(diags) != 0 {
+		t.Errorf("Should not have produced diagnostics without additional errors, got: %v", diags)
+	}
+}
+
+func TestSiteErrorDiagnosticsUnexpectedErrors(t *testing.T) {
+	var siteStatusResponse SiteStatusResponse
+	err := json.Unmarshal([]byte(`{"site_id":42,"res":0,"additionalErrors":[9420,{"type":1,"message":"Conflicting CNAME record"},"The domain is already protected by another site"]}`), &siteStatusResponse)
+	if err != nil {
+		t.Fatalf("Should have parsed the site status despite the unexpected errors, got: %s", err)
+	}
+
+	expectedMessages := []string{"9420", `{"type":1,"message":"Conflicting CNAME record"}`, "The domain is already protected by another site"}
+	if len(siteStatusResponse.AdditionalErrors) != len(expectedMessages) {
+		t.Fatalf("Expected %d errors, got: %v", len(expectedMessages), siteStatusResponse.AdditionalErrors)
+	}
+	for i, siteError := range siteStatusResponse.AdditionalErrors {
+		if siteError.Type != "" || siteError.Message != expectedMessages[i] {
+			t.Errorf("Expected error %q, got: %+v", expectedMessages[i], siteError)
+		}
+	}
+}
+
+func TestSiteAccountMoveDiagnostics(t *testing.T) {
+	d := resourceSite().TestResourceData()
+	d.SetId("42")
+	d.Set("account_id", 8)
+
+	// The state had account 7, the site status reports account 8
+	diags := siteAccountMoveDiagnostics(d, 7, &SiteStatusResponse{SiteID: 42, AccountID: 8})
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("Expected a warning diagnostic, got: %v", diags)
+	}
+	if d.Get("account_id").(int) != 8 || d.Get("moved_from_account_id").(int) != 7 {
+		t.Errorf("Unexpected account_id/moved_from_account_id, got: %d/%d", d.Get("account_id").(int), d.Get("moved_from_account_id").(int))
+	}
+	if !suppressMovedSiteAccountIDDiff("account_id", "8", "7", d) {
+		t.Errorf("Should have suppressed the diff to the account the site was moved from")
+	}
+	if suppressMovedSiteAccountIDDiff("account_id", "8", "9", d) {
+		t.Errorf("Should not have suppressed the diff to another account")
+	}
+
+	if diags := siteAccountMoveDiagnostics(d, 8, &SiteStatusResponse{SiteID: 42, AccountID: 8}); len(diags) != 0 {
+		t.Errorf("Should not have produced diagnostics when the account didn't change, got: %v", diags)
+	}
+}
+
+func TestSiteAccountChangeMovesSite(t *testing.T) {
+	if resourceSite().Schema["account_id"].ForceNew {
+		t.Fatalf("Changing account_id should move the site rather than replace it")
+	}
+
+	moved := false
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		switch req.URL.String() {
+		case fmt.Sprintf("/%s", endpointSiteAdd):
+			if req.PostForm.Get("account_id") != "7" {
+				t.Errorf("Expected the site to be added to account 7, got: %s", req.PostForm.Get("account_id"))
+			}
+			rw.Write([]byte(`{"site_id":42,"res":0}`))
+		case fmt.Sprintf("/%s", endpointSiteMove):
+			if req.PostForm.Get("site_id") != "42" || req.PostForm.Get("destination_account_id") != "8" {
+				t.Errorf("Unexpected site_id/destination_account_id, got: %s/%s", req.PostForm.Get("site_id"), req.PostForm.Get("destination_account_id"))
+			}
+			moved = true
+			rw.Write([]byte(`{"res":0}`))
+		default:
+			t.Errorf("Unexpected request: %s", req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteAddResponse, err := client.WithAccount(7).AddSite("www.example.com", "", "", "", "", 0, false, false, "")
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if client.accountID != 0 {
+		t.Errorf("WithAccount should have left the original client unchanged")
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSite().Schema, map[string]interface{}{"domain": "www.example.com", "account_id": 8})
+	d.SetId(strconv.Itoa(siteAddResponse.SiteID))
+	err = updateSiteAccount(client, d)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if !moved {
+		t.Errorf("Should have moved the site to account 8")
+	}
+}
+
+func TestSiteNakedDomainSANInheritsAccountDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointAccountStatus) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointAccountStatus, req.URL.String())
+		}
+		req.ParseForm()
+		if req.PostForm.Get("account_id") != "7" {
+			t.Errorf("Expected the defaults of account 7 to be read, got: %s", req.PostForm.Get("account_id"))
+		}
+		rw.Write([]byte(`{"account":{"account_id":7,"naked_domain_san_for_new_www_sites":false},"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	d := schema.TestResourceDataRaw(t, resourceSite().Schema, map[string]interface{}{"domain": "www.example.com", "account_id": 7, "inherit_naked_domain_san": true})
+	if siteNakedDomainSAN(client, d) {
+		t.Errorf("Should have inherited the naked domain SAN default of the account")
+	}
+
+	// The naked domain SAN only applies to www sites, the account isn't read for the others
+	config.BaseURL = "badness.incapsula.com"
+	d = schema.TestResourceDataRaw(t, resourceSite().Schema, map[string]interface{}{"domain": "example.com", "account_id": 7, "inherit_naked_domain_san": true})
+	if !siteNakedDomainSAN(client, d) {
+		t.Errorf("Should have kept the naked_domain_san default of a site which isn't a www site")
+	}
+}
+
+func TestSiteNakedDomainSANDefault(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	// Without inherit_naked_domain_san the account isn't read
+	d := schema.TestResourceDataRaw(t, resourceSite().Schema, map[string]interface{}{"domain": "www.example.com", "account_id": 7})
+	if !siteNakedDomainSAN(client, d) {
+		t.Errorf("Should have added the naked domain SAN by default")
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSite().Schema, map[string]interface{}{"domain": "www.example.com", "account_id": 7, "naked_domain_san": false})
+	if siteNakedDomainSAN(client, d) {
+		t.Errorf("Should have used the naked_domain_san of the site")
+	}
+
+	// When the account defaults can't be read the naked domain SAN is added
+	d = schema.TestResourceDataRaw(t, resourceSite().Schema, map[string]interface{}{"domain": "www.example.com", "account_id": 7, "inherit_naked_domain_san": true})
+	if !siteNakedDomainSAN(client, d) {
+		t.Errorf("Should have fallen back to adding the naked domain SAN")
+	}
+}
+
+func TestAddOrAdoptSiteAdoptsExistingSite(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.String() {
+		case fmt.Sprintf("/%s", endpointSiteAdd):
+			rw.Write([]byte(`{"res":1,"res_message":"Unexpected error","debug_info":{"id-info":"13007"}}`))
+		case fmt.Sprintf("/%s", endpointSiteList):
+			rw.Write([]byte(`{"sites":[{"site_id":41,"domain":"www.other.com","account_id":7},{"site_id":42,"domain":"WWW.example.com","account_id":7,"ref_id":"cmdb-1"}],"res":0}`))
+		default:
+			t.Errorf("Unexpected request: %s", req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	d := schema.TestResourceDataRaw(t, resourceSite().Schema, map[string]interface{}{"domain": "www.example.com", "account_id": 7, "ref_id": "cmdb-1", "adopt_existing": true})
+	siteID, err := addOrAdoptSite(client, d, true)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	if siteID != 42 {
+		t.Errorf("Should have adopted site 42, got: %d", siteID)
+	}
+
+	// A site that doesn't match the configuration isn't adopted
+	d = schema.TestResourceDataRaw(t, resourceSite().Schema, map[string]interface{}{"domain": "www.example.com", "account_id": 7, "ref_id": "cmdb-2", "adopt_existing": true})
+	_, err = addOrAdoptSite(client, d, true)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error - the existing Incapsula site 42 for domain www.example.com doesn't match the configuration") {
+		t.Errorf("Should have received a mismatch error, got: %v", err)
+	}
+
+	// Without an existing site for the domain the add error is returned
+	d = schema.TestResourceDataRaw(t, resourceSite().Schema, map[string]interface{}{"domain": "www.missing.com", "account_id": 7, "adopt_existing": true})
+	_, err = addOrAdoptSite(client, d, true)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error from Incapsula service when adding site for domain www.missing.com") {
+		t.Errorf("Should have received the add site error, got: %v", err)
+	}
+
+	// Without adopt_existing the add error is returned
+	d = schema.TestResourceDataRaw(t, resourceSite().Schema, map[string]interface{}{"domain": "www.example.com", "account_id": 7})
+	_, err = addOrAdoptSite(client, d, true)
+	if err == nil || !strings.HasPrefix(err.Error(), "Error from Incapsula service when adding site for domain www.example.com") {
+		t.Errorf("Should have received the add site error, got: %v", err)
+	}
+}
+
+func TestSupportAllTLSVersionsDiagnostics(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceSite().Schema, map[string]interface{}{"domain": "www.example.com"})
+	d.SetId("42")
+
+	diags := supportAllTLSVersionsDiagnostics(d, true)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "TLS 1.0 and 1.1") {
+		t.Errorf("Should have received a single warning when enabling, got: %+v", diags)
+	}
+
+	diags = supportAllTLSVersionsDiagnostics(d, false)
+	if len(diags) != 0 {
+		t.Errorf("Should not have received a warning when not enabling, got: %+v", diags)
+	}
+}
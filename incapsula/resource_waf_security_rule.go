@@ -5,6 +5,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -67,6 +68,17 @@ func resourceWAFSecurityRule() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"simulation_duration": {
+				Description:  "Run the rule in alert mode for this duration (e.g. 168h) before applying security_rule_action. The rule is promoted on the first apply after the duration has elapsed.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateWafRuleSimulationDuration,
+			},
+			"simulation_ends_at": {
+				Description: "When the rule simulation ends (RFC 3339), empty when the rule isn't simulated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 
 			// Required for rule_id: api.threats.ddos
 			"activation_mode": {
@@ -91,6 +103,32 @@ func resourceWAFSecurityRule() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"bad_bots_allowlist": {
+				Description: "Bad bot signatures (client application ids) that are still allowed when block_bad_bots is true.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"non_essential_bots_allowlist": {
+				Description: "Client application types exempted from the bot access control rule (for example monitoring bots), managed as an exception of the rule.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_app_types": {
+							Description: "The client application types to allow.",
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -103,29 +141,33 @@ func resourceWAFSecurityRuleCreate(d *schema.ResourceData, m interface{}) error
 	log.Printf("[INFO] Creating Incapsula WAF Rule rule_id (%s) on site_id (%d)\n", ruleID, d.Get("site_id").(int))
 
 	if ruleID == backdoorRuleID || ruleID == crossSiteScriptingRuleID || ruleID == illegalResourceAccessRuleID || ruleID == remoteFileInclusionRuleID || ruleID == sqlInjectionRuleID {
-		_, err := client.ConfigureWAFSecurityRule(
-			d.Get("site_id").(int),
-			ruleID,
-			d.Get("security_rule_action").(string),
-			"",
-			"",
-			"",
-			"",
-		)
-		if err != nil {
-			log.Printf("[ERROR] Could not create Incapsula WAF Rule rule_id (%s) and security_rule_action (%s) on site_id (%d), %s\n", ruleID, d.Get("security_rule_action").(string), d.Get("site_id").(int), err)
-			return err
+		if simulationDuration, ok := d.GetOk("simulation_duration"); ok && d.HasChange("simulation_duration") {
+			// Validated by the schema
+			duration, _ := time.ParseDuration(simulationDuration.(string))
+			err := client.SetWafRuleSimulation(d.Get("site_id").(int), ruleID, duration)
+			if err != nil {
+				log.Printf("[ERROR] Could not simulate Incapsula WAF Rule rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
+				return err
+			}
+			d.Set("simulation_ends_at", wafRuleSimulationEnd(time.Now(), duration))
+		} else {
+			_, err := client.ConfigureWAFSecurityRule(
+				d.Get("site_id").(int),
+				ruleID,
+				d.Get("security_rule_action").(string),
+				"",
+				"",
+				"",
+				"",
+			)
+			if err != nil {
+				log.Printf("[ERROR] Could not create Incapsula WAF Rule rule_id (%s) and security_rule_action (%s) on site_id (%d), %s\n", ruleID, d.Get("security_rule_action").(string), d.Get("site_id").(int), err)
+				return err
+			}
+			d.Set("simulation_ends_at", "")
 		}
 	} else if ruleID == ddosRuleID {
-		_, err := client.ConfigureWAFSecurityRule(
-			d.Get("site_id").(int),
-			ruleID,
-			"",
-			d.Get("activation_mode").(string),
-			d.Get("ddos_traffic_threshold").(string),
-			"",
-			"",
-		)
+		err := configureDDoSRule(client, d)
 		if err != nil {
 			log.Printf("[ERROR] Could not create Incapsula WAF Rule rule_id (%s) with activation_mode (%s) and ddos_traffic_threshold (%s) on site_id (%d), %s\n", ruleID, d.Get("activation_mode").(string), d.Get("ddos_traffic_threshold").(string), d.Get("site_id").(int), err)
 			return err
@@ -144,6 +186,24 @@ func resourceWAFSecurityRuleCreate(d *schema.ResourceData, m interface{}) error
 			log.Printf("[ERROR] Could not create Incapsula WAF Rule rule_id (%s) with block_bad_bots (%s) and challenge_suspected_bots (%s) on site_id (%d), %s\n", ruleID, d.Get("block_bad_bots").(string), d.Get("challenge_suspected_bots").(string), d.Get("site_id").(int), err)
 			return err
 		}
+
+		if d.HasChange("non_essential_bots_allowlist") {
+			previousAllowlist, allowlist := d.GetChange("non_essential_bots_allowlist")
+			err = client.SetBotAccessControlAllowlist(d.Get("site_id").(int), getBotAccessControlAllowlist(previousAllowlist), getBotAccessControlAllowlist(allowlist))
+			if err != nil {
+				log.Printf("[ERROR] Could not set the non-essential bots allowlist of Incapsula WAF Rule rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
+				return err
+			}
+		}
+
+		badBotsAllowlist := getBadBotsAllowlist(d)
+		if len(badBotsAllowlist) > 0 || d.HasChange("bad_bots_allowlist") {
+			err = client.SetBadBotPolicy(d.Get("site_id").(int), d.Get("block_bad_bots").(string) == "true", badBotsAllowlist)
+			if err != nil {
+				log.Printf("[ERROR] Could not set the bad bots allowlist of Incapsula WAF Rule rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
+				return err
+			}
+		}
 	}
 
 	// Set the rule ID
@@ -182,24 +242,41 @@ func resourceWAFSecurityRuleRead(d *schema.ResourceData, m interface{}) error {
 		if entry.ID == d.Get("rule_id").(string) {
 			// Set different attributes based on the rule id
 			switch entry.ID {
-			case backdoorRuleID:
-				d.Set("security_rule_action", entry.Action)
-			case crossSiteScriptingRuleID:
+			case backdoorRuleID, crossSiteScriptingRuleID, illegalResourceAccessRuleID, remoteFileInclusionRuleID, sqlInjectionRuleID:
+				// While simulated the rule alerts instead of applying its action, the action is set once the simulation
+				// ended so the next apply promotes the rule
+				simulationEnd := d.Get("simulation_ends_at").(string)
+				if entry.Action == wafRuleActionAlert && simulationEnd != "" && !wafRuleSimulationEnded(simulationEnd, time.Now()) {
+					break
+				}
+				if simulationEnd != "" && entry.Action == wafRuleActionAlert {
+					log.Printf("[INFO] Simulation of Incapsula WAF Rule rule_id (%s) on site_id (%d) ended at %s, the rule will be promoted\n", ruleID, d.Get("site_id").(int), simulationEnd)
+				}
 				d.Set("security_rule_action", entry.Action)
 			case customRuleDefaultActionID:
 				d.Set("security_rule_action", entry.Action)
-			case illegalResourceAccessRuleID:
-				d.Set("security_rule_action", entry.Action)
-			case remoteFileInclusionRuleID:
-				d.Set("security_rule_action", entry.Action)
-			case sqlInjectionRuleID:
-				d.Set("security_rule_action", entry.Action)
 			case ddosRuleID:
 				d.Set("activation_mode", entry.ActivationMode)
-				d.Set("ddos_traffic_threshold", strconv.FormatInt(int64(entry.DdosTrafficThreshold), 10))
+				// The threshold only applies to the manual mode, in auto mode it's adaptive
+				if entry.ActivationMode == ddosActivationModeOn {
+					d.Set("ddos_traffic_threshold", strconv.FormatInt(int64(entry.DdosTrafficThreshold), 10))
+				} else {
+					d.Set("ddos_traffic_threshold", "")
+				}
 			case botAccessControlRuleID:
 				d.Set("block_bad_bots", strconv.FormatBool(entry.BlockBadBots))
 				d.Set("challenge_suspected_bots", strconv.FormatBool(entry.ChallengeSuspectedBots))
+				d.Set("bad_bots_allowlist", entry.BadBotsAllowlist)
+				// The allowlist exception has no id in the state, it's found by its client application types
+				if clientAppTypes := getBotAccessControlAllowlist(d.Get("non_essential_bots_allowlist")); len(clientAppTypes) > 0 {
+					exception, err := findSecurityRuleException(siteStatusResponse, botAccessControlExceptionRuleID, ExceptionKey{ClientAppTypes: strings.Join(clientAppTypes, ",")})
+					if err != nil {
+						return err
+					}
+					if exception == nil {
+						d.Set("non_essential_bots_allowlist", []interface{}{})
+					}
+				}
 			}
 			found = true
 			break
@@ -222,6 +299,63 @@ func resourceWAFSecurityRuleUpdate(d *schema.ResourceData, m interface{}) error
 	return resourceWAFSecurityRuleCreate(d, m)
 }
 
+// configureDDoSRule sets the activation mode of the DDoS rule, the traffic threshold is only accepted in manual
+// (api.threats.ddos.activation_mode.on) mode
+func configureDDoSRule(client *Client, d *schema.ResourceData) error {
+	siteID := d.Get("site_id").(int)
+	activationMode := d.Get("activation_mode").(string)
+	threshold := d.Get("ddos_traffic_threshold").(string)
+
+	if activationMode != ddosActivationModeAuto && activationMode != ddosActivationModeOn {
+		if threshold != "" {
+			return fmt.Errorf("Error - a DDoS traffic threshold (%s) can only be set for WAF security rule rule_id (%s) with activation_mode %s, got: %s", threshold, ddosRuleID, ddosActivationModeOn, activationMode)
+		}
+		_, err := client.ConfigureWAFSecurityRule(siteID, ddosRuleID, "", activationMode, "", "", "")
+		return err
+	}
+
+	thresholdValue := 0
+	if threshold != "" {
+		var err error
+		thresholdValue, err = strconv.Atoi(threshold)
+		if err != nil {
+			return fmt.Errorf("Error - invalid DDoS traffic threshold (%s) for WAF security rule rule_id (%s), must be one of %v", threshold, ddosRuleID, ddosTrafficThresholds)
+		}
+	}
+
+	return client.SetDDoSMode(siteID, activationMode == ddosActivationModeAuto, thresholdValue)
+}
+
+func validateWafRuleSimulationDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil || duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration, e.g. 168h, got: %s", k, v.(string)))
+	}
+	return
+}
+
+// getBotAccessControlAllowlist returns the client application types of a non_essential_bots_allowlist value
+func getBotAccessControlAllowlist(allowlist interface{}) []string {
+	allowlistList := allowlist.([]interface{})
+	if len(allowlistList) == 0 || allowlistList[0] == nil {
+		return nil
+	}
+
+	clientAppTypes := make([]string, 0)
+	for _, clientAppType := range allowlistList[0].(map[string]interface{})["client_app_types"].([]interface{}) {
+		clientAppTypes = append(clientAppTypes, clientAppType.(string))
+	}
+	return clientAppTypes
+}
+
+func getBadBotsAllowlist(d *schema.ResourceData) []string {
+	badBotsAllowlist := make([]string, 0)
+	for _, signature := range d.Get("bad_bots_allowlist").([]interface{}) {
+		badBotsAllowlist = append(badBotsAllowlist, signature.(string))
+	}
+	return badBotsAllowlist
+}
+
 func testAccStateWAFSecurityRuleID(s *terraform.State) (string, error) {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "incapsula_waf_security_rule" {
@@ -349,6 +483,20 @@ func resourceWAFSecurityRuleDelete(d *schema.ResourceData, m interface{}) error
 			log.Printf("[ERROR] Could not reset Incapsula WAF Rule rule_id (%s) with block_bad_bots (%s) and challenge_suspected_bots (%s) on site_id (%d) %s\n", ruleID, botAccessControlBlockBadBotsDefaultAction, botAccessControlChallengeSuspectedBotsDefaultAction, d.Get("site_id").(int), err)
 			return err
 		}
+		if len(getBadBotsAllowlist(d)) > 0 {
+			err = client.SetBadBotPolicy(d.Get("site_id").(int), true, nil)
+			if err != nil {
+				log.Printf("[ERROR] Could not reset the bad bots allowlist of Incapsula WAF Rule rule_id (%s) on site_id (%d) %s\n", ruleID, d.Get("site_id").(int), err)
+				return err
+			}
+		}
+		if clientAppTypes := getBotAccessControlAllowlist(d.Get("non_essential_bots_allowlist")); len(clientAppTypes) > 0 {
+			err = client.SetBotAccessControlAllowlist(d.Get("site_id").(int), clientAppTypes, nil)
+			if err != nil {
+				log.Printf("[ERROR] Could not remove the non-essential bots allowlist of Incapsula WAF Rule rule_id (%s) on site_id (%d) %s\n", ruleID, d.Get("site_id").(int), err)
+				return err
+			}
+		}
 	}
 
 	// Set the ID to empty
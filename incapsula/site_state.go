@@ -0,0 +1,99 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// SiteState is the provisioning state of a site, as returned in the status field of the site status
+type SiteState string
+
+// Site state enumerations
+const (
+	SiteStatePendingSelectApprover SiteState = "pending-select-approver"
+	SiteStatePendingCertificate    SiteState = "pending-certificate"
+	SiteStatePendingDNSChanges     SiteState = "pending-dns-changes"
+	SiteStateFullyConfigured       SiteState = "fully-configured"
+	SiteStateBypassed              SiteState = "bypassed"
+)
+
+// IsReady returns true when the site is fully configured and serving traffic through Imperva
+func (s SiteState) IsReady() bool {
+	return s == SiteStateFullyConfigured
+}
+
+// IsPending returns true while the site is still being provisioned (e.g. waiting for DNS changes or a certificate)
+func (s SiteState) IsPending() bool {
+	return strings.HasPrefix(string(s), "pending-")
+}
+
+// State returns the provisioning state of the site
+func (s *SiteStatusResponse) State() SiteState {
+	return SiteState(s.Status)
+}
+
+// SiteError is a hard error blocking a site (e.g. conflicting DNS), from the additionalErrors of the site status.
+// Errors are either plain strings or objects with a type and a message, anything else is kept as JSON.
+type SiteError struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// UnmarshalJSON accepts both the plain string and the object errors, falling back to the raw JSON of any other error
+func (e *SiteError) UnmarshalJSON(data []byte) error {
+	var message string
+	if err := json.Unmarshal(data, &message); err == nil {
+		*e = SiteError{Message: message}
+		return nil
+	}
+
+	type siteError SiteError
+	var siteErr siteError
+	if err := json.Unmarshal(data, &siteErr); err != nil {
+		// Neither a string nor an object, e.g. a number
+		siteErr = siteError{}
+	}
+	if siteErr.Message == "" {
+		// Keep the raw error rather than losing it
+		siteErr.Message = string(data)
+	}
+	*e = SiteError(siteErr)
+	return nil
+}
+
+// Error returns the message of the error, prefixed with its type when there's one
+func (e SiteError) Error() string {
+	if e.Type != "" {
+		return fmt.Sprintf("%s: %s", e.Type, e.Message)
+	}
+	return e.Message
+}
+
+// WarningMessages returns the warnings of the site status as messages. Warnings are either plain strings or objects
+// with a message (and possibly a type), anything else is returned as JSON.
+func (s *SiteStatusResponse) WarningMessages() []string {
+	messages := make([]string, 0, len(s.Warnings))
+	for _, warning := range s.Warnings {
+		switch w := warning.(type) {
+		case string:
+			messages = append(messages, w)
+		case map[string]interface{}:
+			message, _ := w["message"].(string)
+			warningType, _ := w["type"].(string)
+			switch {
+			case message != "" && warningType != "":
+				messages = append(messages, fmt.Sprintf("%s: %s", warningType, message))
+			case message != "":
+				messages = append(messages, message)
+			default:
+				warningJSON, _ := json.Marshal(w)
+				messages = append(messages, string(warningJSON))
+			}
+		default:
+			warningJSON, _ := json.Marshal(w)
+			messages = append(messages, string(warningJSON))
+		}
+	}
+	return messages
+}
@@ -0,0 +1,40 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSiteState(t *testing.T) {
+	cases := []struct {
+		state   SiteState
+		ready   bool
+		pending bool
+	}{
+		{SiteStateFullyConfigured, true, false},
+		{SiteStatePendingDNSChanges, false, true},
+		{SiteStatePendingSelectApprover, false, true},
+		{SiteStatePendingCertificate, false, true},
+		{SiteStateBypassed, false, false},
+		{SiteState(""), false, false},
+	}
+	for _, c := range cases {
+		if c.state.IsReady() != c.ready {
+			t.Errorf("Expected IsReady() of %q to be %t", c.state, c.ready)
+		}
+		if c.state.IsPending() != c.pending {
+			t.Errorf("Expected IsPending() of %q to be %t", c.state, c.pending)
+		}
+	}
+}
+
+func TestSiteStatusResponseState(t *testing.T) {
+	var siteStatusResponse SiteStatusResponse
+	err := json.Unmarshal([]byte(`{"site_id":42,"status":"pending-dns-changes","res":0}`), &siteStatusResponse)
+	if err != nil {
+		t.Fatalf("Failed to parse site status: %s", err)
+	}
+	if siteStatusResponse.State() != SiteStatePendingDNSChanges {
+		t.Errorf("Expected state to be %s, got: %s", SiteStatePendingDNSChanges, siteStatusResponse.State())
+	}
+}
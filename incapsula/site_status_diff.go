@@ -0,0 +1,83 @@
+package incapsula
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// StatusChange describes a single field that differs between two site status reads
+type StatusChange struct {
+	Path string
+	Old  string
+	New  string
+}
+
+// DiffSiteStatus returns the changes between two site status reads, limited to the attributes managed by the provider
+// (acceleration, log level and format, SANs, cache TTL flags and WAF rule actions). A nil snapshot is treated as empty.
+func DiffSiteStatus(a, b *SiteStatusResponse) []StatusChange {
+	if a == nil {
+		a = &SiteStatusResponse{}
+	}
+	if b == nil {
+		b = &SiteStatusResponse{}
+	}
+
+	changes := make([]StatusChange, 0)
+	addChange := func(path, old, new string) {
+		if old != new {
+			changes = append(changes, StatusChange{Path: path, Old: old, New: new})
+		}
+	}
+
+	addChange("acceleration_level", a.AccelerationLevelRaw, b.AccelerationLevelRaw)
+	addChange("log_level", a.LogLevel, b.LogLevel)
+	addChange("log_format", a.LogFormat, b.LogFormat)
+	addChange("active", a.Active, b.Active)
+	addChange("add_naked_domain_san", strconv.FormatBool(a.AddNakedDomainSan), strconv.FormatBool(b.AddNakedDomainSan))
+	addChange("use_wildcard_san_instead_of_full_domain_san", strconv.FormatBool(a.UseWildcardSanInsteadOfFullDomainSan), strconv.FormatBool(b.UseWildcardSanInsteadOfFullDomainSan))
+	addChange("performance_configuration.use_shortest_caching", strconv.FormatBool(a.PerformanceConfiguration.UseShortestCaching), strconv.FormatBool(b.PerformanceConfiguration.UseShortestCaching))
+	addChange("performance_configuration.prefer_last_modified", strconv.FormatBool(a.PreferLastModified()), strconv.FormatBool(b.PreferLastModified()))
+	addChange("ssl.generated_certificate.san", joinSorted(a.Ssl.GeneratedCertificate.San), joinSorted(b.Ssl.GeneratedCertificate.San))
+
+	oldRules := wafRuleSettings(a)
+	newRules := wafRuleSettings(b)
+	ruleIDs := make([]string, 0, len(oldRules)+len(newRules))
+	for ruleID := range oldRules {
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+	for ruleID := range newRules {
+		if _, ok := oldRules[ruleID]; !ok {
+			ruleIDs = append(ruleIDs, ruleID)
+		}
+	}
+	sort.Strings(ruleIDs)
+	for _, ruleID := range ruleIDs {
+		addChange(fmt.Sprintf("security.waf.rules[%s]", ruleID), oldRules[ruleID], newRules[ruleID])
+	}
+
+	return changes
+}
+
+// wafRuleSettings returns the managed settings of each WAF rule keyed by rule id
+func wafRuleSettings(siteStatusResponse *SiteStatusResponse) map[string]string {
+	settings := make(map[string]string)
+	for _, rule := range siteStatusResponse.Security.Waf.Rules {
+		switch rule.ID {
+		case ddosRuleID:
+			settings[rule.ID] = fmt.Sprintf("activation_mode=%s,ddos_traffic_threshold=%d", rule.ActivationMode, rule.DdosTrafficThreshold)
+		case botAccessControlRuleID:
+			settings[rule.ID] = fmt.Sprintf("block_bad_bots=%t,challenge_suspected_bots=%t", rule.BlockBadBots, rule.ChallengeSuspectedBots)
+		default:
+			settings[rule.ID] = rule.Action
+		}
+	}
+	return settings
+}
+
+func joinSorted(values []string) string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
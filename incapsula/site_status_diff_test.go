@@ -0,0 +1,34 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDiffSiteStatus(t *testing.T) {
+	var before, after SiteStatusResponse
+	err := json.Unmarshal([]byte(`{"acceleration_level_raw":"standard","log_level":"full","ssl":{"generated_certificate":{"san":["example.com","*.example.com"]}},
+		"security":{"waf":{"rules":[{"id":"api.threats.sql_injection","action":"api.threats.action.block_request"},{"id":"api.threats.cross_site_scripting","action":"api.threats.action.block_request"}]}},"res":0}`), &before)
+	if err != nil {
+		t.Fatalf("Failed to parse snapshot: %s", err)
+	}
+	err = json.Unmarshal([]byte(`{"acceleration_level_raw":"aggressive","log_level":"full","ssl":{"generated_certificate":{"san":["*.example.com","example.com"]}},
+		"security":{"waf":{"rules":[{"id":"api.threats.sql_injection","action":"api.threats.action.alert"},{"id":"api.threats.cross_site_scripting","action":"api.threats.action.block_request"}]}},"res":0}`), &after)
+	if err != nil {
+		t.Fatalf("Failed to parse snapshot: %s", err)
+	}
+
+	changes := DiffSiteStatus(&before, &after)
+	expected := []StatusChange{
+		{Path: "acceleration_level", Old: "standard", New: "aggressive"},
+		{Path: "security.waf.rules[api.threats.sql_injection]", Old: "api.threats.action.block_request", New: "api.threats.action.alert"},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Unexpected changes, expected %+v, got: %+v", expected, changes)
+	}
+
+	if changes := DiffSiteStatus(&before, &before); len(changes) != 0 {
+		t.Errorf("Should not have found changes between identical snapshots, got: %+v", changes)
+	}
+}